@@ -452,21 +452,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	ctx, err := app.NewQueryContext(req.Height)
 	if err != nil {
-		return sdkerrors.QueryResult(
-			sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
-			),
-		)
+		return sdkerrors.QueryResult(err)
 	}
 
-	// cache wrap the commit-multistore for safety
-	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
-
 	// Passes the rest of the path as an argument to the querier.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
@@ -488,6 +478,23 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 	}
 }
 
+// NewQueryContext returns a context to query the state committed at the given
+// height. The committed multistore is cache wrapped for safety, so that the
+// queries can't write to it.
+func (app *BaseApp) NewQueryContext(height int64) (sdk.Context, error) {
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
+		)
+	}
+
+	return sdk.NewContext(
+		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
+	).WithMinGasPrices(app.minGasPrices), nil
+}
+
 // splitPath splits a string path using the delimiter '/'.
 //
 // e.g. "this/is/funny" becomes []string{"this", "is", "funny"}
@@ -6,20 +6,21 @@ package std
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types11 "github.com/cosmos/cosmos-sdk/types"
+	types12 "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_x_auth_exported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
-	types7 "github.com/cosmos/cosmos-sdk/x/bank/types"
-	types8 "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	types8 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types9 "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	types6 "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	github_com_cosmos_cosmos_sdk_x_evidence_exported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	types3 "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	github_com_cosmos_cosmos_sdk_x_gov_types "github.com/cosmos/cosmos-sdk/x/gov/types"
 	types4 "github.com/cosmos/cosmos-sdk/x/gov/types"
+	types7 "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
-	types9 "github.com/cosmos/cosmos-sdk/x/slashing/types"
-	types10 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types10 "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	types11 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	github_com_cosmos_cosmos_sdk_x_supply_exported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	types2 "github.com/cosmos/cosmos-sdk/x/supply/types"
 	types5 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -460,6 +461,8 @@ type Content struct {
 	//	*Content_SoftwareUpgrade
 	//	*Content_CancelSoftwareUpgrade
 	//	*Content_CommunityPoolSpend
+	//	*Content_RefundStuckPacket
+	//	*Content_UpdateTransferParams
 	Sum isContent_Sum `protobuf_oneof:"sum"`
 }
 
@@ -518,12 +521,20 @@ type Content_CancelSoftwareUpgrade struct {
 type Content_CommunityPoolSpend struct {
 	CommunityPoolSpend *types6.CommunityPoolSpendProposal `protobuf:"bytes,5,opt,name=community_pool_spend,json=communityPoolSpend,proto3,oneof" json:"community_pool_spend,omitempty"`
 }
+type Content_RefundStuckPacket struct {
+	RefundStuckPacket *types7.RefundStuckPacketProposal `protobuf:"bytes,6,opt,name=refund_stuck_packet,json=refundStuckPacket,proto3,oneof" json:"refund_stuck_packet,omitempty"`
+}
+type Content_UpdateTransferParams struct {
+	UpdateTransferParams *types7.UpdateParamsProposal `protobuf:"bytes,7,opt,name=update_transfer_params,json=updateTransferParams,proto3,oneof" json:"update_transfer_params,omitempty"`
+}
 
 func (*Content_Text) isContent_Sum()                  {}
 func (*Content_ParameterChange) isContent_Sum()       {}
 func (*Content_SoftwareUpgrade) isContent_Sum()       {}
 func (*Content_CancelSoftwareUpgrade) isContent_Sum() {}
 func (*Content_CommunityPoolSpend) isContent_Sum()    {}
+func (*Content_RefundStuckPacket) isContent_Sum()     {}
+func (*Content_UpdateTransferParams) isContent_Sum()  {}
 
 func (m *Content) GetSum() isContent_Sum {
 	if m != nil {
@@ -567,6 +578,20 @@ func (m *Content) GetCommunityPoolSpend() *types6.CommunityPoolSpendProposal {
 	return nil
 }
 
+func (m *Content) GetRefundStuckPacket() *types7.RefundStuckPacketProposal {
+	if x, ok := m.GetSum().(*Content_RefundStuckPacket); ok {
+		return x.RefundStuckPacket
+	}
+	return nil
+}
+
+func (m *Content) GetUpdateTransferParams() *types7.UpdateParamsProposal {
+	if x, ok := m.GetSum().(*Content_UpdateTransferParams); ok {
+		return x.UpdateTransferParams
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Content) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -575,6 +600,8 @@ func (*Content) XXX_OneofWrappers() []interface{} {
 		(*Content_SoftwareUpgrade)(nil),
 		(*Content_CancelSoftwareUpgrade)(nil),
 		(*Content_CommunityPoolSpend)(nil),
+		(*Content_RefundStuckPacket)(nil),
+		(*Content_UpdateTransferParams)(nil),
 	}
 }
 
@@ -685,13 +712,13 @@ type isMessage_Sum interface {
 }
 
 type Message_MsgSend struct {
-	MsgSend *types7.MsgSend `protobuf:"bytes,1,opt,name=msg_send,json=msgSend,proto3,oneof" json:"msg_send,omitempty"`
+	MsgSend *types8.MsgSend `protobuf:"bytes,1,opt,name=msg_send,json=msgSend,proto3,oneof" json:"msg_send,omitempty"`
 }
 type Message_MsgMultiSend struct {
-	MsgMultiSend *types7.MsgMultiSend `protobuf:"bytes,2,opt,name=msg_multi_send,json=msgMultiSend,proto3,oneof" json:"msg_multi_send,omitempty"`
+	MsgMultiSend *types8.MsgMultiSend `protobuf:"bytes,2,opt,name=msg_multi_send,json=msgMultiSend,proto3,oneof" json:"msg_multi_send,omitempty"`
 }
 type Message_MsgVerifyInvariant struct {
-	MsgVerifyInvariant *types8.MsgVerifyInvariant `protobuf:"bytes,3,opt,name=msg_verify_invariant,json=msgVerifyInvariant,proto3,oneof" json:"msg_verify_invariant,omitempty"`
+	MsgVerifyInvariant *types9.MsgVerifyInvariant `protobuf:"bytes,3,opt,name=msg_verify_invariant,json=msgVerifyInvariant,proto3,oneof" json:"msg_verify_invariant,omitempty"`
 }
 type Message_MsgSetWithdrawAddress struct {
 	MsgSetWithdrawAddress *types6.MsgSetWithdrawAddress `protobuf:"bytes,4,opt,name=msg_set_withdraw_address,json=msgSetWithdrawAddress,proto3,oneof" json:"msg_set_withdraw_address,omitempty"`
@@ -718,22 +745,22 @@ type Message_MsgDeposit struct {
 	MsgDeposit *types4.MsgDeposit `protobuf:"bytes,11,opt,name=msg_deposit,json=msgDeposit,proto3,oneof" json:"msg_deposit,omitempty"`
 }
 type Message_MsgUnjail struct {
-	MsgUnjail *types9.MsgUnjail `protobuf:"bytes,12,opt,name=msg_unjail,json=msgUnjail,proto3,oneof" json:"msg_unjail,omitempty"`
+	MsgUnjail *types10.MsgUnjail `protobuf:"bytes,12,opt,name=msg_unjail,json=msgUnjail,proto3,oneof" json:"msg_unjail,omitempty"`
 }
 type Message_MsgCreateValidator struct {
-	MsgCreateValidator *types10.MsgCreateValidator `protobuf:"bytes,13,opt,name=msg_create_validator,json=msgCreateValidator,proto3,oneof" json:"msg_create_validator,omitempty"`
+	MsgCreateValidator *types11.MsgCreateValidator `protobuf:"bytes,13,opt,name=msg_create_validator,json=msgCreateValidator,proto3,oneof" json:"msg_create_validator,omitempty"`
 }
 type Message_MsgEditValidator struct {
-	MsgEditValidator *types10.MsgEditValidator `protobuf:"bytes,14,opt,name=msg_edit_validator,json=msgEditValidator,proto3,oneof" json:"msg_edit_validator,omitempty"`
+	MsgEditValidator *types11.MsgEditValidator `protobuf:"bytes,14,opt,name=msg_edit_validator,json=msgEditValidator,proto3,oneof" json:"msg_edit_validator,omitempty"`
 }
 type Message_MsgDelegate struct {
-	MsgDelegate *types10.MsgDelegate `protobuf:"bytes,15,opt,name=msg_delegate,json=msgDelegate,proto3,oneof" json:"msg_delegate,omitempty"`
+	MsgDelegate *types11.MsgDelegate `protobuf:"bytes,15,opt,name=msg_delegate,json=msgDelegate,proto3,oneof" json:"msg_delegate,omitempty"`
 }
 type Message_MsgBeginRedelegate struct {
-	MsgBeginRedelegate *types10.MsgBeginRedelegate `protobuf:"bytes,16,opt,name=msg_begin_redelegate,json=msgBeginRedelegate,proto3,oneof" json:"msg_begin_redelegate,omitempty"`
+	MsgBeginRedelegate *types11.MsgBeginRedelegate `protobuf:"bytes,16,opt,name=msg_begin_redelegate,json=msgBeginRedelegate,proto3,oneof" json:"msg_begin_redelegate,omitempty"`
 }
 type Message_MsgUndelegate struct {
-	MsgUndelegate *types10.MsgUndelegate `protobuf:"bytes,17,opt,name=msg_undelegate,json=msgUndelegate,proto3,oneof" json:"msg_undelegate,omitempty"`
+	MsgUndelegate *types11.MsgUndelegate `protobuf:"bytes,17,opt,name=msg_undelegate,json=msgUndelegate,proto3,oneof" json:"msg_undelegate,omitempty"`
 }
 
 func (*Message_MsgSend) isMessage_Sum()                        {}
@@ -761,21 +788,21 @@ func (m *Message) GetSum() isMessage_Sum {
 	return nil
 }
 
-func (m *Message) GetMsgSend() *types7.MsgSend {
+func (m *Message) GetMsgSend() *types8.MsgSend {
 	if x, ok := m.GetSum().(*Message_MsgSend); ok {
 		return x.MsgSend
 	}
 	return nil
 }
 
-func (m *Message) GetMsgMultiSend() *types7.MsgMultiSend {
+func (m *Message) GetMsgMultiSend() *types8.MsgMultiSend {
 	if x, ok := m.GetSum().(*Message_MsgMultiSend); ok {
 		return x.MsgMultiSend
 	}
 	return nil
 }
 
-func (m *Message) GetMsgVerifyInvariant() *types8.MsgVerifyInvariant {
+func (m *Message) GetMsgVerifyInvariant() *types9.MsgVerifyInvariant {
 	if x, ok := m.GetSum().(*Message_MsgVerifyInvariant); ok {
 		return x.MsgVerifyInvariant
 	}
@@ -838,42 +865,42 @@ func (m *Message) GetMsgDeposit() *types4.MsgDeposit {
 	return nil
 }
 
-func (m *Message) GetMsgUnjail() *types9.MsgUnjail {
+func (m *Message) GetMsgUnjail() *types10.MsgUnjail {
 	if x, ok := m.GetSum().(*Message_MsgUnjail); ok {
 		return x.MsgUnjail
 	}
 	return nil
 }
 
-func (m *Message) GetMsgCreateValidator() *types10.MsgCreateValidator {
+func (m *Message) GetMsgCreateValidator() *types11.MsgCreateValidator {
 	if x, ok := m.GetSum().(*Message_MsgCreateValidator); ok {
 		return x.MsgCreateValidator
 	}
 	return nil
 }
 
-func (m *Message) GetMsgEditValidator() *types10.MsgEditValidator {
+func (m *Message) GetMsgEditValidator() *types11.MsgEditValidator {
 	if x, ok := m.GetSum().(*Message_MsgEditValidator); ok {
 		return x.MsgEditValidator
 	}
 	return nil
 }
 
-func (m *Message) GetMsgDelegate() *types10.MsgDelegate {
+func (m *Message) GetMsgDelegate() *types11.MsgDelegate {
 	if x, ok := m.GetSum().(*Message_MsgDelegate); ok {
 		return x.MsgDelegate
 	}
 	return nil
 }
 
-func (m *Message) GetMsgBeginRedelegate() *types10.MsgBeginRedelegate {
+func (m *Message) GetMsgBeginRedelegate() *types11.MsgBeginRedelegate {
 	if x, ok := m.GetSum().(*Message_MsgBeginRedelegate); ok {
 		return x.MsgBeginRedelegate
 	}
 	return nil
 }
 
-func (m *Message) GetMsgUndelegate() *types10.MsgUndelegate {
+func (m *Message) GetMsgUndelegate() *types11.MsgUndelegate {
 	if x, ok := m.GetSum().(*Message_MsgUndelegate); ok {
 		return x.MsgUndelegate
 	}
@@ -1191,118 +1218,123 @@ func init() {
 func init() { proto.RegisterFile("codec/std/codec.proto", fileDescriptor_daf09dc2dfa19bb4) }
 
 var fileDescriptor_daf09dc2dfa19bb4 = []byte{
-	// 1764 bytes of a gzipped FileDescriptorProto
+	// 1847 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x70, 0x1b, 0x49,
-	0x15, 0x96, 0x62, 0xc5, 0x92, 0xdb, 0x8a, 0x63, 0xf7, 0x26, 0x58, 0x98, 0xac, 0x94, 0x68, 0xd9,
-	0xb0, 0x64, 0xb1, 0xb4, 0xd9, 0x00, 0xd9, 0xa8, 0xa0, 0x36, 0x91, 0x1d, 0x97, 0xcc, 0xe2, 0x25,
-	0x35, 0x4e, 0x42, 0x41, 0x2d, 0x4c, 0xb5, 0x66, 0x3a, 0xe3, 0xc6, 0xea, 0xe9, 0xd9, 0xe9, 0x1e,
-	0x45, 0xa2, 0x8a, 0x13, 0x17, 0xe0, 0xb4, 0x55, 0x14, 0xf7, 0x05, 0x8a, 0x0b, 0x5c, 0x73, 0xe4,
-	0x4c, 0x6d, 0xe5, 0x94, 0x23, 0x27, 0x43, 0x25, 0x17, 0x6a, 0x8f, 0x39, 0x70, 0xa6, 0xfa, 0x67,
-	0x46, 0x33, 0xd2, 0x48, 0x4e, 0x0e, 0x5c, 0x5c, 0xd3, 0xfd, 0xde, 0xf7, 0xbd, 0xaf, 0xbb, 0xdf,
-	0xeb, 0xd7, 0x32, 0xb8, 0xe8, 0x30, 0x17, 0x3b, 0x6d, 0x2e, 0xdc, 0xb6, 0xfa, 0x6a, 0x05, 0x21,
-	0x13, 0x0c, 0x6e, 0x3a, 0x8c, 0x53, 0xc6, 0x6d, 0xee, 0x1e, 0xb7, 0xf4, 0x3c, 0x17, 0x6e, 0x6b,
-	0x78, 0x7d, 0xeb, 0x5d, 0x71, 0x44, 0x42, 0xd7, 0x0e, 0x50, 0x28, 0xc6, 0x6d, 0xe5, 0xdb, 0xd6,
-	0xae, 0xdb, 0xe9, 0x81, 0x66, 0xd9, 0xba, 0x3a, 0xeb, 0xec, 0x31, 0x8f, 0x4d, 0xbe, 0x8c, 0xdf,
-	0x86, 0x18, 0x07, 0x98, 0xb7, 0xd5, 0x5f, 0x33, 0x55, 0x1b, 0xb5, 0x51, 0x24, 0x8e, 0xda, 0xb3,
-	0x96, 0xcb, 0xc6, 0x32, 0xc4, 0x5c, 0x10, 0xdf, 0x6b, 0xe7, 0x62, 0xfb, 0xc8, 0x3f, 0xce, 0xb1,
-	0x6c, 0x8d, 0xda, 0x4e, 0x48, 0x38, 0xe1, 0xf9, 0xbc, 0x2e, 0xe1, 0x22, 0x24, 0xfd, 0x48, 0x10,
-	0xe6, 0xe7, 0xa3, 0x79, 0x14, 0x04, 0x83, 0x71, 0x8e, 0xed, 0xd2, 0xa8, 0x8d, 0x87, 0xc4, 0xc5,
-	0xbe, 0x83, 0x73, 0xac, 0x9b, 0xa3, 0xb6, 0xc7, 0x86, 0xf9, 0x30, 0x3e, 0x40, 0xfc, 0x28, 0x7f,
-	0x21, 0x5f, 0x1b, 0xb5, 0xb9, 0x40, 0xc7, 0xf9, 0xc6, 0xb7, 0x46, 0xed, 0x00, 0x85, 0x88, 0xc6,
-	0x6b, 0x09, 0x42, 0x16, 0x30, 0x8e, 0x06, 0xd3, 0x0c, 0x51, 0xe0, 0x85, 0xc8, 0xcd, 0x51, 0xd5,
-	0xfc, 0x7b, 0x09, 0x94, 0xef, 0x38, 0x0e, 0x8b, 0x7c, 0x01, 0xf7, 0x40, 0xb5, 0x8f, 0x38, 0xb6,
-	0x91, 0x1e, 0xd7, 0x8a, 0x97, 0x8b, 0xef, 0xac, 0xbe, 0x7f, 0xa5, 0x95, 0xca, 0x83, 0x51, 0x4b,
-	0xee, 0x7b, 0x6b, 0x78, 0xbd, 0xd5, 0x45, 0x1c, 0x1b, 0x60, 0xaf, 0x60, 0xad, 0xf6, 0x27, 0x43,
-	0x38, 0x04, 0x5b, 0x0e, 0xf3, 0x05, 0xf1, 0x23, 0x16, 0x71, 0xdb, 0x9c, 0x51, 0xc2, 0x7a, 0x46,
-	0xb1, 0x7e, 0x37, 0x8f, 0x55, 0x7b, 0x4a, 0xf6, 0x9d, 0x04, 0xff, 0x50, 0x4f, 0x4e, 0x42, 0xd5,
-	0x9c, 0x39, 0x36, 0x48, 0xc1, 0xa6, 0x8b, 0x07, 0x68, 0x8c, 0xdd, 0x99, 0xa0, 0x4b, 0x2a, 0xe8,
-	0x8d, 0xc5, 0x41, 0x77, 0x35, 0x78, 0x26, 0xe2, 0x45, 0x37, 0xcf, 0x00, 0x03, 0x50, 0x0b, 0x70,
-	0x48, 0x98, 0x4b, 0x9c, 0x99, 0x78, 0x25, 0x15, 0xef, 0xdb, 0x8b, 0xe3, 0xdd, 0x33, 0xe8, 0x99,
-	0x80, 0x5f, 0x09, 0x72, 0x2d, 0xf0, 0x63, 0xb0, 0x46, 0x99, 0x1b, 0x0d, 0x26, 0x47, 0x74, 0x56,
-	0xc5, 0x79, 0x3b, 0x1b, 0x47, 0x27, 0xa8, 0x8c, 0x70, 0xa0, 0xbc, 0x27, 0xc4, 0xe7, 0x68, 0x7a,
-	0xa2, 0x73, 0xeb, 0xe9, 0x93, 0xed, 0xef, 0x5c, 0xf3, 0x88, 0x38, 0x8a, 0xfa, 0x2d, 0x87, 0x51,
-	0x53, 0xb9, 0x71, 0x35, 0x73, 0xf7, 0xb8, 0x6d, 0x0a, 0x0d, 0x8f, 0x02, 0x16, 0x0a, 0xec, 0xb6,
-	0x0c, 0xb4, 0x7b, 0x16, 0x2c, 0xf1, 0x88, 0x36, 0x7f, 0x57, 0x04, 0xcb, 0x87, 0x2a, 0x1c, 0xfc,
-	0x00, 0x2c, 0xeb, 0xc0, 0x26, 0x6f, 0xea, 0xf3, 0x44, 0x69, 0xff, 0x5e, 0xc1, 0x32, 0xfe, 0x9d,
-	0x0f, 0xff, 0xf3, 0x79, 0xa3, 0xf8, 0xf4, 0xc9, 0xf6, 0xcd, 0xd3, 0xa4, 0x98, 0xca, 0x4b, 0xc4,
-	0x68, 0xa6, 0xfd, 0x58, 0xcc, 0x9f, 0x8a, 0xa0, 0x72, 0xd7, 0x14, 0x20, 0xfc, 0x21, 0xa8, 0xe2,
-	0x4f, 0x23, 0x32, 0x64, 0x0e, 0x92, 0xa5, 0x6c, 0x44, 0x5d, 0xcd, 0x8a, 0x8a, 0xcb, 0x55, 0xca,
-	0xba, 0x9b, 0xf2, 0xee, 0x15, 0xac, 0x0c, 0xba, 0x73, 0xc7, 0x48, 0xbc, 0x75, 0x8a, 0xc2, 0xa4,
-	0xfe, 0x13, 0x8d, 0xb1, 0xa0, 0x58, 0xe4, 0xdf, 0x8a, 0x60, 0xe3, 0x80, 0x7b, 0x87, 0x51, 0x9f,
-	0x12, 0x91, 0xa8, 0x3d, 0x00, 0x25, 0x59, 0x41, 0x46, 0x65, 0x7b, 0xbe, 0xca, 0x19, 0xa8, 0xac,
-	0xc3, 0x6e, 0xe5, 0x8b, 0x93, 0x46, 0xe1, 0xd9, 0x49, 0xa3, 0x68, 0x29, 0x1a, 0xf8, 0x7d, 0x50,
-	0x89, 0x41, 0xa6, 0xde, 0x32, 0x55, 0x9c, 0xbe, 0xcd, 0x13, 0x81, 0x56, 0x02, 0xe9, 0x54, 0x7e,
-	0xf3, 0x79, 0xa3, 0x20, 0x57, 0xdc, 0xfc, 0x73, 0x5a, 0xed, 0x3d, 0x73, 0xbb, 0xc0, 0x5e, 0x46,
-	0xed, 0xb5, 0xac, 0x5a, 0x8f, 0x0d, 0x33, 0x42, 0x63, 0x54, 0xae, 0xd0, 0x0e, 0x28, 0xcb, 0x72,
-	0xc6, 0xc9, 0xbd, 0x70, 0x79, 0xae, 0xce, 0x1d, 0xed, 0x67, 0xc5, 0x80, 0x94, 0xca, 0xdf, 0x17,
-	0x41, 0x25, 0x11, 0xf7, 0x61, 0x46, 0xdc, 0x95, 0x5c, 0x71, 0x0b, 0x35, 0xdd, 0x7e, 0x6d, 0x4d,
-	0xdd, 0x92, 0xa4, 0x98, 0x28, 0x2b, 0x29, 0x55, 0x7f, 0x2c, 0x81, 0xb2, 0x71, 0x80, 0x37, 0x41,
-	0x49, 0xe0, 0x91, 0x58, 0x28, 0xea, 0x3e, 0x1e, 0x25, 0x9b, 0xd5, 0x2b, 0x58, 0x0a, 0x00, 0x3f,
-	0x01, 0xeb, 0xea, 0x86, 0xc7, 0x02, 0x87, 0xb6, 0x73, 0x84, 0x7c, 0x2f, 0x3e, 0xd1, 0xa9, 0x24,
-	0xd1, 0x7d, 0x40, 0x2d, 0x2e, 0xf6, 0xdf, 0x51, 0xee, 0x29, 0xca, 0xf3, 0x41, 0xd6, 0x04, 0x7f,
-	0x06, 0xd6, 0x39, 0x7b, 0x24, 0x1e, 0xa3, 0x10, 0xdb, 0xa6, 0x47, 0x98, 0xab, 0xf2, 0xbd, 0x2c,
-	0xbb, 0x31, 0xaa, 0xf2, 0x35, 0x80, 0x07, 0x7a, 0x2a, 0x4d, 0xcf, 0xb3, 0x26, 0x18, 0x80, 0x4d,
-	0x07, 0xf9, 0x0e, 0x1e, 0xd8, 0x33, 0x51, 0x4a, 0x79, 0x5d, 0x20, 0x15, 0x65, 0x47, 0xe1, 0xe6,
-	0xc7, 0xba, 0xe8, 0xe4, 0x39, 0xc0, 0x01, 0xb8, 0xe0, 0x30, 0x4a, 0x23, 0x9f, 0x88, 0xb1, 0x1d,
-	0x30, 0x36, 0xb0, 0x79, 0x80, 0x7d, 0xd7, 0xdc, 0x93, 0x1f, 0x64, 0xc3, 0xa5, 0x5b, 0xbd, 0x3e,
-	0x4d, 0x83, 0xbc, 0xc7, 0xd8, 0xe0, 0x50, 0xe2, 0x52, 0x01, 0xa1, 0x33, 0x63, 0xed, 0xdc, 0x32,
-	0xb7, 0xc2, 0xf5, 0xd3, 0x2e, 0xae, 0xa4, 0xf1, 0x27, 0x19, 0x63, 0x6e, 0x83, 0x3f, 0x14, 0xc1,
-	0xea, 0xfd, 0x10, 0xf9, 0x1c, 0x39, 0x52, 0x05, 0xdc, 0xcd, 0x24, 0x6f, 0x73, 0x6e, 0xe2, 0x1d,
-	0x0a, 0xf7, 0xfe, 0x48, 0x65, 0x6f, 0x35, 0xce, 0xde, 0x2f, 0x65, 0x0a, 0xc6, 0x55, 0x55, 0xa2,
-	0xdc, 0xe3, 0xb5, 0x33, 0x97, 0x97, 0x16, 0xa6, 0xef, 0x01, 0xe6, 0x1c, 0x79, 0xd8, 0xa4, 0xaf,
-	0xc2, 0x74, 0x4a, 0xb2, 0xaa, 0x9a, 0xff, 0xa8, 0x82, 0xb2, 0xb1, 0xc2, 0x0e, 0xa8, 0x50, 0xee,
-	0xd9, 0x5c, 0xee, 0xa3, 0xd6, 0xf5, 0x66, 0x76, 0x1f, 0xe5, 0x43, 0x2b, 0x2e, 0x79, 0xec, 0xbb,
-	0xbd, 0x82, 0x55, 0xa6, 0xfa, 0x13, 0xfe, 0x00, 0xac, 0x49, 0x2c, 0x8d, 0x06, 0x82, 0x68, 0x86,
-	0x33, 0xb3, 0x2b, 0xcb, 0x30, 0x1c, 0x48, 0x57, 0x43, 0x53, 0xa5, 0xa9, 0x31, 0xfc, 0x39, 0xb8,
-	0x20, 0xb9, 0x86, 0x38, 0x24, 0x8f, 0xc6, 0x36, 0xf1, 0x87, 0x28, 0x24, 0x28, 0xe9, 0xed, 0x53,
-	0xb7, 0x90, 0x7e, 0xe2, 0x19, 0xce, 0x87, 0x0a, 0xb2, 0x1f, 0x23, 0xe4, 0x69, 0xd2, 0x99, 0x59,
-	0xe8, 0x83, 0x9a, 0x5e, 0xa7, 0xb0, 0x1f, 0x13, 0x71, 0xe4, 0x86, 0xe8, 0xb1, 0x8d, 0x5c, 0x37,
-	0xc4, 0x9c, 0x9b, 0x74, 0xbd, 0xb1, 0x38, 0x7f, 0xd4, 0xfa, 0xc5, 0x8f, 0x0d, 0xf6, 0x8e, 0x86,
-	0xca, 0x5c, 0xa5, 0x79, 0x06, 0xf8, 0x2b, 0xf0, 0xa6, 0x8c, 0x97, 0xc4, 0x72, 0xf1, 0x00, 0x7b,
-	0x48, 0xb0, 0xd0, 0x0e, 0xf1, 0x63, 0x14, 0xbe, 0x62, 0xd2, 0x1e, 0x70, 0x2f, 0x26, 0xde, 0x8d,
-	0x09, 0x2c, 0x85, 0xef, 0x15, 0xac, 0x2d, 0x3a, 0xd7, 0x0a, 0x7f, 0x5b, 0x04, 0x57, 0x32, 0xf1,
-	0x87, 0x68, 0x40, 0x5c, 0x15, 0x5f, 0xa6, 0x3a, 0xe1, 0x5c, 0xb6, 0xcd, 0x65, 0xa5, 0xe1, 0x7b,
-	0xaf, 0xac, 0xe1, 0x61, 0x4c, 0xb2, 0x93, 0x70, 0xf4, 0x0a, 0x56, 0x9d, 0x2e, 0xf4, 0x80, 0xc7,
-	0x60, 0x53, 0x4a, 0x79, 0x14, 0xf9, 0xae, 0x9d, 0xad, 0xdf, 0x5a, 0x59, 0x09, 0x78, 0xff, 0x54,
-	0x01, 0x7b, 0x91, 0xef, 0x66, 0x0a, 0xb8, 0x57, 0xb0, 0x64, 0xbe, 0xcc, 0xcc, 0xc3, 0x4f, 0xc0,
-	0x1b, 0xea, 0x9c, 0x55, 0x77, 0xb2, 0x93, 0x3e, 0x59, 0x99, 0x4d, 0xa3, 0x6c, 0xb1, 0x4c, 0x77,
-	0xde, 0x5e, 0xc1, 0xda, 0xa0, 0x33, 0x9d, 0x3c, 0xcb, 0x1e, 0x3f, 0xc8, 0x6b, 0x2b, 0xaf, 0xca,
-	0x9e, 0xba, 0x72, 0x26, 0xec, 0x49, 0x73, 0xbb, 0xa5, 0x6b, 0x71, 0xc8, 0x04, 0xae, 0x01, 0x45,
-	0x79, 0x69, 0x5e, 0xf7, 0x7d, 0xc8, 0x04, 0x36, 0xa5, 0x28, 0x3f, 0x61, 0x17, 0xac, 0x4a, 0xa8,
-	0x8b, 0x03, 0xc6, 0x89, 0xa8, 0xad, 0x2a, 0x74, 0x63, 0x1e, 0x7a, 0x57, 0xbb, 0xf5, 0x0a, 0x16,
-	0xa0, 0xc9, 0x08, 0xee, 0x02, 0x39, 0xb2, 0x23, 0xff, 0x17, 0x88, 0x0c, 0x6a, 0x55, 0x45, 0xf1,
-	0xd6, 0xd4, 0x3b, 0xcf, 0xfc, 0x94, 0x31, 0x3c, 0x0f, 0x94, 0x6b, 0xaf, 0x60, 0xad, 0xd0, 0x78,
-	0x00, 0x6d, 0x5d, 0xc8, 0x4e, 0x88, 0x91, 0xc0, 0x93, 0xb4, 0xab, 0x9d, 0x53, 0x7c, 0xef, 0x4e,
-	0xf1, 0xe9, 0x1f, 0x3f, 0x86, 0x6e, 0x47, 0x61, 0x92, 0x14, 0x32, 0x95, 0x3c, 0x35, 0x0b, 0x7f,
-	0x02, 0xe4, 0xac, 0x8d, 0x5d, 0x22, 0x52, 0xf4, 0x6b, 0x8a, 0xfe, 0x9b, 0x8b, 0xe8, 0xef, 0xba,
-	0x44, 0xa4, 0xc9, 0xd7, 0xe9, 0xd4, 0x1c, 0xdc, 0x07, 0x55, 0xbd, 0x8b, 0xaa, 0x98, 0x70, 0xed,
-	0xbc, 0x22, 0xfd, 0xfa, 0x22, 0x52, 0x53, 0x78, 0xf2, 0x30, 0x56, 0xe9, 0x64, 0x18, 0x6f, 0x43,
-	0x1f, 0x7b, 0xc4, 0xb7, 0x43, 0x9c, 0x50, 0xae, 0x9f, 0xbe, 0x0d, 0x5d, 0x89, 0xb1, 0x12, 0x88,
-	0xd9, 0x86, 0xa9, 0x59, 0xf8, 0x23, 0x7d, 0xf9, 0x46, 0x7e, 0x42, 0xbd, 0x91, 0xf7, 0x08, 0xce,
-	0x52, 0x3f, 0xf0, 0x53, 0xac, 0xe7, 0x68, 0x7a, 0xa2, 0x73, 0xed, 0xe9, 0x93, 0xed, 0xab, 0x0b,
-	0x5b, 0x9d, 0x6e, 0x72, 0x52, 0xa1, 0x69, 0x70, 0x9f, 0x15, 0x41, 0xf9, 0x90, 0x78, 0xfe, 0x2e,
-	0x73, 0xe0, 0x7e, 0xa6, 0xb9, 0x7d, 0x63, 0x51, 0x73, 0x33, 0x90, 0xff, 0x47, 0x87, 0x6b, 0xfe,
-	0x5a, 0xfe, 0x66, 0x11, 0xee, 0x1e, 0x96, 0xef, 0x9f, 0x65, 0x44, 0xcd, 0x6f, 0x5d, 0x49, 0xf4,
-	0x46, 0x9a, 0x48, 0xbd, 0x08, 0x88, 0xdf, 0x7d, 0x4f, 0x62, 0xff, 0xfa, 0xaf, 0xc6, 0x3b, 0xaf,
-	0xb0, 0x72, 0x09, 0xe0, 0x96, 0x21, 0x85, 0xeb, 0x60, 0xc9, 0x43, 0x5c, 0xb5, 0xbc, 0x92, 0x25,
-	0x3f, 0x53, 0x6f, 0xd6, 0x5f, 0x82, 0xaa, 0x59, 0x27, 0x12, 0x51, 0x88, 0xe1, 0x1e, 0x28, 0x07,
-	0x51, 0xdf, 0x3e, 0xc6, 0xfa, 0xf7, 0x53, 0xb5, 0xbb, 0xfd, 0xe5, 0x49, 0xe3, 0x42, 0x10, 0xf5,
-	0x07, 0xc4, 0x91, 0xb3, 0xdf, 0x62, 0x94, 0x08, 0x4c, 0x03, 0x31, 0x7e, 0x79, 0xd2, 0xd8, 0x18,
-	0x23, 0x3a, 0xe8, 0x34, 0x27, 0xd6, 0xa6, 0xb5, 0x1c, 0x44, 0xfd, 0x8f, 0xf0, 0x18, 0x5e, 0x02,
-	0x2b, 0x3c, 0x26, 0x55, 0x91, 0xab, 0xd6, 0x64, 0xc2, 0x74, 0xf7, 0xbf, 0x14, 0xc1, 0x4a, 0xf2,
-	0x82, 0x80, 0x37, 0xc1, 0xd2, 0x23, 0x1c, 0x9f, 0x4a, 0x63, 0xd1, 0xa9, 0xec, 0xe1, 0x78, 0x27,
-	0x25, 0x02, 0x7e, 0x04, 0x40, 0xc2, 0x1c, 0x1f, 0xc5, 0xdb, 0xa7, 0x9d, 0xaa, 0xf2, 0x36, 0x2c,
-	0x29, 0x38, 0x84, 0xa0, 0x44, 0x31, 0x65, 0xaa, 0x9b, 0xaf, 0x58, 0xea, 0xbb, 0xf9, 0xdf, 0x22,
-	0x58, 0xcb, 0x26, 0x83, 0xbc, 0x00, 0x9d, 0x23, 0x44, 0x7c, 0x9b, 0xe8, 0xc7, 0xc8, 0x4a, 0xb7,
-	0xfe, 0xfc, 0xa4, 0x51, 0xde, 0x91, 0x73, 0xfb, 0xbb, 0x2f, 0x4f, 0x1a, 0xe7, 0xf5, 0xd6, 0xc4,
-	0x4e, 0x4d, 0xab, 0xac, 0x3e, 0xf7, 0x5d, 0x78, 0x1b, 0xac, 0x99, 0x9f, 0xcd, 0xb6, 0x1f, 0xd1,
-	0x3e, 0x0e, 0xf5, 0xc1, 0x74, 0xbf, 0xfa, 0xf2, 0xa4, 0x71, 0x51, 0xa3, 0xb2, 0xf6, 0xa6, 0x75,
-	0xce, 0x4c, 0x7c, 0xac, 0xc6, 0x70, 0x0b, 0x54, 0x38, 0xfe, 0x34, 0x52, 0xed, 0x62, 0x49, 0x1d,
-	0x6a, 0x32, 0x4e, 0xf4, 0x97, 0x26, 0xfa, 0xe3, 0x9d, 0x3d, 0xfb, 0xba, 0x3b, 0xdb, 0xbd, 0xfd,
-	0xc5, 0xf3, 0x7a, 0xf1, 0xd9, 0xf3, 0x7a, 0xf1, 0xdf, 0xcf, 0xeb, 0xc5, 0xcf, 0x5e, 0xd4, 0x0b,
-	0xcf, 0x5e, 0xd4, 0x0b, 0xff, 0x7c, 0x51, 0x2f, 0xfc, 0x74, 0x71, 0xf9, 0x25, 0xff, 0xc8, 0xeb,
-	0x2f, 0xab, 0x7f, 0xef, 0xdc, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89, 0xf5, 0x71, 0xb9,
-	0xdc, 0x13, 0x00, 0x00,
+	0x15, 0x96, 0x62, 0xc5, 0xb2, 0xdb, 0x8a, 0x63, 0x77, 0x92, 0xb5, 0x30, 0x59, 0x29, 0xd1, 0xb2,
+	0x61, 0xc9, 0x62, 0x29, 0xc9, 0x02, 0xd9, 0xa8, 0xa0, 0x36, 0x91, 0x1d, 0x97, 0xcc, 0xe2, 0x25,
+	0x35, 0x4e, 0x42, 0x41, 0x2d, 0x4c, 0xb5, 0x66, 0xda, 0xe3, 0x5e, 0xab, 0xa7, 0x67, 0xa7, 0x7b,
+	0x14, 0x89, 0x2a, 0x4e, 0x5c, 0x80, 0xd3, 0x56, 0x51, 0xdc, 0xb7, 0xa0, 0xb8, 0xc0, 0x35, 0x47,
+	0xce, 0xd4, 0x56, 0x4e, 0x39, 0x72, 0x32, 0x54, 0x72, 0xa1, 0xf6, 0x98, 0x03, 0xc5, 0x91, 0xea,
+	0x9f, 0x19, 0xcd, 0x48, 0x23, 0x39, 0x7b, 0xe0, 0xa2, 0x9a, 0x7e, 0xef, 0x7d, 0xdf, 0xfb, 0xa6,
+	0xbb, 0xdf, 0xeb, 0x1e, 0x81, 0x4b, 0x0e, 0x73, 0xb1, 0xd3, 0xe2, 0xc2, 0x6d, 0xa9, 0xa7, 0x66,
+	0x10, 0x32, 0xc1, 0xe0, 0x86, 0xc3, 0x38, 0x65, 0xdc, 0xe6, 0xee, 0x71, 0x53, 0xdb, 0xb9, 0x70,
+	0x9b, 0x83, 0x9b, 0x9b, 0xef, 0x8a, 0x23, 0x12, 0xba, 0x76, 0x80, 0x42, 0x31, 0x6a, 0xa9, 0xd8,
+	0x96, 0x0e, 0xdd, 0x4a, 0x0f, 0x34, 0xcb, 0xe6, 0xb5, 0xe9, 0x60, 0x8f, 0x79, 0x6c, 0xfc, 0x64,
+	0xe2, 0xd6, 0xc5, 0x28, 0xc0, 0xbc, 0xa5, 0x7e, 0x8d, 0xa9, 0x3a, 0x6c, 0xa1, 0x48, 0x1c, 0xb5,
+	0xa6, 0x3d, 0x57, 0x8c, 0x67, 0x80, 0xb9, 0x20, 0xbe, 0xd7, 0xca, 0xc5, 0xf6, 0x90, 0x7f, 0x9c,
+	0xe3, 0xd9, 0x1c, 0xb6, 0x9c, 0x90, 0x70, 0xc2, 0xf3, 0x79, 0x5d, 0xc2, 0x45, 0x48, 0x7a, 0x91,
+	0x20, 0xcc, 0xcf, 0x47, 0xf3, 0x28, 0x08, 0xfa, 0xa3, 0x1c, 0xdf, 0xe5, 0x61, 0x0b, 0x0f, 0x88,
+	0x8b, 0x7d, 0x07, 0xe7, 0x78, 0x37, 0x86, 0x2d, 0x8f, 0x0d, 0xf2, 0x61, 0xbc, 0x8f, 0xf8, 0x51,
+	0xfe, 0x8b, 0x7c, 0x7d, 0xd8, 0xe2, 0x02, 0x1d, 0xe7, 0x3b, 0xdf, 0x1a, 0xb6, 0x02, 0x14, 0x22,
+	0x1a, 0xbf, 0x4b, 0x10, 0xb2, 0x80, 0x71, 0xd4, 0x9f, 0x64, 0x88, 0x02, 0x2f, 0x44, 0x2e, 0xce,
+	0x67, 0x20, 0x3d, 0xa7, 0x75, 0xeb, 0xc6, 0x96, 0x08, 0x91, 0xcf, 0x0f, 0x71, 0x38, 0x1d, 0xd4,
+	0xf8, 0x5b, 0x09, 0x94, 0xef, 0x39, 0x0e, 0x8b, 0x7c, 0x01, 0x77, 0x41, 0xa5, 0x87, 0x38, 0xb6,
+	0x91, 0x1e, 0x57, 0x8b, 0x57, 0x8a, 0xef, 0xac, 0xdc, 0xba, 0xda, 0x4c, 0x6d, 0x96, 0x61, 0x53,
+	0x2e, 0x4e, 0x73, 0x70, 0xb3, 0xd9, 0x41, 0x1c, 0x1b, 0x60, 0xb7, 0x60, 0xad, 0xf4, 0xc6, 0x43,
+	0x38, 0x00, 0x9b, 0x0e, 0xf3, 0x05, 0xf1, 0x23, 0x16, 0x71, 0xdb, 0x2c, 0x64, 0xc2, 0x7a, 0x46,
+	0xb1, 0x7e, 0x2f, 0x8f, 0x55, 0x47, 0x4a, 0xf6, 0xed, 0x04, 0xff, 0x58, 0x1b, 0xc7, 0xa9, 0xaa,
+	0xce, 0x0c, 0x1f, 0xa4, 0x60, 0xc3, 0xc5, 0x7d, 0x34, 0xc2, 0xee, 0x54, 0xd2, 0x05, 0x95, 0xf4,
+	0xbd, 0xf9, 0x49, 0x77, 0x34, 0x78, 0x2a, 0xe3, 0x25, 0x37, 0xcf, 0x01, 0x03, 0x50, 0x0d, 0x70,
+	0x48, 0x98, 0x4b, 0x9c, 0xa9, 0x7c, 0x25, 0x95, 0xef, 0x3b, 0xf3, 0xf3, 0x3d, 0x30, 0xe8, 0xa9,
+	0x84, 0x6f, 0x04, 0xb9, 0x1e, 0xf8, 0x11, 0x58, 0xa5, 0xcc, 0x8d, 0xfa, 0xe3, 0x25, 0x3a, 0xab,
+	0xf2, 0xbc, 0x9d, 0xcd, 0xa3, 0x77, 0xb1, 0xcc, 0xb0, 0xaf, 0xa2, 0xc7, 0xc4, 0xe7, 0x68, 0xda,
+	0xd0, 0xbe, 0xf3, 0xec, 0xe9, 0xd6, 0x77, 0xaf, 0x7b, 0x44, 0x1c, 0x45, 0xbd, 0xa6, 0xc3, 0xa8,
+	0x29, 0xef, 0xb8, 0xe4, 0xb9, 0x7b, 0xdc, 0x32, 0xd5, 0x88, 0x87, 0x01, 0x0b, 0x05, 0x76, 0x9b,
+	0x06, 0xda, 0x39, 0x0b, 0x16, 0x78, 0x44, 0x1b, 0xbf, 0x2b, 0x82, 0xc5, 0x03, 0x95, 0x0e, 0xbe,
+	0x0f, 0x16, 0x75, 0x62, 0xb3, 0x6f, 0x6a, 0xb3, 0x44, 0xe9, 0xf8, 0x6e, 0xc1, 0x32, 0xf1, 0xed,
+	0x0f, 0xfe, 0xfd, 0x79, 0xbd, 0xf8, 0xec, 0xe9, 0xd6, 0xed, 0xd3, 0xa4, 0x98, 0xf2, 0x4c, 0xc4,
+	0x68, 0xa6, 0xbd, 0x58, 0xcc, 0x1f, 0x8b, 0x60, 0xe9, 0xbe, 0xa9, 0x52, 0xf8, 0x23, 0x50, 0xc1,
+	0x9f, 0x46, 0x64, 0xc0, 0x1c, 0x24, 0xeb, 0xdd, 0x88, 0xba, 0x96, 0x15, 0x15, 0xd7, 0xb4, 0x94,
+	0x75, 0x3f, 0x15, 0xdd, 0x2d, 0x58, 0x19, 0x74, 0xfb, 0x9e, 0x91, 0x78, 0xe7, 0x14, 0x85, 0x49,
+	0x93, 0x48, 0x34, 0xc6, 0x82, 0x62, 0x91, 0x7f, 0x2d, 0x82, 0xf5, 0x7d, 0xee, 0x1d, 0x44, 0x3d,
+	0x4a, 0x44, 0xa2, 0x76, 0x1f, 0x94, 0x64, 0x05, 0x19, 0x95, 0xad, 0xd9, 0x2a, 0xa7, 0xa0, 0xb2,
+	0x0e, 0x3b, 0x4b, 0x5f, 0x9c, 0xd4, 0x0b, 0xcf, 0x4f, 0xea, 0x45, 0x4b, 0xd1, 0xc0, 0x1f, 0x80,
+	0xa5, 0x18, 0x54, 0x3d, 0x33, 0x5d, 0xc5, 0xe9, 0x96, 0x9f, 0x08, 0xb4, 0x12, 0x48, 0x7b, 0xe9,
+	0x37, 0x9f, 0xd7, 0x0b, 0xf2, 0x8d, 0x1b, 0x7f, 0x4a, 0xab, 0x7d, 0x60, 0x5a, 0x10, 0xec, 0x66,
+	0xd4, 0x5e, 0xcf, 0xaa, 0xf5, 0xd8, 0x20, 0x23, 0x34, 0x46, 0xe5, 0x0a, 0x6d, 0x83, 0xb2, 0x2c,
+	0x67, 0x9c, 0xf4, 0x85, 0x2b, 0x33, 0x75, 0x6e, 0xeb, 0x38, 0x2b, 0x06, 0xa4, 0x54, 0xfe, 0xbe,
+	0x08, 0x96, 0x12, 0x71, 0x1f, 0x64, 0xc4, 0x5d, 0xcd, 0x15, 0x37, 0x57, 0xd3, 0xdd, 0xaf, 0xac,
+	0xa9, 0x53, 0x92, 0x14, 0x63, 0x65, 0x25, 0xa5, 0xea, 0xbf, 0x67, 0x41, 0xd9, 0x04, 0xc0, 0xdb,
+	0xa0, 0x24, 0xf0, 0x50, 0xcc, 0x15, 0xf5, 0x10, 0x0f, 0x93, 0xc9, 0xea, 0x16, 0x2c, 0x05, 0x80,
+	0x1f, 0x83, 0x35, 0x75, 0x0c, 0x60, 0x81, 0x43, 0xdb, 0x39, 0x42, 0xbe, 0x17, 0xaf, 0xe8, 0xc4,
+	0x26, 0x51, 0x51, 0x5c, 0xbd, 0x5c, 0x1c, 0xbf, 0xad, 0xc2, 0x53, 0x94, 0xe7, 0x83, 0xac, 0x0b,
+	0xfe, 0x1c, 0xac, 0x71, 0x76, 0x28, 0x9e, 0xa0, 0x10, 0xdb, 0xe6, 0x20, 0x31, 0xad, 0xf2, 0x46,
+	0x96, 0xdd, 0x38, 0x55, 0xf9, 0x1a, 0xc0, 0x23, 0x6d, 0x4a, 0xd3, 0xf3, 0xac, 0x0b, 0x06, 0x60,
+	0xc3, 0x41, 0xbe, 0x83, 0xfb, 0xf6, 0x54, 0x96, 0x52, 0xde, 0x29, 0x90, 0xca, 0xb2, 0xad, 0x70,
+	0xb3, 0x73, 0x5d, 0x72, 0xf2, 0x02, 0x60, 0x1f, 0x5c, 0x74, 0x18, 0xa5, 0x91, 0x4f, 0xc4, 0xc8,
+	0x0e, 0x18, 0xeb, 0xdb, 0x3c, 0xc0, 0xbe, 0x6b, 0xfa, 0xe4, 0xfb, 0xd9, 0x74, 0xe9, 0xfb, 0x80,
+	0x5e, 0x4d, 0x83, 0x7c, 0xc0, 0x58, 0xff, 0x40, 0xe2, 0x52, 0x09, 0xa1, 0x33, 0xe5, 0x85, 0x04,
+	0x5c, 0x08, 0xf1, 0x61, 0xe4, 0xbb, 0x36, 0x17, 0x91, 0x73, 0x6c, 0x07, 0xc8, 0x39, 0xc6, 0xa2,
+	0xba, 0xa8, 0x92, 0xdd, 0xce, 0x26, 0x23, 0x3d, 0xa7, 0x19, 0x9f, 0xc3, 0x32, 0x99, 0xa5, 0x80,
+	0x07, 0x12, 0xf7, 0x40, 0xc1, 0x52, 0xb9, 0xd6, 0xc3, 0x49, 0x27, 0xfc, 0x04, 0xbc, 0x11, 0x05,
+	0x2e, 0x12, 0xd8, 0x8e, 0x49, 0x6c, 0xbd, 0xe2, 0xd5, 0xb2, 0xca, 0x76, 0x6b, 0x7e, 0xb6, 0x47,
+	0x0a, 0xab, 0x76, 0x06, 0x4f, 0x25, 0xba, 0xa8, 0x39, 0x1f, 0x9a, 0x48, 0xed, 0x6f, 0xdf, 0x31,
+	0xcd, 0xee, 0xe6, 0x69, 0xfd, 0x38, 0xb9, 0xf4, 0x24, 0x85, 0x60, 0x9a, 0xdc, 0x1f, 0x8a, 0x60,
+	0x45, 0x91, 0x22, 0x47, 0x4e, 0x2e, 0xdc, 0xc9, 0xd4, 0x64, 0x63, 0x66, 0x3d, 0x1d, 0x08, 0xf7,
+	0xe1, 0x50, 0x15, 0x65, 0x25, 0x2e, 0xca, 0x2f, 0x65, 0x65, 0xc5, 0xcd, 0xa2, 0x44, 0xb9, 0xc7,
+	0xab, 0x67, 0xae, 0x2c, 0xcc, 0xad, 0xca, 0x7d, 0xcc, 0x39, 0xf2, 0xb0, 0xa9, 0x4a, 0x85, 0x69,
+	0x97, 0x64, 0xb3, 0x68, 0xfc, 0xbd, 0x02, 0xca, 0xc6, 0x0b, 0xdb, 0x60, 0x89, 0x72, 0xcf, 0xe6,
+	0x72, 0x7b, 0x68, 0x5d, 0x6f, 0x66, 0xe7, 0x50, 0x5e, 0x32, 0xe3, 0x4e, 0x86, 0x7d, 0xb7, 0x5b,
+	0xb0, 0xca, 0x54, 0x3f, 0xc2, 0x1f, 0x82, 0x55, 0x89, 0xa5, 0x51, 0x5f, 0x10, 0xcd, 0x70, 0x66,
+	0xfa, 0xcd, 0x32, 0x0c, 0xfb, 0x32, 0xd4, 0xd0, 0x54, 0x68, 0x6a, 0x0c, 0x7f, 0x01, 0x2e, 0x4a,
+	0xae, 0x01, 0x0e, 0xc9, 0xe1, 0xc8, 0x26, 0xfe, 0x00, 0x85, 0x04, 0x25, 0x57, 0x96, 0x89, 0xe6,
+	0xaa, 0xaf, 0xb7, 0x86, 0xf3, 0xb1, 0x82, 0xec, 0xc5, 0x08, 0xb9, 0x49, 0xe9, 0x94, 0x15, 0xfa,
+	0xa0, 0xaa, 0xdf, 0x53, 0xd8, 0x4f, 0x88, 0x38, 0x72, 0x43, 0xf4, 0xc4, 0x46, 0xae, 0x1b, 0x62,
+	0xce, 0xab, 0xa5, 0xbc, 0x6b, 0xd1, 0x64, 0x59, 0xa8, 0xf7, 0x17, 0x3f, 0x31, 0xd8, 0x7b, 0x1a,
+	0x2a, 0x4b, 0x90, 0xe6, 0x39, 0xe0, 0xaf, 0xc0, 0x9b, 0x32, 0x5f, 0x92, 0xcb, 0xc5, 0x7d, 0xec,
+	0x21, 0xc1, 0x42, 0x3b, 0xc4, 0x4f, 0x50, 0xf8, 0x9a, 0xb5, 0xb8, 0xcf, 0xbd, 0x98, 0x78, 0x27,
+	0x26, 0xb0, 0x14, 0xbe, 0x5b, 0xb0, 0x36, 0xe9, 0x4c, 0x2f, 0xfc, 0x6d, 0x11, 0x5c, 0xcd, 0xe4,
+	0x1f, 0xa0, 0x3e, 0x71, 0x55, 0x7e, 0x59, 0xc1, 0x84, 0x73, 0x79, 0x1b, 0xd0, 0x25, 0xfa, 0xfd,
+	0xd7, 0xd6, 0xf0, 0x38, 0x26, 0xd9, 0x4e, 0x38, 0xba, 0x05, 0xab, 0x46, 0xe7, 0x46, 0xc0, 0x63,
+	0xb0, 0x21, 0xa5, 0xa8, 0x0e, 0x91, 0x6d, 0x4b, 0xf9, 0x55, 0x9b, 0x23, 0x60, 0x37, 0xf2, 0xdd,
+	0x4c, 0x5f, 0x92, 0x55, 0x4b, 0x73, 0xec, 0xf0, 0x63, 0x70, 0x41, 0xad, 0xb3, 0x3a, 0x74, 0xed,
+	0xe4, 0xf8, 0x5f, 0x9a, 0xde, 0x46, 0xd9, 0x62, 0x99, 0xbc, 0x50, 0xc8, 0xfe, 0x43, 0x27, 0x8d,
+	0x13, 0xec, 0xf1, 0xc7, 0x48, 0x75, 0xf9, 0x75, 0xd9, 0xd3, 0xdd, 0x8d, 0x4e, 0x1a, 0xe1, 0x1d,
+	0x5d, 0x8b, 0x03, 0x26, 0x70, 0x15, 0x28, 0xca, 0xcb, 0xb3, 0x2e, 0x15, 0x8f, 0x99, 0xc0, 0xa6,
+	0x14, 0xe5, 0x23, 0xec, 0x80, 0x15, 0x09, 0x75, 0x71, 0xc0, 0x38, 0x11, 0xd5, 0x15, 0x85, 0xae,
+	0xcf, 0x42, 0xef, 0xe8, 0xb0, 0x6e, 0xc1, 0x02, 0x34, 0x19, 0xc1, 0x1d, 0x20, 0x47, 0x76, 0xe4,
+	0x7f, 0x82, 0x48, 0xbf, 0x5a, 0x51, 0x14, 0x6f, 0x65, 0x29, 0xe2, 0xcf, 0x38, 0xc3, 0xf3, 0x48,
+	0x85, 0x76, 0x0b, 0xd6, 0x32, 0x8d, 0x07, 0xd0, 0xd6, 0x85, 0xec, 0x84, 0x58, 0xb6, 0xe9, 0x64,
+	0xdb, 0x55, 0xcf, 0x29, 0xbe, 0x77, 0x27, 0xf8, 0xf4, 0x87, 0x9f, 0xa1, 0xdb, 0x56, 0x98, 0x64,
+	0x0b, 0x99, 0x4a, 0x9e, 0xb0, 0xc2, 0x9f, 0x02, 0x69, 0xb5, 0xb1, 0x4b, 0x44, 0x8a, 0x7e, 0x55,
+	0xd1, 0x7f, 0x6b, 0x1e, 0xfd, 0x7d, 0x97, 0x88, 0x34, 0xf9, 0x1a, 0x9d, 0xb0, 0xc1, 0x3d, 0x50,
+	0xd1, 0xb3, 0xa8, 0x8a, 0x09, 0x57, 0xcf, 0x2b, 0xd2, 0x6f, 0xcc, 0x23, 0x35, 0x85, 0x27, 0x17,
+	0x63, 0x85, 0x8e, 0x87, 0xf1, 0x34, 0xf4, 0xb0, 0x47, 0x7c, 0x3b, 0xc4, 0x09, 0xe5, 0xda, 0xe9,
+	0xd3, 0xd0, 0x91, 0x18, 0x2b, 0x81, 0x98, 0x69, 0x98, 0xb0, 0xc2, 0x1f, 0xeb, 0xe6, 0x1b, 0xf9,
+	0x09, 0xf5, 0x7a, 0xde, 0xdd, 0x3e, 0x4b, 0xfd, 0xc8, 0x4f, 0xb1, 0x9e, 0xa3, 0x69, 0x43, 0xfb,
+	0xfa, 0xb3, 0xa7, 0x5b, 0xd7, 0xe6, 0x1e, 0x75, 0xfa, 0x90, 0x93, 0x0a, 0xcd, 0x01, 0xf7, 0x59,
+	0x11, 0x94, 0x0f, 0x88, 0xe7, 0xef, 0x30, 0x07, 0xee, 0x65, 0x0e, 0xb7, 0x6f, 0xce, 0x3b, 0xdc,
+	0x0c, 0xe4, 0xff, 0x71, 0xc2, 0x35, 0x7e, 0x2d, 0x3f, 0xc5, 0x84, 0xbb, 0x8b, 0xe5, 0xb5, 0x6e,
+	0x11, 0x51, 0xf3, 0x09, 0x2f, 0x89, 0x2e, 0xa4, 0x89, 0xd4, 0x45, 0x87, 0xf8, 0x9d, 0x1b, 0x12,
+	0xfb, 0x97, 0x7f, 0xd6, 0xdf, 0x79, 0x8d, 0x37, 0x97, 0x00, 0x6e, 0x19, 0x52, 0xb8, 0x06, 0x16,
+	0x3c, 0xc4, 0xd5, 0x91, 0x57, 0xb2, 0xe4, 0x63, 0xea, 0x2a, 0xfe, 0x4b, 0x50, 0x31, 0xef, 0x89,
+	0x44, 0x14, 0x62, 0xb8, 0x0b, 0xca, 0x41, 0xd4, 0xb3, 0x8f, 0xb1, 0xfe, 0x2c, 0xac, 0x74, 0xb6,
+	0xbe, 0x3c, 0xa9, 0x5f, 0x0c, 0xa2, 0x5e, 0x9f, 0x38, 0xd2, 0xfa, 0x6d, 0x46, 0x89, 0xc0, 0x34,
+	0x10, 0xa3, 0x57, 0x27, 0xf5, 0xf5, 0x11, 0xa2, 0xfd, 0x76, 0x63, 0xec, 0x6d, 0x58, 0x8b, 0x41,
+	0xd4, 0xfb, 0x10, 0x8f, 0xe0, 0x65, 0xb0, 0xcc, 0x63, 0x52, 0x95, 0xb9, 0x62, 0x8d, 0x0d, 0xe6,
+	0x74, 0xff, 0x73, 0x11, 0x2c, 0x27, 0x37, 0x08, 0x78, 0x1b, 0x2c, 0x1c, 0xe2, 0x78, 0x55, 0xea,
+	0xf3, 0x56, 0x65, 0x17, 0xc7, 0x33, 0x29, 0x11, 0xf0, 0x43, 0x00, 0x12, 0xe6, 0x78, 0x29, 0xde,
+	0x3e, 0x6d, 0x55, 0x55, 0xb4, 0x61, 0x49, 0xc1, 0x21, 0x04, 0x25, 0x8a, 0x29, 0x53, 0xa7, 0xf9,
+	0xb2, 0xa5, 0x9e, 0x1b, 0xff, 0x29, 0x82, 0xd5, 0xec, 0x66, 0x90, 0x0d, 0xd0, 0x39, 0x42, 0xc4,
+	0xb7, 0x89, 0xbe, 0x8c, 0x2c, 0x77, 0x6a, 0x2f, 0x4e, 0xea, 0xe5, 0x6d, 0x69, 0xdb, 0xdb, 0x79,
+	0x75, 0x52, 0x3f, 0xaf, 0xa7, 0x26, 0x0e, 0x6a, 0x58, 0x65, 0xf5, 0xb8, 0xe7, 0xc2, 0xbb, 0x60,
+	0xd5, 0xfc, 0x1b, 0x60, 0xfb, 0x11, 0xed, 0xe1, 0x50, 0x2f, 0x4c, 0xe7, 0x6b, 0xaf, 0x4e, 0xea,
+	0x97, 0x34, 0x2a, 0xeb, 0x6f, 0x58, 0xe7, 0x8c, 0xe1, 0x23, 0x35, 0x86, 0x9b, 0x60, 0x89, 0xe3,
+	0x4f, 0x23, 0x75, 0x5c, 0x2c, 0xa8, 0x45, 0x4d, 0xc6, 0x89, 0xfe, 0xd2, 0x58, 0x7f, 0x3c, 0xb3,
+	0x67, 0xbf, 0xea, 0xcc, 0x76, 0xee, 0x7e, 0xf1, 0xa2, 0x56, 0x7c, 0xfe, 0xa2, 0x56, 0xfc, 0xd7,
+	0x8b, 0x5a, 0xf1, 0xb3, 0x97, 0xb5, 0xc2, 0xf3, 0x97, 0xb5, 0xc2, 0x3f, 0x5e, 0xd6, 0x0a, 0x3f,
+	0x9b, 0x5f, 0x7e, 0xc9, 0x9f, 0x98, 0xbd, 0x45, 0xf5, 0xaf, 0xd5, 0x7b, 0xff, 0x1b, 0x00, 0x9a,
+	0x2b, 0x97, 0x08, 0xd8, 0x14, 0x00, 0x00,
 }
 
 func (this *Supply) Equal(that interface{}) bool {
@@ -1644,6 +1676,54 @@ func (this *Content_CommunityPoolSpend) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Content_RefundStuckPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Content_RefundStuckPacket)
+	if !ok {
+		that2, ok := that.(Content_RefundStuckPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RefundStuckPacket.Equal(that1.RefundStuckPacket) {
+		return false
+	}
+	return true
+}
+func (this *Content_UpdateTransferParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Content_UpdateTransferParams)
+	if !ok {
+		that2, ok := that.(Content_UpdateTransferParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UpdateTransferParams.Equal(that1.UpdateTransferParams) {
+		return false
+	}
+	return true
+}
 func (this *StdFee) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1779,6 +1859,12 @@ func (this *Content) GetContent() github_com_cosmos_cosmos_sdk_x_gov_types.Conte
 	if x := this.GetCommunityPoolSpend(); x != nil {
 		return x
 	}
+	if x := this.GetRefundStuckPacket(); x != nil {
+		return x
+	}
+	if x := this.GetUpdateTransferParams(); x != nil {
+		return x
+	}
 	return nil
 }
 
@@ -1803,6 +1889,12 @@ func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.C
 	case *types6.CommunityPoolSpendProposal:
 		this.Sum = &Content_CommunityPoolSpend{vt}
 		return nil
+	case *types7.RefundStuckPacketProposal:
+		this.Sum = &Content_RefundStuckPacket{vt}
+		return nil
+	case *types7.UpdateParamsProposal:
+		this.Sum = &Content_UpdateTransferParams{vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message Content", value)
 }
@@ -1868,22 +1960,22 @@ func (this *Message) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error
 		return nil
 	}
 	switch vt := value.(type) {
-	case *types7.MsgSend:
+	case *types8.MsgSend:
 		this.Sum = &Message_MsgSend{vt}
 		return nil
-	case types7.MsgSend:
+	case types8.MsgSend:
 		this.Sum = &Message_MsgSend{&vt}
 		return nil
-	case *types7.MsgMultiSend:
+	case *types8.MsgMultiSend:
 		this.Sum = &Message_MsgMultiSend{vt}
 		return nil
-	case types7.MsgMultiSend:
+	case types8.MsgMultiSend:
 		this.Sum = &Message_MsgMultiSend{&vt}
 		return nil
-	case *types8.MsgVerifyInvariant:
+	case *types9.MsgVerifyInvariant:
 		this.Sum = &Message_MsgVerifyInvariant{vt}
 		return nil
-	case types8.MsgVerifyInvariant:
+	case types9.MsgVerifyInvariant:
 		this.Sum = &Message_MsgVerifyInvariant{&vt}
 		return nil
 	case *types6.MsgSetWithdrawAddress:
@@ -1934,40 +2026,40 @@ func (this *Message) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error
 	case types4.MsgDeposit:
 		this.Sum = &Message_MsgDeposit{&vt}
 		return nil
-	case *types9.MsgUnjail:
+	case *types10.MsgUnjail:
 		this.Sum = &Message_MsgUnjail{vt}
 		return nil
-	case types9.MsgUnjail:
+	case types10.MsgUnjail:
 		this.Sum = &Message_MsgUnjail{&vt}
 		return nil
-	case *types10.MsgCreateValidator:
+	case *types11.MsgCreateValidator:
 		this.Sum = &Message_MsgCreateValidator{vt}
 		return nil
-	case types10.MsgCreateValidator:
+	case types11.MsgCreateValidator:
 		this.Sum = &Message_MsgCreateValidator{&vt}
 		return nil
-	case *types10.MsgEditValidator:
+	case *types11.MsgEditValidator:
 		this.Sum = &Message_MsgEditValidator{vt}
 		return nil
-	case types10.MsgEditValidator:
+	case types11.MsgEditValidator:
 		this.Sum = &Message_MsgEditValidator{&vt}
 		return nil
-	case *types10.MsgDelegate:
+	case *types11.MsgDelegate:
 		this.Sum = &Message_MsgDelegate{vt}
 		return nil
-	case types10.MsgDelegate:
+	case types11.MsgDelegate:
 		this.Sum = &Message_MsgDelegate{&vt}
 		return nil
-	case *types10.MsgBeginRedelegate:
+	case *types11.MsgBeginRedelegate:
 		this.Sum = &Message_MsgBeginRedelegate{vt}
 		return nil
-	case types10.MsgBeginRedelegate:
+	case types11.MsgBeginRedelegate:
 		this.Sum = &Message_MsgBeginRedelegate{&vt}
 		return nil
-	case *types10.MsgUndelegate:
+	case *types11.MsgUndelegate:
 		this.Sum = &Message_MsgUndelegate{vt}
 		return nil
-	case types10.MsgUndelegate:
+	case types11.MsgUndelegate:
 		this.Sum = &Message_MsgUndelegate{&vt}
 		return nil
 	}
@@ -2487,6 +2579,48 @@ func (m *Content_CommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Content_RefundStuckPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_RefundStuckPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RefundStuckPacket != nil {
+		{
+			size, err := m.RefundStuckPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Content_UpdateTransferParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_UpdateTransferParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateTransferParams != nil {
+		{
+			size, err := m.UpdateTransferParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Transaction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3410,6 +3544,30 @@ func (m *Content_CommunityPoolSpend) Size() (n int) {
 	}
 	return n
 }
+func (m *Content_RefundStuckPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefundStuckPacket != nil {
+		l = m.RefundStuckPacket.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_UpdateTransferParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateTransferParams != nil {
+		l = m.UpdateTransferParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *Transaction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4718,6 +4876,76 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Content_CommunityPoolSpend{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundStuckPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types7.RefundStuckPacketProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_RefundStuckPacket{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTransferParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types7.UpdateParamsProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_UpdateTransferParams{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4920,7 +5148,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types7.MsgSend{}
+			v := &types8.MsgSend{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4955,7 +5183,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types7.MsgMultiSend{}
+			v := &types8.MsgMultiSend{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4990,7 +5218,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types8.MsgVerifyInvariant{}
+			v := &types9.MsgVerifyInvariant{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5305,7 +5533,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types9.MsgUnjail{}
+			v := &types10.MsgUnjail{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5340,7 +5568,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types10.MsgCreateValidator{}
+			v := &types11.MsgCreateValidator{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5375,7 +5603,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types10.MsgEditValidator{}
+			v := &types11.MsgEditValidator{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5410,7 +5638,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types10.MsgDelegate{}
+			v := &types11.MsgDelegate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5445,7 +5673,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types10.MsgBeginRedelegate{}
+			v := &types11.MsgBeginRedelegate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5480,7 +5708,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types10.MsgUndelegate{}
+			v := &types11.MsgUndelegate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5688,7 +5916,7 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types11.Coin{})
+			m.Amount = append(m.Amount, types12.Coin{})
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -7,6 +7,7 @@ require (
 	github.com/cosmos/go-bip39 v0.0.0-20180819234021-555e2067c45d
 	github.com/cosmos/ledger-cosmos-go v0.11.1
 	github.com/gibson042/canonicaljson-go v1.0.3
+	github.com/go-kit/kit v0.10.0
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang/mock v1.4.3
 	github.com/golang/protobuf v1.4.0
@@ -17,6 +18,7 @@ require (
 	github.com/otiai10/copy v1.1.1
 	github.com/pelletier/go-toml v1.7.0
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.5.0
 	github.com/rakyll/statik v0.1.7
 	github.com/regen-network/cosmos-proto v0.2.2
 	github.com/spf13/afero v1.2.2 // indirect
@@ -31,6 +33,7 @@ require (
 	github.com/tendermint/iavl v0.13.3
 	github.com/tendermint/tendermint v0.33.3
 	github.com/tendermint/tm-db v0.5.1
+	google.golang.org/grpc v1.28.0
 	gopkg.in/yaml.v2 v2.2.8
 )
 
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"context"
 	"io"
 	"os"
 
@@ -8,6 +9,9 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -180,6 +184,8 @@ func NewSimApp(
 	app.subspaces[slashing.ModuleName] = app.ParamsKeeper.Subspace(slashing.DefaultParamspace)
 	app.subspaces[gov.ModuleName] = app.ParamsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	app.subspaces[crisis.ModuleName] = app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
+	app.subspaces[ibc.ModuleName] = app.ParamsKeeper.Subspace(ibc.DefaultParamspace)
+	app.subspaces[transfer.ModuleName] = app.ParamsKeeper.Subspace(transfer.DefaultParamspace)
 
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(std.ConsensusParamsKeyTable()))
@@ -218,17 +224,6 @@ func NewSimApp(
 	)
 	app.UpgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], appCodec, homePath)
 
-	// register the proposal types
-	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
-	app.GovKeeper = gov.NewKeeper(
-		appCodec, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
-		&stakingKeeper, govRouter,
-	)
-
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
@@ -237,18 +232,34 @@ func NewSimApp(
 
 	// Create IBC Keeper
 	app.IBCKeeper = ibc.NewKeeper(
-		app.cdc, keys[ibc.StoreKey], app.StakingKeeper, scopedIBCKeeper,
+		app.cdc, keys[ibc.StoreKey], app.subspaces[ibc.ModuleName], app.StakingKeeper, scopedIBCKeeper,
 	)
 
 	// Create Transfer Keepers
+	// NOTE: the client keepers are needed to refund the packets stuck on a dead
+	// client through governance
 	app.TransferKeeper = transfer.NewKeeper(
-		app.cdc, keys[transfer.StoreKey],
+		app.cdc, keys[transfer.StoreKey], app.subspaces[transfer.ModuleName],
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.BankKeeper, app.SupplyKeeper,
 		scopedTransferKeeper,
-	)
+	).WithClientKeepers(app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ClientKeeper)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
+	// register the proposal types
+	// NOTE: the gov keeper is created after the transfer keeper, whose proposals
+	// it routes
+	govRouter := gov.NewRouter()
+	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(transfer.RouterKey, transfer.NewProposalHandler(app.TransferKeeper))
+	app.GovKeeper = gov.NewKeeper(
+		appCodec, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
+		&stakingKeeper, govRouter,
+	)
+
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := port.NewRouter()
 	ibcRouter.AddRoute(transfer.ModuleName, transferModule)
@@ -292,7 +303,7 @@ func NewSimApp(
 		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
 		evidence.ModuleName, staking.ModuleName, ibc.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, ibc.ModuleName, transfer.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -378,6 +389,27 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	return app.mm.InitGenesis(ctx, app.cdc, genesisState)
 }
 
+// NewGRPCServer returns a gRPC server serving the query services of the app
+// modules. The queries are executed against the latest committed state.
+func (app *SimApp) NewGRPCServer() *grpc.Server {
+	server := grpc.NewServer(grpc.UnaryInterceptor(app.queryInterceptor))
+	app.mm.RegisterQueryServices(server)
+	return server
+}
+
+// queryInterceptor attaches a query context on the latest committed state to
+// the context of the gRPC queries, as expected by the query services.
+func (app *SimApp) queryInterceptor(
+	ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	queryCtx, err := app.NewQueryContext(app.LastBlockHeight())
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return handler(sdk.WrapSDKContext(queryCtx.WithContext(ctx)), req)
+}
+
 // LoadHeight loads a particular height
 func (app *SimApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
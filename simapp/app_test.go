@@ -1,14 +1,20 @@
 package simapp
 
 import (
+	"context"
+	"net"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -36,6 +42,38 @@ func TestSimAppExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+// ensure that the gRPC query services of the modules are served on the committed
+// state
+func TestGRPCServer(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := app.NewGRPCServer()
+	go server.Serve(listener) // nolint: errcheck
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	queryClient := transfertypes.NewQueryClient(conn)
+
+	res, err := queryClient.Params(context.Background(), &transfertypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, transfertypes.DefaultParams(), res.Params)
+
+	_, err = queryClient.DenomTrace(context.Background(), &transfertypes.QueryDenomTraceRequest{
+		Hash: transfertypes.ParseDenomTrace("transfer/channel/atom").IBCDenom(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
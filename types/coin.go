@@ -580,8 +580,9 @@ func (coins Coins) Sort() Coins {
 // Parsing
 
 var (
-	// Denominations can be 3 ~ 64 characters long.
-	reDnmString = `[a-z][a-z0-9/]{2,63}`
+	// Denominations can be 3 ~ 64 characters long, except for the IBC voucher
+	// denominations (i.e ibc/{hash}), which carry a 64 characters hex SHA256 hash.
+	reDnmString = `(?:ibc/[0-9a-f]{64}|[a-z][a-z0-9/]{2,63})`
 	reAmt       = `[[:digit:]]+`
 	reDecAmt    = `[[:digit:]]*\.[[:digit:]]+`
 	reSpc       = `[[:space:]]*`
@@ -63,6 +63,12 @@ func TestCoinIsValid(t *testing.T) {
 		{Coin{"a very long coin denom", NewInt(1)}, false},
 		{Coin{"atOm", NewInt(1)}, false},
 		{Coin{"     ", NewInt(1)}, false},
+		{Coin{"a" + strings.Repeat("b", 63), NewInt(1)}, true},
+		{Coin{"a" + strings.Repeat("b", 64), NewInt(1)}, false},
+		{Coin{"ibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", NewInt(1)}, true},
+		{Coin{"ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", NewInt(1)}, false},
+		{Coin{"ibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2aa", NewInt(1)}, false},
+		{Coin{"abc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", NewInt(1)}, false},
 	}
 
 	for i, tc := range cases {
@@ -411,6 +417,7 @@ func TestParse(t *testing.T) {
 		{"11me coin, 12you coin", false, nil}, // no spaces in coin names
 		{"1.2btc", false, nil},                // amount must be integer
 		{"5foo-bar", false, nil},              // once more, only letters in coin name
+		{"10ibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", true, Coins{{"ibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", NewInt(10)}}},
 	}
 
 	for tcIndex, tc := range cases {
@@ -225,3 +225,23 @@ func (c Context) CacheContext() (cc Context, writeCache func()) {
 	cc = c.WithMultiStore(cms).WithEventManager(NewEventManager())
 	return cc, cms.Write
 }
+
+// ContextKey defines a type alias for a stdlib Context key.
+type ContextKey string
+
+// SdkContextKey is the key in the context.Context which holds the sdk.Context.
+const SdkContextKey ContextKey = "sdk-context"
+
+// WrapSDKContext returns a stdlib context.Context with the provided sdk.Context
+// as a value. It is useful for passing an sdk.Context through methods that take
+// a stdlib context.Context parameter, such as the generated gRPC methods. To get
+// the original sdk.Context back, call UnwrapSDKContext.
+func WrapSDKContext(ctx Context) context.Context {
+	return context.WithValue(ctx.ctx, SdkContextKey, ctx)
+}
+
+// UnwrapSDKContext retrieves the Context attached to a context.Context with
+// WrapSDKContext. It panics if no Context is attached.
+func UnwrapSDKContext(ctx context.Context) Context {
+	return ctx.Value(SdkContextKey).(Context)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -149,6 +150,12 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// QueryServiceModule is implemented by the app modules serving their queries
+// through a gRPC query service, in addition to their legacy querier.
+type QueryServiceModule interface {
+	RegisterQueryService(*grpc.Server)
+}
+
 //___________________________
 
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
@@ -256,6 +263,16 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
+// RegisterQueryServices registers the gRPC query services of the modules
+// implementing QueryServiceModule on the given server
+func (m *Manager) RegisterQueryServices(server *grpc.Server) {
+	for _, module := range m.Modules {
+		if qsm, ok := module.(QueryServiceModule); ok {
+			qsm.RegisterQueryService(server)
+		}
+	}
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
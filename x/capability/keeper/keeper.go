@@ -272,16 +272,15 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 
 	key := types.RevCapabilityKey(sk.module, name)
 	indexBytes := memStore.Get(key)
-	index := sdk.BigEndianToUint64(indexBytes)
 	if len(indexBytes) == 0 {
-		// If a tx failed and NewCapability got reverted, it is possible
-		// to still have the capability in the go map since changes to
-		// go map do not automatically get reverted on tx failure,
-		// so we delete here to remove unnecessary values in map
-		delete(sk.capMap, index)
+		// The index of a capability that doesn't exist is unknown, so nothing
+		// can be removed from the go map here. Deleting the zero index would
+		// drop the first capability ever created, which is still in use.
 		return nil, false
 	}
 
+	index := sdk.BigEndianToUint64(indexBytes)
+
 	cap := sk.capMap[index]
 	if cap == nil {
 		// delete key from store to remove unnecessary mapping
@@ -271,6 +271,26 @@ func (suite KeeperTestSuite) TestRevertCapability() {
 	suite.Require().Equal(cap, got, "did not get correct capability from context")
 }
 
+func (suite KeeperTestSuite) TestGetMissingCapability() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	sk := app.ScopedTransferKeeper
+
+	// the transfer port capability is the first one created on genesis
+	portCap, ok := sk.GetCapability(ctx, "ports/transfer")
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(0), portCap.GetIndex())
+
+	got, ok := sk.GetCapability(ctx, "missing")
+	suite.Require().False(ok)
+	suite.Require().Nil(got)
+
+	// looking up a missing capability must not drop any existing one
+	got, ok = sk.GetCapability(ctx, "ports/transfer")
+	suite.Require().True(ok)
+	suite.Require().Equal(portCap, got)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -12,31 +12,36 @@ import (
 )
 
 const (
-	AttributeKeyClientID  = types.AttributeKeyClientID
-	AttrbuteKeyClientType = types.AttributeKeyClientType
-	SubModuleName         = types.SubModuleName
-	RouterKey             = types.RouterKey
-	QuerierRoute          = types.QuerierRoute
-	QueryAllClients       = types.QueryAllClients
-	QueryClientState      = types.QueryClientState
-	QueryConsensusState   = types.QueryConsensusState
+	AttributeKeyClientID      = types.AttributeKeyClientID
+	AttrbuteKeyClientType     = types.AttributeKeyClientType
+	SubModuleName             = types.SubModuleName
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	QueryAllClients           = types.QueryAllClients
+	QueryClientState          = types.QueryClientState
+	QueryConsensusState       = types.QueryConsensusState
+	QueryClientFrozen         = types.QueryClientFrozen
+	DefaultMaxConsensusStates = types.DefaultMaxConsensusStates
 )
 
 var (
 	// functions aliases
-	NewKeeper                 = keeper.NewKeeper
-	QuerierClients            = keeper.QuerierClients
-	RegisterCodec             = types.RegisterCodec
-	ErrClientExists           = types.ErrClientExists
-	ErrClientNotFound         = types.ErrClientNotFound
-	ErrClientFrozen           = types.ErrClientFrozen
-	ErrConsensusStateNotFound = types.ErrConsensusStateNotFound
-	ErrInvalidConsensus       = types.ErrInvalidConsensus
-	ErrClientTypeNotFound     = types.ErrClientTypeNotFound
-	ErrInvalidClientType      = types.ErrInvalidClientType
-	ErrRootNotFound           = types.ErrRootNotFound
-	ErrInvalidHeader          = types.ErrInvalidHeader
-	ErrInvalidEvidence        = types.ErrInvalidEvidence
+	NewKeeper                  = keeper.NewKeeper
+	QuerierClients             = keeper.QuerierClients
+	QuerierClientFrozen        = keeper.QuerierClientFrozen
+	RegisterCodec              = types.RegisterCodec
+	NewQueryClientFrozenParams = types.NewQueryClientFrozenParams
+	NewClientFrozenResponse    = types.NewClientFrozenResponse
+	ErrClientExists            = types.ErrClientExists
+	ErrClientNotFound          = types.ErrClientNotFound
+	ErrClientFrozen            = types.ErrClientFrozen
+	ErrConsensusStateNotFound  = types.ErrConsensusStateNotFound
+	ErrInvalidConsensus        = types.ErrInvalidConsensus
+	ErrClientTypeNotFound      = types.ErrClientTypeNotFound
+	ErrInvalidClientType       = types.ErrInvalidClientType
+	ErrRootNotFound            = types.ErrRootNotFound
+	ErrInvalidHeader           = types.ErrInvalidHeader
+	ErrInvalidEvidence         = types.ErrInvalidEvidence
 
 	// variable aliases
 	SubModuleCdc           = types.SubModuleCdc
@@ -46,6 +51,8 @@ var (
 )
 
 type (
-	Keeper        = keeper.Keeper
-	StakingKeeper = types.StakingKeeper
+	Keeper                  = keeper.Keeper
+	StakingKeeper           = types.StakingKeeper
+	QueryClientFrozenParams = types.QueryClientFrozenParams
+	ClientFrozenResponse    = types.ClientFrozenResponse
 )
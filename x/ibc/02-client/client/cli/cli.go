@@ -21,6 +21,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	ics02ClientQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryClientStates(queryRoute, cdc),
 		GetCmdQueryClientState(queryRoute, cdc),
+		GetCmdQueryClientFrozen(queryRoute, cdc),
 		GetCmdQueryConsensusState(queryRoute, cdc),
 		GetCmdQueryHeader(cdc),
 		GetCmdNodeConsensusState(queryRoute, cdc),
@@ -84,6 +84,37 @@ $ %s query ibc client state [client-id]
 	return cmd
 }
 
+// GetCmdQueryClientFrozen defines the command to query whether a client with a
+// given id has been frozen due to misbehaviour.
+func GetCmdQueryClientFrozen(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "frozen [client-id]",
+		Short: "Query whether a client is frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a client has been frozen due to misbehaviour
+
+Example:
+$ %s query ibc client frozen [client-id]
+		`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			clientID := args[0]
+			if strings.TrimSpace(clientID) == "" {
+				return errors.New("client ID can't be blank")
+			}
+
+			frozenRes, _, err := utils.QueryClientFrozen(cliCtx, clientID)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(frozenRes)
+		},
+	}
+}
+
 // GetCmdQueryConsensusState defines the command to query the consensus state of
 // the chain as defined in https://github.com/cosmos/ics/tree/master/spec/ics-002-client-semantics#query
 func GetCmdQueryConsensusState(queryRoute string, cdc *codec.Codec) *cobra.Command {
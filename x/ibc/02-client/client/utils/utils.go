@@ -37,6 +37,29 @@ func QueryAllClientStates(cliCtx context.CLIContext, page, limit int) ([]exporte
 	return clients, height, nil
 }
 
+// QueryClientFrozen returns whether a light client is frozen due to misbehaviour.
+// It _does not_ return any merkle proof.
+func QueryClientFrozen(cliCtx context.CLIContext, clientID string) (types.ClientFrozenResponse, int64, error) {
+	params := types.NewQueryClientFrozenParams(clientID)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.ClientFrozenResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", "ibc", types.QuerierRoute, types.QueryClientFrozen)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.ClientFrozenResponse{}, 0, err
+	}
+
+	var frozenRes types.ClientFrozenResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &frozenRes)
+	if err != nil {
+		return types.ClientFrozenResponse{}, 0, fmt.Errorf("failed to unmarshal client frozen status: %w", err)
+	}
+	return frozenRes, height, nil
+}
+
 // QueryClientState queries the store to get the light client state and a merkle
 // proof.
 func QueryClientState(
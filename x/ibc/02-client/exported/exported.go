@@ -103,6 +103,10 @@ type ConsensusState interface {
 	// which is used for key-value pair verification.
 	GetRoot() commitmentexported.Root
 
+	// GetTimestamp returns the block time, in nanoseconds since the Unix epoch,
+	// of the consensus state, which is used to check the packet timeouts.
+	GetTimestamp() uint64
+
 	ValidateBasic() error
 }
 
@@ -136,6 +140,14 @@ type MsgUpdateClient interface {
 	GetHeader() Header
 }
 
+// MsgSubmitMisbehaviour defines the msg interface that the
+// SubmitMisbehaviour Handler expects
+type MsgSubmitMisbehaviour interface {
+	sdk.Msg
+	GetEvidence() evidenceexported.Evidence
+	GetSubmitter() sdk.AccAddress
+}
+
 // ClientType defines the type of the consensus algorithm
 type ClientType byte
 
@@ -1,8 +1,6 @@
 package client
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
@@ -73,8 +71,8 @@ func HandleMsgCreateClient(ctx sdk.Context, k Keeper, msg exported.MsgCreateClie
 
 // HandleMsgUpdateClient defines the sdk.Handler for MsgUpdateClient
 func HandleMsgUpdateClient(ctx sdk.Context, k Keeper, msg exported.MsgUpdateClient) (*sdk.Result, error) {
-	clientState, err := k.UpdateClient(ctx, msg.GetClientID(), msg.GetHeader())
-	if err != nil {
+	// the update (or no-op) event with the client heights is emitted by the keeper
+	if _, err := k.UpdateClient(ctx, msg.GetClientID(), msg.GetHeader()); err != nil {
 		return nil, err
 	}
 
@@ -84,19 +82,38 @@ func HandleMsgUpdateClient(ctx sdk.Context, k Keeper, msg exported.MsgUpdateClie
 		attributes[i+1] = sdk.NewAttribute(sdk.AttributeKeySender, signer.String())
 	}
 
-	k.Logger(ctx).Info(fmt.Sprintf("client %s updated to height %d", msg.GetClientID(), clientState.GetLatestHeight()))
-
-	ctx.EventManager().EmitEvents(sdk.Events{
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			EventTypeUpdateClient,
-			sdk.NewAttribute(AttributeKeyClientID, msg.GetClientID()),
-			sdk.NewAttribute(AttrbuteKeyClientType, clientState.ClientType().String()),
+			sdk.EventTypeMessage,
+			attributes...,
 		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// HandleMsgSubmitMisbehaviour defines the sdk.Handler for MsgSubmitMisbehaviour.
+// The client is frozen if the evidence carries two conflicting headers that
+// would have both convinced it.
+func HandleMsgSubmitMisbehaviour(ctx sdk.Context, k Keeper, msg exported.MsgSubmitMisbehaviour) (*sdk.Result, error) {
+	misbehaviour, ok := msg.GetEvidence().(exported.Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidEvidence, "evidence type %T is not a client misbehaviour", msg.GetEvidence())
+	}
+
+	if err := k.CheckMisbehaviourAndUpdateState(ctx, misbehaviour); err != nil {
+		return nil, sdkerrors.Wrapf(err, "cannot freeze client with ID %s", misbehaviour.GetClientID())
+	}
+
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
-			attributes...,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSubmitter().String()),
 		),
-	})
+	)
 
 	return &sdk.Result{
 		Events: ctx.EventManager().Events().ToABCIEvents(),
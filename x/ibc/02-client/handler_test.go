@@ -0,0 +1,101 @@
+package client_test
+
+import (
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+const (
+	testClientID     = "gaiamainnet"
+	testClientHeight = 5
+
+	trustingPeriod time.Duration = time.Hour * 24 * 7 * 2
+	ubdPeriod      time.Duration = time.Hour * 24 * 7 * 3
+)
+
+func (suite *ClientTestSuite) TestHandleMsgSubmitMisbehaviour() {
+	now := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	privVal := tmtypes.NewMockPV()
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(privVal.GetPubKey(), 1)})
+	signers := []tmtypes.PrivValidator{privVal}
+	submitter := sdk.AccAddress([]byte("submitter"))
+
+	header := ibctmtypes.CreateTestHeader(testClientID, testClientHeight, now, valSet, signers)
+	conflictingHeader := ibctmtypes.CreateTestHeader(testClientID, testClientHeight, now.Add(time.Minute), valSet, signers)
+
+	testCases := []struct {
+		msg     string
+		header1 ibctmtypes.Header
+		header2 ibctmtypes.Header
+		expPass bool
+	}{
+		{"conflicting headers", header, conflictingHeader, true},
+		{"identical headers", header, header, false},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		ctx := suite.ctx.WithBlockTime(now.Add(time.Hour))
+		clientKeeper := suite.app.IBCKeeper.ClientKeeper
+
+		clientState, err := ibctmtypes.Initialize(testClientID, trustingPeriod, ubdPeriod, header)
+		suite.Require().NoError(err)
+		consensusState := ibctmtypes.ConsensusState{
+			Height:       testClientHeight,
+			Timestamp:    now,
+			Root:         commitmenttypes.NewMerkleRoot([]byte("hash")),
+			ValidatorSet: valSet,
+		}
+		_, err = clientKeeper.CreateClient(ctx, clientState, consensusState)
+		suite.Require().NoError(err)
+
+		evidence := ibctmtypes.Evidence{
+			ClientID: testClientID,
+			ChainID:  testClientID,
+			Header1:  tc.header1,
+			Header2:  tc.header2,
+		}
+		msg := ibctmtypes.NewMsgSubmitClientMisbehaviour(evidence, submitter)
+
+		handler := ibc.NewHandler(*suite.app.IBCKeeper)
+		_, err = handler(ctx, msg)
+
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", ibctypes.QuerierRoute, client.SubModuleName, client.QueryClientFrozen}, "/"),
+			Data: suite.cdc.MustMarshalJSON(client.NewQueryClientFrozenParams(testClientID)),
+		}
+		bz, queryErr := client.QuerierClientFrozen(ctx, query, clientKeeper)
+		suite.Require().NoError(queryErr)
+
+		var frozenRes client.ClientFrozenResponse
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &frozenRes))
+
+		updatedClientState, found := clientKeeper.GetClientState(ctx, testClientID)
+		suite.Require().True(found)
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			suite.Require().True(frozenRes.Frozen, "valid test case %d failed: %s", i, tc.msg)
+
+			// proofs at the misbehaviour height don't verify anymore
+			err = updatedClientState.VerifyClientConsensusState(
+				suite.cdc, consensusState.Root, testClientHeight, testClientID, testClientHeight,
+				commitmenttypes.NewMerklePrefix([]byte("ibc")), ibctypes.ValidProof{}, consensusState,
+			)
+			suite.Require().True(client.ErrClientFrozen.Is(err), "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			suite.Require().False(frozenRes.Frozen, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
@@ -75,6 +75,23 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		return nil, sdkerrors.Wrapf(types.ErrClientFrozen, "cannot update client with ID %s", clientID)
 	}
 
+	// the localhost client is updated to the current block height, so that an
+	// update within the block it was last updated in is a no-op
+	previousHeight := clientState.GetLatestHeight()
+	if clientType == exported.Localhost && uint64(ctx.BlockHeight()) <= previousHeight {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateClientNoop,
+				sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+				sdk.NewAttribute(types.AttributeKeyClientType, clientType.String()),
+				sdk.NewAttribute(types.AttributeKeyPreviousHeight, fmt.Sprintf("%d", previousHeight)),
+				sdk.NewAttribute(types.AttributeKeyHeaderHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+
+		return clientState, nil
+	}
+
 	var (
 		consensusState exported.ConsensusState
 		err            error
@@ -105,8 +122,21 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	// we don't set consensus state for localhost client
 	if header != nil && clientType != exported.Localhost {
 		k.SetClientConsensusState(ctx, clientID, header.GetHeight(), consensusState)
+		k.pruneOldConsensusStates(ctx, clientID)
+
+		k.Logger(ctx).Info(fmt.Sprintf("client %s updated from height %d to height %d", clientID, previousHeight, clientState.GetLatestHeight()))
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, fmt.Sprintf("%d", previousHeight)),
+			sdk.NewAttribute(types.AttributeKeyNewHeight, fmt.Sprintf("%d", clientState.GetLatestHeight())),
+		),
+	)
+
 	return clientState, nil
 }
 
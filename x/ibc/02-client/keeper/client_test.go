@@ -7,7 +7,9 @@ import (
 
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 
@@ -177,6 +179,56 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientEvents() {
+	clientState, err := ibctmtypes.Initialize(testClientID, trustingPeriod, ubdPeriod, suite.header)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+	suite.Require().NoError(err)
+
+	header := ibctmtypes.CreateTestHeader(testClientID, suite.header.Height+1, suite.header.Time.Add(time.Minute),
+		suite.valSet, []tmtypes.PrivValidator{suite.privVal})
+	ctx := suite.ctx.WithBlockTime(header.Time.Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+
+	_, err = suite.keeper.UpdateClient(ctx, testClientID, header)
+	suite.Require().NoError(err)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, testClientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, exported.Tendermint.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, fmt.Sprintf("%d", suite.header.Height)),
+			sdk.NewAttribute(types.AttributeKeyNewHeight, fmt.Sprintf("%d", header.Height)),
+		),
+	}, events)
+
+	// the localhost client can't be updated past the current block height
+	localhostClient := localhosttypes.NewClientState(
+		suite.keeper.ClientStore(suite.ctx, exported.ClientTypeLocalHost),
+		suite.header.ChainID,
+		suite.ctx.BlockHeight(),
+	)
+	_, err = suite.keeper.CreateClient(suite.ctx, localhostClient, nil)
+	suite.Require().NoError(err)
+
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	updatedClientState, err := suite.keeper.UpdateClient(ctx, exported.ClientTypeLocalHost, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(suite.ctx.BlockHeight()), updatedClientState.GetLatestHeight())
+
+	events = ctx.EventManager().Events()
+	suite.Require().Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateClientNoop,
+			sdk.NewAttribute(types.AttributeKeyClientID, exported.ClientTypeLocalHost),
+			sdk.NewAttribute(types.AttributeKeyClientType, exported.Localhost.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, fmt.Sprintf("%d", suite.ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeyHeaderHeight, fmt.Sprintf("%d", suite.ctx.BlockHeight())),
+		),
+	}, events)
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientLocalhost() {
 	var localhostClient exported.ClientState = localhosttypes.NewClientState(
 		suite.keeper.ClientStore(suite.ctx, exported.ClientTypeLocalHost),
@@ -224,7 +276,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 			"trusting period misbehavior should pass",
 			ibctmtypes.Evidence{
 				Header1:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight, suite.ctx.BlockTime(), bothValSet, bothSigners),
-				Header2:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight, suite.ctx.BlockTime(), bothValSet, bothSigners),
+				Header2:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight, suite.ctx.BlockTime().Add(time.Minute), bothValSet, bothSigners),
 				ChainID:  testClientID,
 				ClientID: testClientID,
 			},
@@ -244,7 +296,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 			"misbehavior at later height should pass",
 			ibctmtypes.Evidence{
 				Header1:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight+5, suite.ctx.BlockTime(), bothValSet, bothSigners),
-				Header2:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight+5, suite.ctx.BlockTime(), bothValSet, bothSigners),
+				Header2:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight+5, suite.ctx.BlockTime().Add(time.Minute), bothValSet, bothSigners),
 				ChainID:  testClientID,
 				ClientID: testClientID,
 			},
@@ -260,6 +312,26 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 			},
 			true,
 		},
+		{
+			"identical headers are not misbehaviour",
+			ibctmtypes.Evidence{
+				Header1:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight, suite.ctx.BlockTime(), bothValSet, bothSigners),
+				Header2:  ibctmtypes.CreateTestHeader(testClientID, testClientHeight, suite.ctx.BlockTime(), bothValSet, bothSigners),
+				ChainID:  testClientID,
+				ClientID: testClientID,
+			},
+			func() error {
+				suite.consensusState.ValidatorSet = bothValSet
+				clientState, err := ibctmtypes.Initialize(testClientID, trustingPeriod, ubdPeriod, suite.header)
+				if err != nil {
+					return err
+				}
+				_, err = suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+
+				return err
+			},
+			false,
+		},
 		{
 			"client state not found",
 			ibctmtypes.Evidence{},
@@ -24,6 +24,8 @@ type Keeper struct {
 	storeKey      sdk.StoreKey
 	cdc           *codec.Codec
 	stakingKeeper types.StakingKeeper
+
+	maxConsensusStates uint64
 }
 
 // NewKeeper creates a new NewKeeper instance
@@ -32,6 +34,8 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.StakingKeeper) Keepe
 		storeKey:      key,
 		cdc:           cdc,
 		stakingKeeper: sk,
+
+		maxConsensusStates: types.DefaultMaxConsensusStates,
 	}
 }
 
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// consensusStatePrefix is the prefix of the consensus state keys of a client
+// store.
+var consensusStatePrefix = []byte("consensusState/")
+
+// WithMaxConsensusStates returns a copy of the keeper that keeps the latest n
+// consensus states of each client on update. Setting n to 0 disables the
+// automatic pruning.
+func (k Keeper) WithMaxConsensusStates(n uint64) Keeper {
+	k.maxConsensusStates = n
+	return k
+}
+
+// GetConsensusStateHeights returns the heights of all the consensus states
+// stored for a client, in ascending order.
+func (k Keeper) GetConsensusStateHeights(ctx sdk.Context, clientID string) []uint64 {
+	store := k.ClientStore(ctx, clientID)
+	iterator := sdk.KVStorePrefixIterator(store, consensusStatePrefix)
+
+	defer iterator.Close()
+
+	var heights []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		height, err := strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), string(consensusStatePrefix)), 10, 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid consensus state key %s of client %s: %v", iterator.Key(), clientID, err))
+		}
+		heights = append(heights, height)
+	}
+
+	// the heights are not zero padded, so the keys aren't sorted by height
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
+
+// PruneConsensusStates deletes the consensus states of a client with a height
+// lower than beforeHeight, so that they can no longer be used for proof
+// verification. The consensus state at the latest height of the client is never
+// pruned. It returns the number of pruned consensus states.
+func (k Keeper) PruneConsensusStates(ctx sdk.Context, clientID string, beforeHeight uint64) int {
+	var latestHeight uint64
+	if clientState, found := k.GetClientState(ctx, clientID); found {
+		latestHeight = clientState.GetLatestHeight()
+	}
+
+	store := k.ClientStore(ctx, clientID)
+
+	pruned := 0
+	for _, height := range k.GetConsensusStateHeights(ctx, clientID) {
+		if height >= beforeHeight {
+			break
+		}
+		if height == latestHeight {
+			continue
+		}
+
+		store.Delete(ibctypes.KeyConsensusState(height))
+		pruned++
+	}
+
+	if pruned > 0 {
+		k.Logger(ctx).Info(fmt.Sprintf("pruned %d consensus states of client %s before height %d", pruned, clientID, beforeHeight))
+	}
+	return pruned
+}
+
+// pruneOldConsensusStates deletes the oldest consensus states of a client so that
+// at most the configured maximum number of consensus states are kept.
+func (k Keeper) pruneOldConsensusStates(ctx sdk.Context, clientID string) {
+	if k.maxConsensusStates == 0 {
+		return
+	}
+
+	heights := k.GetConsensusStateHeights(ctx, clientID)
+	if uint64(len(heights)) <= k.maxConsensusStates {
+		return
+	}
+
+	k.PruneConsensusStates(ctx, clientID, heights[uint64(len(heights))-k.maxConsensusStates])
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	// the client latest height is lower than some of the stored consensus states
+	// so that it falls within the pruned range
+	clientState, err := ibctmtypes.Initialize(testClientID, trustingPeriod, ubdPeriod, suite.header)
+	suite.Require().NoError(err)
+	suite.keeper.SetClientState(suite.ctx, clientState)
+
+	for height := uint64(1); height <= 20; height++ {
+		consensusState := ibctmtypes.ConsensusState{
+			Height:       height,
+			Timestamp:    suite.now,
+			Root:         commitmenttypes.NewMerkleRoot([]byte("hash")),
+			ValidatorSet: suite.valSet,
+		}
+		suite.keeper.SetClientConsensusState(suite.ctx, testClientID, height, consensusState)
+	}
+
+	pruned := suite.keeper.PruneConsensusStates(suite.ctx, testClientID, 15)
+	suite.Require().Equal(13, pruned)
+
+	expHeights := []uint64{testClientHeight, 15, 16, 17, 18, 19, 20}
+	suite.Require().Equal(expHeights, suite.keeper.GetConsensusStateHeights(suite.ctx, testClientID))
+
+	// pruned consensus states can't be used for proof verification anymore
+	_, found := suite.keeper.GetClientConsensusState(suite.ctx, testClientID, 14)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetClientConsensusStateLTE(suite.ctx, testClientID, 4)
+	suite.Require().False(found)
+
+	// the latest height is never pruned
+	pruned = suite.keeper.PruneConsensusStates(suite.ctx, testClientID, 100)
+	suite.Require().Equal(6, pruned)
+	suite.Require().Equal([]uint64{testClientHeight}, suite.keeper.GetConsensusStateHeights(suite.ctx, testClientID))
+
+	_, found = suite.keeper.GetLatestClientConsensusState(suite.ctx, testClientID)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientPruneConsensusStates() {
+	const maxConsensusStates = 3
+	clientKeeper := suite.keeper.WithMaxConsensusStates(maxConsensusStates)
+
+	clientState, err := ibctmtypes.Initialize(testClientID, trustingPeriod, ubdPeriod, suite.header)
+	suite.Require().NoError(err)
+	_, err = clientKeeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+	suite.Require().NoError(err)
+
+	header := suite.header
+	for i := 0; i < 10; i++ {
+		header = ibctmtypes.CreateTestHeader(testClientID, header.Height+1, header.Time.Add(time.Minute),
+			suite.valSet, []tmtypes.PrivValidator{suite.privVal})
+		suite.ctx = suite.ctx.WithBlockTime(header.Time.Add(time.Minute))
+
+		_, err := clientKeeper.UpdateClient(suite.ctx, testClientID, header)
+		suite.Require().NoError(err)
+
+		heights := clientKeeper.GetConsensusStateHeights(suite.ctx, testClientID)
+		suite.Require().LessOrEqual(len(heights), maxConsensusStates)
+		suite.Require().Equal(uint64(header.Height), heights[len(heights)-1])
+	}
+
+	latestHeight := uint64(header.Height)
+	suite.Require().Equal(
+		[]uint64{latestHeight - 2, latestHeight - 1, latestHeight},
+		clientKeeper.GetConsensusStateHeights(suite.ctx, testClientID),
+	)
+
+	_, found := clientKeeper.GetClientConsensusState(suite.ctx, testClientID, testClientHeight)
+	suite.Require().False(found, "initial consensus state should have been pruned")
+}
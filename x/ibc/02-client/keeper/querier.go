@@ -35,3 +35,25 @@ func QuerierClients(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 
 	return res, nil
 }
+
+// QuerierClientFrozen defines the sdk.Querier to query the frozen status of a
+// light client.
+func QuerierClientFrozen(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClientFrozenParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	clientState, found := k.GetClientState(ctx, params.ClientID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrClientNotFound, params.ClientID)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewClientFrozenResponse(params.ClientID, clientState.IsFrozen()))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
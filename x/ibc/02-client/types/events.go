@@ -8,14 +8,18 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID   = "client_id"
-	AttributeKeyClientType = "client_type"
+	AttributeKeyClientID       = "client_id"
+	AttributeKeyClientType     = "client_type"
+	AttributeKeyPreviousHeight = "previous_height"
+	AttributeKeyNewHeight      = "new_height"
+	AttributeKeyHeaderHeight   = "header_height"
 )
 
 // IBC client events vars
 var (
 	EventTypeCreateClient       = "create_client"
 	EventTypeUpdateClient       = "update_client"
+	EventTypeUpdateClientNoop   = "update_client_noop"
 	EventTypeSubmitMisbehaviour = "client_misbehaviour"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibctypes.ModuleName, SubModuleName)
@@ -9,4 +9,8 @@ const (
 
 	// QuerierRoute is the querier route for IBC client
 	QuerierRoute string = SubModuleName
+
+	// DefaultMaxConsensusStates is the default number of consensus states kept
+	// for each client. Older consensus states are pruned on client update.
+	DefaultMaxConsensusStates uint64 = 100
 )
@@ -15,6 +15,7 @@ const (
 	QueryAllClients     = "client_states"
 	QueryClientState    = "client_state"
 	QueryConsensusState = "consensus_state"
+	QueryClientFrozen   = "client_frozen"
 )
 
 // QueryAllClientsParams defines the parameters necessary for querying for all
@@ -32,6 +33,34 @@ func NewQueryAllClientsParams(page, limit int) QueryAllClientsParams {
 	}
 }
 
+// QueryClientFrozenParams defines the parameters necessary for querying the
+// frozen status of a light client.
+type QueryClientFrozenParams struct {
+	ClientID string `json:"client_id" yaml:"client_id"`
+}
+
+// NewQueryClientFrozenParams creates a new QueryClientFrozenParams instance.
+func NewQueryClientFrozenParams(clientID string) QueryClientFrozenParams {
+	return QueryClientFrozenParams{
+		ClientID: clientID,
+	}
+}
+
+// ClientFrozenResponse defines the client response for a client frozen status
+// query. A frozen client can't be updated and its proofs don't verify.
+type ClientFrozenResponse struct {
+	ClientID string `json:"client_id" yaml:"client_id"`
+	Frozen   bool   `json:"frozen" yaml:"frozen"`
+}
+
+// NewClientFrozenResponse creates a new ClientFrozenResponse instance.
+func NewClientFrozenResponse(clientID string, frozen bool) ClientFrozenResponse {
+	return ClientFrozenResponse{
+		ClientID: clientID,
+		Frozen:   frozen,
+	}
+}
+
 // StateResponse defines the client response for a client state query.
 // It includes the commitment proof and the height of the proof.
 type StateResponse struct {
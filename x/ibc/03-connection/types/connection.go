@@ -61,7 +61,7 @@ func (c ConnectionEnd) GetVersions() []string {
 
 // ValidateBasic implements the Connection interface
 func (c ConnectionEnd) ValidateBasic() error {
-	if err := host.DefaultClientIdentifierValidator(c.ClientID); err != nil {
+	if err := host.ClientIdentifierValidator(c.ClientID); err != nil {
 		return sdkerrors.Wrapf(err, "invalid client ID: %s", c.ClientID)
 	}
 	if len(c.Versions) == 0 {
@@ -110,7 +110,7 @@ func (c Counterparty) GetPrefix() commitmentexported.Prefix {
 
 // ValidateBasic performs a basic validation check of the identifiers and prefix
 func (c Counterparty) ValidateBasic() error {
-	if err := host.DefaultConnectionIdentifierValidator(c.ConnectionID); err != nil {
+	if err := host.ConnectionIdentifierValidator(c.ConnectionID); err != nil {
 		return sdkerrors.Wrap(err,
 			sdkerrors.Wrapf(
 				ErrInvalidCounterparty,
@@ -118,7 +118,7 @@ func (c Counterparty) ValidateBasic() error {
 			).Error(),
 		)
 	}
-	if err := host.DefaultClientIdentifierValidator(c.ClientID); err != nil {
+	if err := host.ClientIdentifierValidator(c.ClientID); err != nil {
 		return sdkerrors.Wrap(err,
 			sdkerrors.Wrapf(
 				ErrInvalidCounterparty,
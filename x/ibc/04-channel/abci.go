@@ -0,0 +1,13 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/keeper"
+)
+
+// EndBlocker prunes the receipts of the packets received on unordered channels
+// that are older than the receipt retention window and can no longer be
+// replayed.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneReceipts(ctx)
+}
@@ -12,45 +12,95 @@ import (
 )
 
 const (
-	SubModuleName           = types.SubModuleName
-	StoreKey                = types.StoreKey
-	RouterKey               = types.RouterKey
-	QuerierRoute            = types.QuerierRoute
-	QueryAllChannels        = types.QueryAllChannels
-	QueryConnectionChannels = types.QueryConnectionChannels
-	QueryChannel            = types.QueryChannel
+	SubModuleName                = types.SubModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryAllChannels             = types.QueryAllChannels
+	QueryConnectionChannels      = types.QueryConnectionChannels
+	QueryChannel                 = types.QueryChannel
+	QueryNextSequenceSend        = types.QueryNextSequenceSend
+	QueryPacketCommitment        = types.QueryPacketCommitment
+	QueryPacketCommitments       = types.QueryPacketCommitments
+	QueryPacketAck               = types.QueryPacketAck
+	QueryUnreceivedPackets       = types.QueryUnreceivedPackets
+	QueryUnreceivedPacketsRange  = types.QueryUnreceivedPacketsRange
+	QueryUnrelayedAcks           = types.QueryUnrelayedAcks
+	MaxQuerySequences            = types.MaxQuerySequences
+	DefaultMaxPacketProofAge     = types.DefaultMaxPacketProofAge
+	ProposalTypeRelayerAllowlist = types.ProposalTypeRelayerAllowlist
+)
+
+const (
+	DefaultReceiptRetentionWindow = types.DefaultReceiptRetentionWindow
 )
 
 var (
 	// functions aliases
-	NewKeeper                    = keeper.NewKeeper
-	QuerierChannels              = keeper.QuerierChannels
-	QuerierConnectionChannels    = keeper.QuerierConnectionChannels
-	NewChannel                   = types.NewChannel
-	NewCounterparty              = types.NewCounterparty
-	RegisterCodec                = types.RegisterCodec
-	ErrChannelExists             = types.ErrChannelExists
-	ErrChannelNotFound           = types.ErrChannelNotFound
-	ErrInvalidCounterparty       = types.ErrInvalidCounterparty
-	ErrChannelCapabilityNotFound = types.ErrChannelCapabilityNotFound
-	ErrInvalidPacket             = types.ErrInvalidPacket
-	ErrSequenceSendNotFound      = types.ErrSequenceSendNotFound
-	ErrSequenceReceiveNotFound   = types.ErrSequenceReceiveNotFound
-	ErrPacketTimeout             = types.ErrPacketTimeout
-	ErrInvalidChannel            = types.ErrInvalidChannel
-	ErrInvalidChannelState       = types.ErrInvalidChannelState
-	ErrAcknowledgementTooLong    = types.ErrAcknowledgementTooLong
-	NewMsgChannelOpenInit        = types.NewMsgChannelOpenInit
-	NewMsgChannelOpenTry         = types.NewMsgChannelOpenTry
-	NewMsgChannelOpenAck         = types.NewMsgChannelOpenAck
-	NewMsgChannelOpenConfirm     = types.NewMsgChannelOpenConfirm
-	NewMsgChannelCloseInit       = types.NewMsgChannelCloseInit
-	NewMsgChannelCloseConfirm    = types.NewMsgChannelCloseConfirm
-	NewMsgPacket                 = types.NewMsgPacket
-	NewMsgTimeout                = types.NewMsgTimeout
-	NewMsgAcknowledgement        = types.NewMsgAcknowledgement
-	NewPacket                    = types.NewPacket
-	NewChannelResponse           = types.NewChannelResponse
+	NewKeeper                            = keeper.NewKeeper
+	QuerierChannels                      = keeper.QuerierChannels
+	QuerierConnectionChannels            = keeper.QuerierConnectionChannels
+	QuerierNextSequenceSend              = keeper.QuerierNextSequenceSend
+	QuerierPacketCommitment              = keeper.QuerierPacketCommitment
+	QuerierPacketCommitments             = keeper.QuerierPacketCommitments
+	QuerierPacketAck                     = keeper.QuerierPacketAck
+	QuerierUnreceivedPackets             = keeper.QuerierUnreceivedPackets
+	QuerierUnreceivedPacketsRange        = keeper.QuerierUnreceivedPacketsRange
+	QuerierUnrelayedAcks                 = keeper.QuerierUnrelayedAcks
+	HandleRelayerAllowlistProposal       = keeper.HandleRelayerAllowlistProposal
+	NewChannel                           = types.NewChannel
+	NewCounterparty                      = types.NewCounterparty
+	RegisterCodec                        = types.RegisterCodec
+	ErrChannelExists                     = types.ErrChannelExists
+	ErrChannelNotFound                   = types.ErrChannelNotFound
+	ErrInvalidCounterparty               = types.ErrInvalidCounterparty
+	ErrChannelCapabilityNotFound         = types.ErrChannelCapabilityNotFound
+	ErrInvalidPacket                     = types.ErrInvalidPacket
+	ErrSequenceSendNotFound              = types.ErrSequenceSendNotFound
+	ErrSequenceReceiveNotFound           = types.ErrSequenceReceiveNotFound
+	ErrPacketTimeout                     = types.ErrPacketTimeout
+	ErrInvalidChannel                    = types.ErrInvalidChannel
+	ErrInvalidChannelState               = types.ErrInvalidChannelState
+	ErrAcknowledgementTooLong            = types.ErrAcknowledgementTooLong
+	ErrInvalidAcknowledgement            = types.ErrInvalidAcknowledgement
+	ErrPacketSequenceOutOfOrder          = types.ErrPacketSequenceOutOfOrder
+	ErrPacketDataTooLong                 = types.ErrPacketDataTooLong
+	ErrPacketProofTooOld                 = types.ErrPacketProofTooOld
+	ErrPacketCommitmentNotFound          = types.ErrPacketCommitmentNotFound
+	ErrPacketAckNotFound                 = types.ErrPacketAckNotFound
+	ErrPacketAlreadyReceived             = types.ErrPacketAlreadyReceived
+	ParamKeyTable                        = types.ParamKeyTable
+	NewMsgChannelOpenInit                = types.NewMsgChannelOpenInit
+	NewMsgChannelOpenTry                 = types.NewMsgChannelOpenTry
+	NewMsgChannelOpenAck                 = types.NewMsgChannelOpenAck
+	NewMsgChannelOpenConfirm             = types.NewMsgChannelOpenConfirm
+	NewMsgChannelCloseInit               = types.NewMsgChannelCloseInit
+	NewMsgChannelCloseConfirm            = types.NewMsgChannelCloseConfirm
+	NewMsgPacket                         = types.NewMsgPacket
+	NewMsgRecvPackets                    = types.NewMsgRecvPackets
+	NewMsgTimeout                        = types.NewMsgTimeout
+	NewMsgTimeoutOnClose                 = types.NewMsgTimeoutOnClose
+	NewMsgAcknowledgement                = types.NewMsgAcknowledgement
+	NewPacket                            = types.NewPacket
+	NewPacketWithTimeoutHeight           = types.NewPacketWithTimeoutHeight
+	NewPacketReceipt                     = types.NewPacketReceipt
+	NewRelayerAllowlistProposal          = types.NewRelayerAllowlistProposal
+	NewRecvPacketResult                  = types.NewRecvPacketResult
+	NewRecvPacketErrorResult             = types.NewRecvPacketErrorResult
+	NewChannelResponse                   = types.NewChannelResponse
+	NewQueryNextSequenceSendParams       = types.NewQueryNextSequenceSendParams
+	NewQueryPacketCommitmentParams       = types.NewQueryPacketCommitmentParams
+	NewPacketCommitmentResponse          = types.NewPacketCommitmentResponse
+	NewQueryPacketCommitmentsParams      = types.NewQueryPacketCommitmentsParams
+	NewPacketCommitment                  = types.NewPacketCommitment
+	PacketCommitmentPath                 = types.PacketCommitmentPath
+	PacketAcknowledgementPath            = types.PacketAcknowledgementPath
+	PacketReceiptPath                    = types.PacketReceiptPath
+	NewQueryPacketAckParams              = types.NewQueryPacketAckParams
+	NewPacketAckResponse                 = types.NewPacketAckResponse
+	NewQueryUnreceivedPacketsParams      = types.NewQueryUnreceivedPacketsParams
+	NewQueryUnreceivedPacketsRangeParams = types.NewQueryUnreceivedPacketsRangeParams
+	NewQueryUnrelayedAcksParams          = types.NewQueryUnrelayedAcksParams
 
 	// variable aliases
 	SubModuleCdc                 = types.SubModuleCdc
@@ -61,25 +111,43 @@ var (
 	EventTypeChannelCloseInit    = types.EventTypeChannelCloseInit
 	EventTypeChannelCloseConfirm = types.EventTypeChannelCloseConfirm
 	AttributeValueCategory       = types.AttributeValueCategory
+	KeyMaxPacketProofAge         = types.KeyMaxPacketProofAge
+	KeyReceiptRetentionWindow    = types.KeyReceiptRetentionWindow
 )
 
 // nolint: golint
 type (
-	Keeper                 = keeper.Keeper
-	Channel                = types.Channel
-	Counterparty           = types.Counterparty
-	ClientKeeper           = types.ClientKeeper
-	ConnectionKeeper       = types.ConnectionKeeper
-	PortKeeper             = types.PortKeeper
-	MsgChannelOpenInit     = types.MsgChannelOpenInit
-	MsgChannelOpenTry      = types.MsgChannelOpenTry
-	MsgChannelOpenAck      = types.MsgChannelOpenAck
-	MsgChannelOpenConfirm  = types.MsgChannelOpenConfirm
-	MsgChannelCloseInit    = types.MsgChannelCloseInit
-	MsgChannelCloseConfirm = types.MsgChannelCloseConfirm
-	MsgPacket              = types.MsgPacket
-	MsgAcknowledgement     = types.MsgAcknowledgement
-	MsgTimeout             = types.MsgTimeout
-	Packet                 = types.Packet
-	ChannelResponse        = types.ChannelResponse
+	Keeper                            = keeper.Keeper
+	Channel                           = types.Channel
+	IdentifiedChannel                 = types.IdentifiedChannel
+	PacketReceipt                     = types.PacketReceipt
+	Counterparty                      = types.Counterparty
+	ClientKeeper                      = types.ClientKeeper
+	ConnectionKeeper                  = types.ConnectionKeeper
+	PortKeeper                        = types.PortKeeper
+	MsgChannelOpenInit                = types.MsgChannelOpenInit
+	MsgChannelOpenTry                 = types.MsgChannelOpenTry
+	MsgChannelOpenAck                 = types.MsgChannelOpenAck
+	MsgChannelOpenConfirm             = types.MsgChannelOpenConfirm
+	MsgChannelCloseInit               = types.MsgChannelCloseInit
+	MsgChannelCloseConfirm            = types.MsgChannelCloseConfirm
+	MsgPacket                         = types.MsgPacket
+	MsgRecvPackets                    = types.MsgRecvPackets
+	MsgAcknowledgement                = types.MsgAcknowledgement
+	MsgTimeout                        = types.MsgTimeout
+	MsgTimeoutOnClose                 = types.MsgTimeoutOnClose
+	Packet                            = types.Packet
+	RelayerAllowlistProposal          = types.RelayerAllowlistProposal
+	RecvPacketResult                  = types.RecvPacketResult
+	ChannelResponse                   = types.ChannelResponse
+	QueryNextSequenceSendParams       = types.QueryNextSequenceSendParams
+	QueryPacketCommitmentParams       = types.QueryPacketCommitmentParams
+	PacketCommitmentResponse          = types.PacketCommitmentResponse
+	QueryPacketCommitmentsParams      = types.QueryPacketCommitmentsParams
+	PacketCommitment                  = types.PacketCommitment
+	QueryPacketAckParams              = types.QueryPacketAckParams
+	PacketAckResponse                 = types.PacketAckResponse
+	QueryUnreceivedPacketsParams      = types.QueryUnreceivedPacketsParams
+	QueryUnreceivedPacketsRangeParams = types.QueryUnreceivedPacketsRangeParams
+	QueryUnrelayedAcksParams          = types.QueryUnrelayedAcksParams
 )
@@ -17,6 +17,13 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	ics04ChannelQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryChannel(storeKey, cdc),
+		GetCmdQueryNextSequenceSend(storeKey, cdc),
+		GetCmdQueryPacketCommitment(storeKey, cdc),
+		GetCmdQueryPacketCommitments(storeKey, cdc),
+		GetCmdQueryPacketAck(storeKey, cdc),
+		GetCmdQueryUnreceivedPackets(storeKey, cdc),
+		GetCmdQueryUnreceivedPacketsRange(storeKey, cdc),
+		GetCmdQueryUnrelayedAcks(storeKey, cdc),
 	)...)
 
 	return ics04ChannelQueryCmd
@@ -36,6 +43,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetMsgChannelOpenConfirmCmd(storeKey, cdc),
 		GetMsgChannelCloseInitCmd(storeKey, cdc),
 		GetMsgChannelCloseConfirmCmd(storeKey, cdc),
+		GetMsgRecvPacketCmd(storeKey, cdc),
+		GetMsgAcknowledgementCmd(storeKey, cdc),
 	)...)
 
 	return ics04ChannelTxCmd
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -45,3 +46,266 @@ $ %s query ibc channel end [port-id] [channel-id]
 
 	return cmd
 }
+
+// GetCmdQueryNextSequenceSend defines the command to query the next send
+// sequence of a channel
+func GetCmdQueryNextSequenceSend(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "next-sequence-send [port-id] [channel-id]",
+		Short: "Query the next send sequence of a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the sequence of the next packet sent on an IBC channel
+		
+Example:
+$ %s query ibc channel next-sequence-send [port-id] [channel-id]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel next-sequence-send [port-id] [channel-id]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sequence, height, err := utils.QueryNextSequenceSend(cliCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(sequence)
+		},
+	}
+}
+
+// GetCmdQueryPacketCommitment defines the command to query the commitment of a
+// packet sent on a channel
+func GetCmdQueryPacketCommitment(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-commitment [port-id] [channel-id] [sequence]",
+		Short: "Query the commitment of a packet sent on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the commitment of a packet sent on an IBC channel. The commitment
+is deleted once the packet is acknowledged or timed out.
+		
+Example:
+$ %s query ibc channel packet-commitment [port-id] [channel-id] [sequence]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel packet-commitment [port-id] [channel-id] [sequence]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			prove := viper.GetBool(flags.FlagProve)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			commitmentRes, err := utils.QueryPacketCommitment(cliCtx, args[0], args[1], sequence, prove)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(commitmentRes)
+		},
+	}
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+
+	return cmd
+}
+
+// GetCmdQueryPacketCommitments defines the command to query the commitments of
+// all the packets in flight on a channel
+func GetCmdQueryPacketCommitments(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-commitments [port-id] [channel-id]",
+		Short: "Query the commitments of all the packets in flight on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the commitments of all the packets sent on an IBC channel that
+haven't been acknowledged or timed out yet, in ascending sequence order.
+		
+Example:
+$ %s query ibc channel packet-commitments [port-id] [channel-id] --page 2 --limit 100
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel packet-commitments [port-id] [channel-id]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			commitments, height, err := utils.QueryPacketCommitments(cliCtx, args[0], args[1], page, limit)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(commitments)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of packet commitments to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of packet commitments to query for")
+
+	return cmd
+}
+
+// GetCmdQueryPacketAck defines the command to query the acknowledgement of a
+// packet received on a channel
+func GetCmdQueryPacketAck(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-ack [port-id] [channel-id] [sequence]",
+		Short: "Query the acknowledgement of a packet received on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the acknowledgement of a packet received on an IBC channel, which
+is relayed back to the sending chain.
+		
+Example:
+$ %s query ibc channel packet-ack [port-id] [channel-id] [sequence]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel packet-ack [port-id] [channel-id] [sequence]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			prove := viper.GetBool(flags.FlagProve)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			ackRes, err := utils.QueryPacketAck(cliCtx, args[0], args[1], sequence, prove)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(ackRes)
+		},
+	}
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+
+	return cmd
+}
+
+// GetCmdQueryUnreceivedPackets defines the command to query which packet
+// sequences haven't been received on a channel
+func GetCmdQueryUnreceivedPackets(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unreceived-packets [port-id] [channel-id] [sequences]",
+		Short: "Query which of the given packet sequences haven't been received on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query which of the comma separated packet sequences haven't been received on an IBC channel
+		
+Example:
+$ %s query ibc channel unreceived-packets [port-id] [channel-id] 1,2,3
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel unreceived-packets [port-id] [channel-id] [sequences]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sequences, err := parseSequences(args[2])
+			if err != nil {
+				return err
+			}
+
+			unreceived, height, err := utils.QueryUnreceivedPackets(cliCtx, args[0], args[1], sequences)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(unreceived)
+		},
+	}
+}
+
+// GetCmdQueryUnreceivedPacketsRange defines the command to query which packet
+// sequences from a range haven't been received on a channel
+func GetCmdQueryUnreceivedPacketsRange(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unreceived-packets-range [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short: "Query which packet sequences from a range haven't been received on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query which packet sequences from an inclusive range haven't been received on an IBC channel.
+The range is paginated, each page checking up to limit sequences.
+		
+Example:
+$ %s query ibc channel unreceived-packets-range [port-id] [channel-id] 1 1000 --page 2 --limit 100
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel unreceived-packets-range [port-id] [channel-id] [start-sequence] [end-sequence]", version.ClientName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			startSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start sequence %s: %w", args[2], err)
+			}
+
+			endSequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end sequence %s: %w", args[3], err)
+			}
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			unreceived, height, err := utils.QueryUnreceivedPacketsRange(cliCtx, args[0], args[1], startSequence, endSequence, page, limit)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(unreceived)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of the sequence range to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of the sequence range to query for")
+
+	return cmd
+}
+
+// GetCmdQueryUnrelayedAcks defines the command to query which packets sent on a
+// channel haven't been acknowledged yet
+func GetCmdQueryUnrelayedAcks(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unrelayed-acks [port-id] [channel-id] [sequences]",
+		Short: "Query which of the given packets sent on a channel haven't been acknowledged",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query which of the comma separated sequences of packets sent on an IBC channel still have a commitment (i.e haven't been acknowledged)
+		
+Example:
+$ %s query ibc channel unrelayed-acks [port-id] [channel-id] 1,2,3
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc channel unrelayed-acks [port-id] [channel-id] [sequences]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sequences, err := parseSequences(args[2])
+			if err != nil {
+				return err
+			}
+
+			unrelayed, height, err := utils.QueryUnrelayedAcks(cliCtx, args[0], args[1], sequences)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(unrelayed)
+		},
+	}
+}
+
+// parseSequences parses a comma separated list of packet sequences
+func parseSequences(arg string) ([]uint64, error) {
+	sequences := []uint64{}
+	for _, s := range strings.Split(arg, ",") {
+		sequence, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid packet sequence %s: %w", s, err)
+		}
+		sequences = append(sequences, sequence)
+	}
+	return sequences, nil
+}
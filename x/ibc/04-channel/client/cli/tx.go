@@ -2,6 +2,9 @@ package cli
 
 import (
 	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -9,19 +12,24 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	connectionutils "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 )
 
 // IBC Channel flags
 const (
 	FlagOrdered    = "ordered"
 	FlagIBCVersion = "ibc-version"
+	FlagSourceNode = "source-node"
 )
 
 // GetMsgChannelOpenInitCmd returns the command to create a MsgChannelOpenInit transaction
@@ -249,3 +257,142 @@ func channelOrder() exported.Order {
 	}
 	return exported.UNORDERED
 }
+
+// GetMsgRecvPacketCmd returns the command to create a MsgPacket transaction
+func GetMsgRecvPacketCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recv-packet [/path/to/packet.json] [/path/to/proof.json] [proof-height]",
+		Short: "Creates and sends a MsgPacket message to receive a packet",
+		Long: strings.TrimSpace(fmt.Sprintf(`Creates and sends a MsgPacket message to receive a packet sent by the
+counterparty chain. With --prove, the proof of the packet commitment is queried from the
+counterparty node given by --source-node, and the proof height is filled in, so only the
+packet has to be provided. The client of the counterparty chain must have been updated to
+the proof height.
+
+Example:
+$ %s tx ibc channel recv-packet packet.json --prove --source-node tcp://localhost:26657
+		`, version.ClientName),
+		),
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			packet, err := utils.ParsePacket(cliCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			proof, proofHeight, err := packetProof(cliCtx, args[1:], func(sourceCtx context.CLIContext) (commitmenttypes.MerkleProof, uint64, error) {
+				return utils.QueryPacketCommitmentProof(sourceCtx, packet)
+			})
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPacket(packet, proof, proofHeight, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Bool(flags.FlagProve, false, "query the proof of the packet commitment from the counterparty chain")
+	cmd.Flags().String(FlagSourceNode, "", "<host>:<port> to the tendermint RPC interface of the counterparty chain")
+
+	return cmd
+}
+
+// GetMsgAcknowledgementCmd returns the command to create a MsgAcknowledgement transaction
+func GetMsgAcknowledgementCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ack-packet [/path/to/packet.json] [acknowledgement] [/path/to/proof.json] [proof-height]",
+		Short: "Creates and sends a MsgAcknowledgement message to acknowledge a packet",
+		Long: strings.TrimSpace(fmt.Sprintf(`Creates and sends a MsgAcknowledgement message to acknowledge a packet
+received by the counterparty chain, with the raw acknowledgement written by the counterparty
+chain in hex. With --prove, the proof of the acknowledgement is queried from the counterparty
+node given by --source-node, and the proof height is filled in, so only the packet and the
+acknowledgement have to be provided. The client of the counterparty chain must have been
+updated to the proof height.
+
+Example:
+$ %s tx ibc channel ack-packet packet.json [acknowledgement] --prove --source-node tcp://localhost:26657
+		`, version.ClientName),
+		),
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			packet, err := utils.ParsePacket(cliCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			ack, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex acknowledgement %s: %w", args[1], err)
+			}
+
+			proof, proofHeight, err := packetProof(cliCtx, args[2:], func(sourceCtx context.CLIContext) (commitmenttypes.MerkleProof, uint64, error) {
+				return utils.QueryPacketAckProof(sourceCtx, packet)
+			})
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcknowledgement(packet, ack, proof, proofHeight, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Bool(flags.FlagProve, false, "query the proof of the acknowledgement from the counterparty chain")
+	cmd.Flags().String(FlagSourceNode, "", "<host>:<port> to the tendermint RPC interface of the counterparty chain")
+
+	return cmd
+}
+
+// packetProof returns the proof and proof height of a packet message, either
+// parsed from the [/path/to/proof.json] [proof-height] arguments or, with the
+// prove flag, queried from the counterparty node.
+func packetProof(
+	cliCtx context.CLIContext, args []string,
+	queryProof func(sourceCtx context.CLIContext) (commitmenttypes.MerkleProof, uint64, error),
+) (commitmenttypes.MerkleProof, uint64, error) {
+	if viper.GetBool(flags.FlagProve) {
+		if len(args) != 0 {
+			return commitmenttypes.MerkleProof{}, 0, errors.New("the proof and proof height cannot be provided along with --prove")
+		}
+
+		sourceNode := viper.GetString(FlagSourceNode)
+		if sourceNode == "" {
+			return commitmenttypes.MerkleProof{}, 0, fmt.Errorf("--%s is required along with --prove", FlagSourceNode)
+		}
+
+		// the proof is verified on chain by the client of the counterparty chain,
+		// so it doesn't need to be verified against the local light client
+		return queryProof(cliCtx.WithNodeURI(sourceNode).WithTrustNode(true))
+	}
+
+	if len(args) != 2 {
+		return commitmenttypes.MerkleProof{}, 0, errors.New("the proof and proof height are required without --prove")
+	}
+
+	proof, err := connectionutils.ParseProof(cliCtx.Codec, args[0])
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, 0, err
+	}
+
+	proofHeight, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, 0, fmt.Errorf("invalid proof height %s: %w", args[1], err)
+	}
+
+	return proof, proofHeight, nil
+}
@@ -1,17 +1,24 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // QueryPacket returns a packet from the store
 func QueryPacket(
 	ctx context.CLIContext, portID, channelID string,
-	sequence, timeout uint64, prove bool,
+	sequence, timeoutHeight, timeoutTimestamp uint64, prove bool,
 ) (types.PacketResponse, error) {
 	req := abci.RequestQuery{
 		Path:  "store/ibc/key",
@@ -39,7 +46,8 @@ func QueryPacket(
 		channelID,
 		destPortID,
 		destChannelID,
-		timeout,
+		timeoutHeight,
+		timeoutTimestamp,
 	)
 
 	// FIXME: res.Height+1 is hack, fix later
@@ -67,3 +75,236 @@ func QueryChannel(
 	}
 	return types.NewChannelResponse(portID, channelID, channel, res.Proof, res.Height), nil
 }
+
+// QueryNextSequenceSend returns the next send sequence of a channel. It _does not_
+// return any merkle proof.
+func QueryNextSequenceSend(cliCtx context.CLIContext, portID, channelID string) (uint64, int64, error) {
+	params := types.NewQueryNextSequenceSendParams(portID, channelID)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", ibctypes.QuerierRoute, types.QuerierRoute, types.QueryNextSequenceSend)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var sequence uint64
+	if err := cliCtx.Codec.UnmarshalJSON(res, &sequence); err != nil {
+		return 0, 0, fmt.Errorf("failed to unmarshal next send sequence: %w", err)
+	}
+	return sequence, height, nil
+}
+
+// QueryPacketCommitment returns the commitment of a packet sent on a channel.
+// If prove is true, the commitment is queried from the store along with its
+// merkle proof.
+func QueryPacketCommitment(
+	cliCtx context.CLIContext, portID, channelID string, sequence uint64, prove bool,
+) (types.PacketCommitmentResponse, error) {
+	if prove {
+		req := abci.RequestQuery{
+			Path:  "store/ibc/key",
+			Data:  ibctypes.KeyPacketCommitment(portID, channelID, sequence),
+			Prove: true,
+		}
+
+		res, err := cliCtx.QueryABCI(req)
+		if err != nil {
+			return types.PacketCommitmentResponse{}, err
+		}
+
+		if len(res.Value) == 0 {
+			return types.PacketCommitmentResponse{}, sdkerrors.Wrapf(
+				types.ErrPacketCommitmentNotFound, "port ID (%s) channel ID (%s) sequence (%d)", portID, channelID, sequence,
+			)
+		}
+
+		return types.NewPacketCommitmentResponse(portID, channelID, sequence, res.Value, res.Proof, res.Height), nil
+	}
+
+	params := types.NewQueryPacketCommitmentParams(portID, channelID, sequence)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.PacketCommitmentResponse{}, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", ibctypes.QuerierRoute, types.QuerierRoute, types.QueryPacketCommitment)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.PacketCommitmentResponse{}, err
+	}
+
+	var commitmentRes types.PacketCommitmentResponse
+	if err := cliCtx.Codec.UnmarshalJSON(res, &commitmentRes); err != nil {
+		return types.PacketCommitmentResponse{}, fmt.Errorf("failed to unmarshal packet commitment: %w", err)
+	}
+	return commitmentRes, nil
+}
+
+// QueryPacketCommitments returns a page of the commitments of the packets in
+// flight on a channel, in ascending sequence order. It _does not_ return any
+// merkle proof.
+func QueryPacketCommitments(
+	cliCtx context.CLIContext, portID, channelID string, page, limit int,
+) ([]types.PacketCommitment, int64, error) {
+	params := types.NewQueryPacketCommitmentsParams(portID, channelID, page, limit)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", ibctypes.QuerierRoute, types.QuerierRoute, types.QueryPacketCommitments)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var commitments []types.PacketCommitment
+	if err := cliCtx.Codec.UnmarshalJSON(res, &commitments); err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal packet commitments: %w", err)
+	}
+	return commitments, height, nil
+}
+
+// QueryPacketAck returns the acknowledgement of a packet received on a
+// channel. If prove is true, the acknowledgement is queried from the store
+// along with its merkle proof.
+func QueryPacketAck(
+	cliCtx context.CLIContext, portID, channelID string, sequence uint64, prove bool,
+) (types.PacketAckResponse, error) {
+	if prove {
+		req := abci.RequestQuery{
+			Path:  "store/ibc/key",
+			Data:  ibctypes.KeyPacketAcknowledgement(portID, channelID, sequence),
+			Prove: true,
+		}
+
+		res, err := cliCtx.QueryABCI(req)
+		if err != nil {
+			return types.PacketAckResponse{}, err
+		}
+
+		if len(res.Value) == 0 {
+			return types.PacketAckResponse{}, sdkerrors.Wrapf(
+				types.ErrPacketAckNotFound, "port ID (%s) channel ID (%s) sequence (%d)", portID, channelID, sequence,
+			)
+		}
+
+		return types.NewPacketAckResponse(portID, channelID, sequence, res.Value, res.Proof, res.Height), nil
+	}
+
+	params := types.NewQueryPacketAckParams(portID, channelID, sequence)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.PacketAckResponse{}, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", ibctypes.QuerierRoute, types.QuerierRoute, types.QueryPacketAck)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.PacketAckResponse{}, err
+	}
+
+	var ackRes types.PacketAckResponse
+	if err := cliCtx.Codec.UnmarshalJSON(res, &ackRes); err != nil {
+		return types.PacketAckResponse{}, fmt.Errorf("failed to unmarshal packet acknowledgement: %w", err)
+	}
+	return ackRes, nil
+}
+
+// QueryUnreceivedPackets returns which of the given packet sequences haven't
+// been received on a channel. It _does not_ return any merkle proof.
+func QueryUnreceivedPackets(cliCtx context.CLIContext, portID, channelID string, sequences []uint64) ([]uint64, int64, error) {
+	params := types.NewQueryUnreceivedPacketsParams(portID, channelID, sequences)
+	return querySequences(cliCtx, types.QueryUnreceivedPackets, params)
+}
+
+// QueryUnreceivedPacketsRange returns which of the packet sequences from a page
+// of the given range haven't been received on a channel. It _does not_ return
+// any merkle proof.
+func QueryUnreceivedPacketsRange(
+	cliCtx context.CLIContext, portID, channelID string, startSequence, endSequence uint64, page, limit int,
+) ([]uint64, int64, error) {
+	params := types.NewQueryUnreceivedPacketsRangeParams(portID, channelID, startSequence, endSequence, page, limit)
+	return querySequences(cliCtx, types.QueryUnreceivedPacketsRange, params)
+}
+
+func querySequences(cliCtx context.CLIContext, queryPath string, params interface{}) ([]uint64, int64, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", ibctypes.QuerierRoute, types.QuerierRoute, queryPath)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var sequences []uint64
+	if err := cliCtx.Codec.UnmarshalJSON(res, &sequences); err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal packet sequences: %w", err)
+	}
+	return sequences, height, nil
+}
+
+// QueryUnrelayedAcks returns which of the given packets sent on a channel
+// haven't been acknowledged yet. It _does not_ return any merkle proof.
+func QueryUnrelayedAcks(cliCtx context.CLIContext, portID, channelID string, sequences []uint64) ([]uint64, int64, error) {
+	params := types.NewQueryUnrelayedAcksParams(portID, channelID, sequences)
+	return querySequences(cliCtx, types.QueryUnrelayedAcks, params)
+}
+
+// ParsePacket unmarshals a packet from a JSON string or from the path to a
+// JSON file.
+func ParsePacket(cdc *codec.Codec, arg string) (types.Packet, error) {
+	var packet types.Packet
+	if err := cdc.UnmarshalJSON([]byte(arg), &packet); err != nil {
+		// check for file path if JSON input is not provided
+		contents, err := ioutil.ReadFile(arg)
+		if err != nil {
+			return types.Packet{}, errors.New("neither JSON input nor path to .json file were provided")
+		}
+		if err := cdc.UnmarshalJSON(contents, &packet); err != nil {
+			return types.Packet{}, fmt.Errorf("error unmarshalling packet: %w", err)
+		}
+	}
+	return packet, nil
+}
+
+// QueryPacketCommitmentProof returns the merkle proof of the commitment of a
+// packet, queried from its source chain, along with the proof height to submit
+// it with, at which the client of the source chain must have been updated.
+// It returns an error if the queried proof is empty.
+func QueryPacketCommitmentProof(cliCtx context.CLIContext, packet types.Packet) (commitmenttypes.MerkleProof, uint64, error) {
+	res, err := QueryPacketCommitment(cliCtx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), true)
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, 0, err
+	}
+	return proofWithHeight(res.Proof, res.ProofHeight)
+}
+
+// QueryPacketAckProof returns the merkle proof of the acknowledgement of a
+// packet, queried from its destination chain, along with the proof height to
+// submit it with, at which the client of the destination chain must have been
+// updated. It returns an error if the queried proof is empty.
+func QueryPacketAckProof(cliCtx context.CLIContext, packet types.Packet) (commitmenttypes.MerkleProof, uint64, error) {
+	res, err := QueryPacketAck(cliCtx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), true)
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, 0, err
+	}
+	return proofWithHeight(res.Proof, res.ProofHeight)
+}
+
+// proofWithHeight checks that the proof isn't empty and returns the height of
+// the header committing to the queried state: the app hash of the state at a
+// given height is only included in the header of the next block.
+func proofWithHeight(proof commitmenttypes.MerkleProof, queryHeight uint64) (commitmenttypes.MerkleProof, uint64, error) {
+	if proof.IsEmpty() || len(proof.Proof.Ops) == 0 {
+		return commitmenttypes.MerkleProof{}, 0, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "the queried node returned an empty proof")
+	}
+	return proof, queryHeight + 1, nil
+}
@@ -3,6 +3,8 @@ package exported
 import (
 	"encoding/json"
 	"fmt"
+
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // ChannelI defines the standard interface for a channel end.
@@ -26,7 +28,8 @@ type CounterpartyI interface {
 // PacketI defines the standard interface for IBC packets
 type PacketI interface {
 	GetSequence() uint64
-	GetTimeoutHeight() uint64
+	GetTimeoutHeight() ibctypes.Height
+	GetTimeoutTimestamp() uint64
 	GetSourcePort() string
 	GetSourceChannel() string
 	GetDestPort() string
@@ -1,8 +1,12 @@
 package channel
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/keeper"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 )
@@ -169,3 +173,43 @@ func HandleMsgChannelCloseConfirm(ctx sdk.Context, k keeper.Keeper, channelCap *
 		Events: ctx.EventManager().Events().ToABCIEvents(),
 	}, nil
 }
+
+// HandleMsgPacketAlreadyReceived defines the sdk.Handler for a MsgPacket whose
+// packet was already received. The packet is not executed again and an event is
+// emitted so that relayers can detect the redundant relay.
+func HandleMsgPacketAlreadyReceived(ctx sdk.Context, msg types.MsgPacket) *sdk.Result {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacketAlreadyReceived,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", msg.Packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, msg.Packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, msg.Packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, msg.Packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, msg.Packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return &sdk.Result{
+		Log:    "packet already received",
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}
+}
+
+// NewRelayerAllowlistProposalHandler returns the governance handler of the
+// relayer allowlist proposals.
+func NewRelayerAllowlistProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RelayerAllowlistProposal:
+			return keeper.HandleRelayerAllowlistProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC channel proposal content type: %T", c)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper defines the IBC channel keeper
@@ -21,6 +25,7 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     capability.ScopedKeeper
+	paramSpace       paramtypes.Subspace
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -28,7 +33,12 @@ func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey,
 	clientKeeper types.ClientKeeper, connectionKeeper types.ConnectionKeeper,
 	portKeeper types.PortKeeper, scopedKeeper capability.ScopedKeeper,
+	paramSpace paramtypes.Subspace,
 ) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:         key,
 		cdc:              cdc,
@@ -36,6 +46,7 @@ func NewKeeper(
 		connectionKeeper: connectionKeeper,
 		portKeeper:       portKeeper,
 		scopedKeeper:     scopedKeeper,
+		paramSpace:       paramSpace,
 	}
 }
 
@@ -44,6 +55,34 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s/%s", ibctypes.ModuleName, types.SubModuleName))
 }
 
+// GetMaxPacketProofAge returns the maximum number of blocks the proof of a
+// received packet can be behind the latest height of the counterparty client.
+// Zero disables the check.
+func (k Keeper) GetMaxPacketProofAge(ctx sdk.Context) uint64 {
+	maxAge := types.DefaultMaxPacketProofAge
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxPacketProofAge, &maxAge)
+	return maxAge
+}
+
+// SetMaxPacketProofAge sets the maximum age of the received packet proofs.
+func (k Keeper) SetMaxPacketProofAge(ctx sdk.Context, maxAge uint64) {
+	k.paramSpace.Set(ctx, types.KeyMaxPacketProofAge, maxAge)
+}
+
+// GetReceiptRetentionWindow returns the number of blocks the receipts of the
+// packets received on unordered channels are kept for at least. Zero disables
+// the pruning of the receipts.
+func (k Keeper) GetReceiptRetentionWindow(ctx sdk.Context) uint64 {
+	window := types.DefaultReceiptRetentionWindow
+	k.paramSpace.GetIfExists(ctx, types.KeyReceiptRetentionWindow, &window)
+	return window
+}
+
+// SetReceiptRetentionWindow sets the retention window of the packet receipts.
+func (k Keeper) SetReceiptRetentionWindow(ctx sdk.Context, window uint64) {
+	k.paramSpace.Set(ctx, types.KeyReceiptRetentionWindow, window)
+}
+
 // GetChannel returns a channel with a particular identifier binded to a specific port
 func (k Keeper) GetChannel(ctx sdk.Context, portID, channelID string) (types.Channel, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -113,11 +152,50 @@ func (k Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string, s
 	store.Set(ibctypes.KeyPacketCommitment(portID, channelID, sequence), commitmentHash)
 }
 
-func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
+// DeletePacketCommitment deletes the packet commitment hash from the store
+func (k Keeper) DeletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(ibctypes.KeyPacketCommitment(portID, channelID, sequence))
 }
 
+// IteratePacketCommitments provides an iterator over the commitments of the
+// packets sent on a channel, in the lexicographic order of their store keys. For
+// each commitment, cb will be called. If the cb returns true, the iterator will
+// close and stop.
+func (k Keeper) IteratePacketCommitments(ctx sdk.Context, portID, channelID string, cb func(sequence uint64, commitment []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := ibctypes.PacketCommitmentPrefixPath(portID, channelID)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(prefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		sequence, err := strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), prefix), 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("invalid packet commitment key %s: %w", iterator.Key(), err))
+		}
+
+		if cb(sequence, iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetPacketCommitments returns the commitments of all the packets sent on a
+// channel that are still in flight, in ascending sequence order. The store keys
+// don't pad the sequences, so their lexicographic order has to be sorted.
+func (k Keeper) GetPacketCommitments(ctx sdk.Context, portID, channelID string) []types.PacketCommitment {
+	commitments := []types.PacketCommitment{}
+	k.IteratePacketCommitments(ctx, portID, channelID, func(sequence uint64, commitment []byte) bool {
+		commitments = append(commitments, types.NewPacketCommitment(sequence, commitment))
+		return false
+	})
+
+	sort.Slice(commitments, func(i, j int) bool {
+		return commitments[i].Sequence < commitments[j].Sequence
+	})
+	return commitments
+}
+
 // SetPacketAcknowledgement sets the packet ack hash to the store
 func (k Keeper) SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ackHash []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -153,6 +231,25 @@ func (k Keeper) IterateChannels(ctx sdk.Context, cb func(types.IdentifiedChannel
 	}
 }
 
+// IteratePortChannels provides an iterator over the Channel objects of a port.
+// For each Channel, cb will be called. If the cb returns true, the iterator will
+// close and stop.
+func (k Keeper) IteratePortChannels(ctx sdk.Context, portID string, cb func(types.IdentifiedChannel) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(ibctypes.PortChannelsPrefixPath(portID)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.Channel
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &channel)
+		_, channelID := ibctypes.MustParseChannelPath(string(iterator.Key()))
+
+		if cb(types.IdentifiedChannel{Channel: channel, PortIdentifier: portID, ChannelIdentifier: channelID}) {
+			break
+		}
+	}
+}
+
 // GetAllChannels returns all stored Channel objects.
 func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChannel) {
 	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
@@ -130,6 +130,14 @@ func (suite KeeperTestSuite) TestGetAllChannels() {
 	channels := suite.chainB.App.IBCKeeper.ChannelKeeper.GetAllChannels(ctx)
 	suite.Require().Len(channels, len(expChannels))
 	suite.Require().Equal(expChannels, channels)
+
+	// only the channels of the port are iterated
+	var portChannels []types.IdentifiedChannel
+	suite.chainB.App.IBCKeeper.ChannelKeeper.IteratePortChannels(ctx, testPort2, func(channel types.IdentifiedChannel) bool {
+		portChannels = append(portChannels, channel)
+		return false
+	})
+	suite.Require().Equal(expChannels[1:2], portChannels)
 }
 
 func (suite *KeeperTestSuite) TestSetSequence() {
@@ -182,6 +190,48 @@ func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
 	suite.Equal(ackHash, storedAckHash)
 }
 
+func (suite *KeeperTestSuite) TestPacketPaths() {
+	suite.chainB.CreateClient(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+	seq := uint64(10)
+
+	commitment := []byte("commitment")
+	ackHash := []byte("ackhash")
+	receipt := types.NewPacketReceipt(1, ibctypes.NewHeight(0, 100), 0)
+	channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, seq, commitment)
+	channelKeeper.SetPacketAcknowledgement(ctx, testPort1, testChannel1, seq, ackHash)
+	channelKeeper.SetPacketReceipt(ctx, testPort1, testChannel1, seq, receipt)
+
+	commitNBlocks(suite.chainA, 1)
+	root := commitmenttypes.NewMerkleRoot(suite.chainA.App.LastCommitID().Hash)
+	prefix := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix()
+
+	testCases := []struct {
+		msg      string
+		pathFn   func(commitmentexported.Prefix, string, string, uint64) (commitmenttypes.MerklePath, error)
+		storeKey []byte
+		value    []byte
+	}{
+		{"packet commitment", types.PacketCommitmentPath, ibctypes.KeyPacketCommitment(testPort1, testChannel1, seq), commitment},
+		{"packet acknowledgement", types.PacketAcknowledgementPath, ibctypes.KeyPacketAcknowledgement(testPort1, testChannel1, seq), ackHash},
+		{"packet receipt", types.PacketReceiptPath, ibctypes.KeyPacketReceipt(testPort1, testChannel1, seq), suite.cdc.MustMarshalBinaryBare(receipt)},
+	}
+
+	for _, tc := range testCases {
+		path, err := tc.pathFn(prefix, testPort1, testChannel1, seq)
+		suite.Require().NoError(err, tc.msg)
+		suite.Require().Equal(commitmenttypes.NewMerklePath([]string{ibctypes.StoreKey, string(tc.storeKey)}), path, tc.msg)
+
+		// the path must resolve to the value the keeper wrote
+		proof, _ := queryProof(suite.chainA, tc.storeKey)
+		suite.Require().NoError(proof.VerifyMembership(root, path, tc.value), tc.msg)
+
+		_, err = tc.pathFn(commitmenttypes.MerklePrefix{}, testPort1, testChannel1, seq)
+		suite.Require().Error(err, tc.msg)
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
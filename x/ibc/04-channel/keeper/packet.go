@@ -77,7 +77,8 @@ func (k Keeper) SendPacket(
 	}
 
 	// check if packet timeouted on the receiving chain
-	if clientState.GetLatestHeight() >= packet.GetTimeoutHeight() {
+	latestHeight := ibctypes.NewHeight(ibctypes.ParseChainID(clientState.GetChainID()), clientState.GetLatestHeight())
+	if ibctypes.IsTimeoutHeightReached(latestHeight, packet.GetTimeoutHeight()) {
 		return sdkerrors.Wrap(types.ErrPacketTimeout, "timeout already passed ond the receiving chain")
 	}
 
@@ -103,7 +104,7 @@ func (k Keeper) SendPacket(
 		sdk.NewEvent(
 			types.EventTypeSendPacket,
 			sdk.NewAttribute(types.AttributeKeyData, string(packet.GetData())),
-			sdk.NewAttribute(types.AttributeKeyTimeout, fmt.Sprintf("%d", packet.GetTimeoutHeight())),
+			sdk.NewAttribute(types.AttributeKeyTimeout, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
 			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
@@ -167,8 +168,29 @@ func (k Keeper) RecvPacket(
 	}
 
 	// check if packet timeouted by comparing it with the latest height of the chain
-	if uint64(ctx.BlockHeight()) >= packet.GetTimeoutHeight() {
-		return nil, types.ErrPacketTimeout
+	if ibctypes.IsTimeoutHeightReached(ibctypes.GetSelfHeight(ctx), packet.GetTimeoutHeight()) {
+		return nil, sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout height already passed")
+	}
+
+	// check if packet timeouted by comparing it with the latest block time of the chain
+	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
+		return nil, sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout timestamp already passed")
+	}
+
+	// a proof at an old height may reference stale counterparty state
+	if maxAge := k.GetMaxPacketProofAge(ctx); maxAge != 0 {
+		clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+		if !found {
+			return nil, sdkerrors.Wrap(client.ErrClientNotFound, connectionEnd.GetClientID())
+		}
+
+		latestHeight := clientState.GetLatestHeight()
+		if latestHeight > proofHeight && latestHeight-proofHeight > maxAge {
+			return nil, sdkerrors.Wrapf(
+				types.ErrPacketProofTooOld,
+				"proof height %d is more than %d blocks behind the latest client height %d", proofHeight, maxAge, latestHeight,
+			)
+		}
 	}
 
 	if err := k.connectionKeeper.VerifyPacketCommitment(
@@ -182,6 +204,101 @@ func (k Keeper) RecvPacket(
 	return packet, nil
 }
 
+// IsPacketReceived returns true if the packet was already received and executed
+// on its destination channel end. Packets of ordered channels are received if
+// their sequence is lower than the next receive sequence, while packets of
+// unordered channels are received if their receipt or acknowledgement is stored.
+func (k Keeper) IsPacketReceived(ctx sdk.Context, packet exported.PacketI) bool {
+	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return false
+	}
+
+	return k.isReceived(ctx, channel, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+}
+
+// GetUnreceivedPackets returns the subset of the given packet sequences that
+// haven't been received on the channel end, in the same order.
+func (k Keeper) GetUnreceivedPackets(ctx sdk.Context, portID, channelID string, sequences []uint64) ([]uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	unreceived := []uint64{}
+	for _, sequence := range sequences {
+		if !k.isReceived(ctx, channel, portID, channelID, sequence) {
+			unreceived = append(unreceived, sequence)
+		}
+	}
+
+	return unreceived, nil
+}
+
+// GetUnrelayedAcks returns the subset of the given sequences of packets sent on
+// the channel end whose commitment is still stored (i.e that haven't been
+// acknowledged or timed out yet), in the same order.
+func (k Keeper) GetUnrelayedAcks(ctx sdk.Context, portID, channelID string, sequences []uint64) ([]uint64, error) {
+	if _, found := k.GetChannel(ctx, portID, channelID); !found {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	unrelayed := []uint64{}
+	for _, sequence := range sequences {
+		if k.GetPacketCommitment(ctx, portID, channelID, sequence) != nil {
+			unrelayed = append(unrelayed, sequence)
+		}
+	}
+
+	return unrelayed, nil
+}
+
+// isReceived returns true if the packet with the given sequence was received on
+// the channel end.
+func (k Keeper) isReceived(ctx sdk.Context, channel types.Channel, portID, channelID string, sequence uint64) bool {
+	if channel.Ordering == exported.ORDERED {
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+		return found && sequence < nextSequenceRecv
+	}
+
+	// the acknowledgements of the packets received before the receipts were
+	// stored still mark them as received
+	if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); found {
+		return true
+	}
+
+	_, found := k.GetPacketAcknowledgement(ctx, portID, channelID, sequence)
+	return found
+}
+
+// ValidatePacketSequence checks that the packet sequence is the next receive
+// sequence of its destination channel end if the channel is ORDERED. Packets of
+// UNORDERED channels can be received in any order.
+func (k Keeper) ValidatePacketSequence(ctx sdk.Context, packet exported.PacketI) error {
+	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
+	}
+
+	if channel.Ordering != exported.ORDERED {
+		return nil
+	}
+
+	nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return types.ErrSequenceReceiveNotFound
+	}
+
+	if packet.GetSequence() != nextSequenceRecv {
+		return sdkerrors.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next receive sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceRecv,
+		)
+	}
+
+	return nil
+}
+
 // PacketExecuted writes the packet execution acknowledgement to the state,
 // which will be verified by the counterparty chain using AcknowledgePacket.
 // CONTRACT: each packet handler function should call WriteAcknowledgement at the end of the execution
@@ -217,21 +334,13 @@ func (k Keeper) PacketExecuted(
 	}
 
 	if channel.Ordering == exported.ORDERED {
-		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
-		if !found {
-			return types.ErrSequenceReceiveNotFound
-		}
-
-		if packet.GetSequence() != nextSequenceRecv {
-			return sdkerrors.Wrapf(
-				types.ErrInvalidPacket,
-				"packet sequence ≠ next receive sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceRecv,
-			)
+		if err := k.ValidatePacketSequence(ctx, packet); err != nil {
+			return err
 		}
 
-		nextSequenceRecv++
-
-		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
+		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+1)
+	} else {
+		k.recordPacketReceipt(ctx, packet)
 	}
 
 	// log that a packet has been received & executed
@@ -242,7 +351,7 @@ func (k Keeper) PacketExecuted(
 		sdk.NewEvent(
 			types.EventTypeRecvPacket,
 			sdk.NewAttribute(types.AttributeKeyData, string(acknowledgement)),
-			sdk.NewAttribute(types.AttributeKeyTimeout, fmt.Sprintf("%d", packet.GetTimeoutHeight())),
+			sdk.NewAttribute(types.AttributeKeyTimeout, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
 			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
@@ -329,7 +438,7 @@ func (k Keeper) AcknowledgePacket(
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeAcknowledgePacket,
-			sdk.NewAttribute(types.AttributeKeyTimeout, fmt.Sprintf("%d", packet.GetTimeoutHeight())),
+			sdk.NewAttribute(types.AttributeKeyTimeout, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
 			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
@@ -338,9 +447,21 @@ func (k Keeper) AcknowledgePacket(
 		),
 	})
 
+	// NOTE: the remaining code is located on the AcknowledgementExecuted function
 	return packet, nil
 }
 
+// AcknowledgementExecuted deletes the commitment send from this chain after it
+// receives the acknowledgement
+func (k Keeper) AcknowledgementExecuted(ctx sdk.Context, chanCap *capability.Capability, packet exported.PacketI) error {
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, ibctypes.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
+		return sdkerrors.Wrap(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel")
+	}
+
+	k.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	return nil
+}
+
 // CleanupPacket is called by a module to remove a received packet commitment
 // from storage. The receiving end must have already processed the packet
 // (whether regularly or past timeout).
@@ -435,7 +556,7 @@ func (k Keeper) CleanupPacket(
 		return nil, sdkerrors.Wrap(err, "packet verification failed")
 	}
 
-	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(fmt.Sprintf("packet cleaned-up: %v", packet))
@@ -444,7 +565,7 @@ func (k Keeper) CleanupPacket(
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCleanupPacket,
-			sdk.NewAttribute(types.AttributeKeyTimeout, fmt.Sprintf("%d", packet.GetTimeoutHeight())),
+			sdk.NewAttribute(types.AttributeKeyTimeout, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
 			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
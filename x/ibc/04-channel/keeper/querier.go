@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -66,3 +68,201 @@ func QuerierConnectionChannels(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 
 	return res, nil
 }
+
+// QuerierNextSequenceSend defines the sdk.Querier to query the next send sequence
+// of a channel.
+func QuerierNextSequenceSend(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNextSequenceSendParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := k.GetChannel(ctx, params.PortID, params.ChannelID); !found {
+		return nil, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", params.PortID, params.ChannelID)
+	}
+
+	sequence, found := k.GetNextSequenceSend(ctx, params.PortID, params.ChannelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", params.PortID, params.ChannelID)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, sequence)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// QuerierPacketCommitment defines the sdk.Querier to query the commitment of a
+// packet sent on a channel. The commitment is deleted once the packet is
+// acknowledged or timed out.
+func QuerierPacketCommitment(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPacketCommitmentParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	commitment := k.GetPacketCommitment(ctx, params.PortID, params.ChannelID, params.Sequence)
+	if len(commitment) == 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrPacketCommitmentNotFound,
+			"port ID (%s) channel ID (%s) sequence (%d)", params.PortID, params.ChannelID, params.Sequence,
+		)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewPacketCommitmentResponse(params.PortID, params.ChannelID, params.Sequence, commitment, nil, 0))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// QuerierPacketCommitments defines the sdk.Querier to query the commitments of
+// all the packets in flight on a channel, a page at a time in ascending
+// sequence order.
+func QuerierPacketCommitments(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPacketCommitmentsParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	commitments := k.GetPacketCommitments(ctx, params.PortID, params.ChannelID)
+
+	start, end := client.Paginate(len(commitments), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		commitments = []types.PacketCommitment{}
+	} else {
+		commitments = commitments[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, commitments)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// QuerierPacketAck defines the sdk.Querier to query the acknowledgement of a
+// packet received on a channel.
+func QuerierPacketAck(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPacketAckParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	ack, found := k.GetPacketAcknowledgement(ctx, params.PortID, params.ChannelID, params.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			types.ErrPacketAckNotFound,
+			"port ID (%s) channel ID (%s) sequence (%d)", params.PortID, params.ChannelID, params.Sequence,
+		)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewPacketAckResponse(params.PortID, params.ChannelID, params.Sequence, ack, nil, 0))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// QuerierUnreceivedPackets defines the sdk.Querier to query which of the given
+// packet sequences haven't been received on a channel.
+func QuerierUnreceivedPackets(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUnreceivedPacketsParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	unreceived, err := k.GetUnreceivedPackets(ctx, params.PortID, params.ChannelID, params.Sequences)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, unreceived)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// QuerierUnreceivedPacketsRange defines the sdk.Querier to query which of the
+// packet sequences from a paginated range haven't been received on a channel.
+func QuerierUnreceivedPacketsRange(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUnreceivedPacketsRangeParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.StartSequence == 0 || params.EndSequence < params.StartSequence {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid packet sequence range [%d, %d]", params.StartSequence, params.EndSequence,
+		)
+	}
+
+	// the range length is capped so that it can be paginated as an int
+	length := params.EndSequence - params.StartSequence + 1
+	if length > math.MaxInt32 {
+		length = math.MaxInt32
+	}
+
+	sequences := []uint64{}
+	start, end := client.Paginate(int(length), params.Page, params.Limit, 100)
+	if start >= 0 && end >= 0 {
+		for i := start; i < end; i++ {
+			sequences = append(sequences, params.StartSequence+uint64(i))
+		}
+	}
+
+	unreceived, err := k.GetUnreceivedPackets(ctx, params.PortID, params.ChannelID, sequences)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, unreceived)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// QuerierUnrelayedAcks defines the sdk.Querier to query which of the given
+// packets sent on a channel haven't been acknowledged yet.
+func QuerierUnrelayedAcks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUnrelayedAcksParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	unrelayed, err := k.GetUnrelayedAcks(ctx, params.PortID, params.ChannelID, params.Sequences)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, unrelayed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
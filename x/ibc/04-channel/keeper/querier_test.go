@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestQuerierNextSequenceSend() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, exported.OPEN, exported.ORDERED, testConnectionIDA)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, testPort1, testChannel1, 5)
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, exported.OPEN, exported.ORDERED, testConnectionIDA)
+
+	testCases := []struct {
+		msg         string
+		portID      string
+		channelID   string
+		expSequence uint64
+		expPass     bool
+	}{
+		{"success", testPort1, testChannel1, 5, true},
+		{"channel not found", testPort3, testChannel3, 0, false},
+		{"next sequence send not found", testPort2, testChannel2, 0, false},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryNextSequenceSendParams(tc.portID, tc.channelID)),
+		}
+
+		bz, err := keeper.QuerierNextSequenceSend(ctx, req, suite.chainA.App.IBCKeeper.ChannelKeeper)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var sequence uint64
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &sequence))
+			suite.Require().Equal(tc.expSequence, sequence)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+
+	_, err := keeper.QuerierNextSequenceSend(ctx, abci.RequestQuery{Data: []byte("invalid")}, suite.chainA.App.IBCKeeper.ChannelKeeper)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerierPacketCommitment() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+	commitment := []byte("commitment")
+	channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 1, commitment)
+	// the commitment of an acknowledged packet is deleted
+	channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 2, commitment)
+	channelKeeper.DeletePacketCommitment(ctx, testPort1, testChannel1, 2)
+
+	testCases := []struct {
+		msg      string
+		sequence uint64
+		expPass  bool
+	}{
+		{"success", 1, true},
+		{"packet acknowledged", 2, false},
+		{"packet not sent", 3, false},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryPacketCommitmentParams(testPort1, testChannel1, tc.sequence)),
+		}
+
+		bz, err := keeper.QuerierPacketCommitment(ctx, req, channelKeeper)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.PacketCommitmentResponse
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(commitment, res.Commitment)
+			suite.Require().Equal(tc.sequence, res.Sequence)
+		} else {
+			suite.Require().True(types.ErrPacketCommitmentNotFound.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+
+	_, err := keeper.QuerierPacketCommitment(ctx, abci.RequestQuery{Data: []byte("invalid")}, channelKeeper)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerierPacketCommitments() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+	for _, sequence := range []uint64{10, 1, 2, 3} {
+		channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, sequence, []byte(fmt.Sprintf("commitment%d", sequence)))
+	}
+	// the commitment of an acknowledged packet is deleted
+	channelKeeper.DeletePacketCommitment(ctx, testPort1, testChannel1, 3)
+	// the commitments of other channels are not returned
+	channelKeeper.SetPacketCommitment(ctx, testPort2, testChannel2, 4, []byte("commitment4"))
+
+	testCases := []struct {
+		msg          string
+		page, limit  int
+		expSequences []uint64
+	}{
+		{"default limit", 1, 0, []uint64{1, 2, 10}},
+		{"first page", 1, 2, []uint64{1, 2}},
+		{"last page", 2, 2, []uint64{10}},
+		{"out of range page", 3, 2, []uint64{}},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryPacketCommitmentsParams(testPort1, testChannel1, tc.page, tc.limit)),
+		}
+
+		bz, err := keeper.QuerierPacketCommitments(ctx, req, channelKeeper)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var res []types.PacketCommitment
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+		suite.Require().Len(res, len(tc.expSequences), "test case %d: %s", i, tc.msg)
+		for j, sequence := range tc.expSequences {
+			suite.Require().Equal(types.NewPacketCommitment(sequence, []byte(fmt.Sprintf("commitment%d", sequence))), res[j])
+		}
+	}
+
+	_, err := keeper.QuerierPacketCommitments(ctx, abci.RequestQuery{Data: []byte("invalid")}, channelKeeper)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerierPacketAck() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+	ackHash := []byte("ackhash")
+	channelKeeper.SetPacketAcknowledgement(ctx, testPort1, testChannel1, 1, ackHash)
+
+	testCases := []struct {
+		msg      string
+		sequence uint64
+		expPass  bool
+	}{
+		{"success", 1, true},
+		{"ack not stored yet", 2, false},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryPacketAckParams(testPort1, testChannel1, tc.sequence)),
+		}
+
+		bz, err := keeper.QuerierPacketAck(ctx, req, channelKeeper)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.PacketAckResponse
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(ackHash, res.Acknowledgement)
+			suite.Require().Equal(tc.sequence, res.Sequence)
+		} else {
+			suite.Require().True(types.ErrPacketAckNotFound.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+
+	_, err := keeper.QuerierPacketAck(ctx, abci.RequestQuery{Data: []byte("invalid")}, channelKeeper)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerierUnreceivedPackets() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+
+	// packets 1 and 3 were received on the unordered channel
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, exported.OPEN, exported.UNORDERED, testConnectionIDA)
+	channelKeeper.SetPacketAcknowledgement(ctx, testPort1, testChannel1, 1, []byte("ackhash"))
+	channelKeeper.SetPacketAcknowledgement(ctx, testPort1, testChannel1, 3, []byte("ackhash"))
+
+	// packets 1 to 4 were received on the ordered channel
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, exported.OPEN, exported.ORDERED, testConnectionIDA)
+	channelKeeper.SetNextSequenceRecv(ctx, testPort2, testChannel2, 5)
+
+	testCases := []struct {
+		msg           string
+		portID        string
+		channelID     string
+		sequences     []uint64
+		expUnreceived []uint64
+		expPass       bool
+	}{
+		{"UNORDERED", testPort1, testChannel1, []uint64{1, 2, 3, 4}, []uint64{2, 4}, true},
+		{"ORDERED", testPort2, testChannel2, []uint64{3, 4, 5, 6}, []uint64{5, 6}, true},
+		{"no sequences", testPort1, testChannel1, nil, nil, true},
+		{"channel not found", testPort3, testChannel3, []uint64{1}, nil, false},
+		{"too many sequences", testPort1, testChannel1, make([]uint64, types.MaxQuerySequences+1), nil, false},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryUnreceivedPacketsParams(tc.portID, tc.channelID, tc.sequences)),
+		}
+
+		bz, err := keeper.QuerierUnreceivedPackets(ctx, req, channelKeeper)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var unreceived []uint64
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &unreceived))
+			suite.Require().Equal(tc.expUnreceived, unreceived, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerierUnreceivedPacketsRange() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+
+	// packets 1 to 4 were received on the ordered channel
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, exported.OPEN, exported.ORDERED, testConnectionIDA)
+	channelKeeper.SetNextSequenceRecv(ctx, testPort2, testChannel2, 5)
+
+	testCases := []struct {
+		msg           string
+		start, end    uint64
+		page, limit   int
+		expUnreceived []uint64
+		expPass       bool
+	}{
+		{"first page", 1, 10, 1, 3, nil, true},
+		{"second page", 1, 10, 2, 3, []uint64{5, 6}, true},
+		{"last page", 1, 10, 4, 3, []uint64{10}, true},
+		{"page out of bounds", 1, 10, 5, 3, nil, true},
+		{"single sequence range", 7, 7, 1, 3, []uint64{7}, true},
+		{"zero start sequence", 0, 10, 1, 3, nil, false},
+		{"end sequence before start", 5, 4, 1, 3, nil, false},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryUnreceivedPacketsRangeParams(testPort2, testChannel2, tc.start, tc.end, tc.page, tc.limit)),
+		}
+
+		bz, err := keeper.QuerierUnreceivedPacketsRange(ctx, req, channelKeeper)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var unreceived []uint64
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &unreceived))
+			suite.Require().Equal(tc.expUnreceived, unreceived, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerierUnrelayedAcks() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+
+	// packets 2 and 4 haven't been acknowledged
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, exported.OPEN, exported.UNORDERED, testConnectionIDA)
+	channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 2, []byte("commitment"))
+	channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 4, []byte("commitment"))
+
+	testCases := []struct {
+		msg          string
+		portID       string
+		channelID    string
+		sequences    []uint64
+		expUnrelayed []uint64
+		expPass      bool
+	}{
+		{"unrelayed acknowledgements", testPort1, testChannel1, []uint64{1, 2, 3, 4}, []uint64{2, 4}, true},
+		{"all acknowledged", testPort1, testChannel1, []uint64{1, 3}, nil, true},
+		{"channel not found", testPort3, testChannel3, []uint64{1}, nil, false},
+		{"too many sequences", testPort1, testChannel1, make([]uint64, types.MaxQuerySequences+1), nil, false},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryUnrelayedAcksParams(tc.portID, tc.channelID, tc.sequences)),
+		}
+
+		bz, err := keeper.QuerierUnrelayedAcks(ctx, req, channelKeeper)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var unrelayed []uint64
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &unrelayed))
+			suite.Require().Equal(tc.expUnrelayed, unrelayed, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
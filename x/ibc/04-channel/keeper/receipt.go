@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// GetPacketReceipt returns the receipt of a packet received on an unordered
+// channel end.
+func (k Keeper) GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ibctypes.KeyPacketReceipt(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketReceipt{}, false
+	}
+
+	var receipt types.PacketReceipt
+	k.cdc.MustUnmarshalBinaryBare(bz, &receipt)
+	return receipt, true
+}
+
+// SetPacketReceipt sets the receipt of a packet received on an unordered
+// channel end, and queues it for pruning at the height it was received at.
+func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64, receipt types.PacketReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	key := ibctypes.KeyPacketReceipt(portID, channelID, sequence)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(receipt))
+
+	// the queue entry references the receipt key so that pruning doesn't need
+	// to parse the queue keys
+	store.Set(ibctypes.KeyReceiptQueue(receipt.Height, portID, channelID, sequence), key)
+}
+
+// recordPacketReceipt stores the receipt of a packet received on an unordered
+// channel end at the current height.
+func (k Keeper) recordPacketReceipt(ctx sdk.Context, packet exported.PacketI) {
+	receipt := types.NewPacketReceipt(uint64(ctx.BlockHeight()), packet.GetTimeoutHeight(), packet.GetTimeoutTimestamp())
+	k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt)
+}
+
+// PruneReceipts removes the packet receipts that are older than the receipt
+// retention window and whose packet timed out on this chain, i.e that the
+// counterparty can no longer resubmit. The receipts of the packets that
+// haven't timed out yet are queued again at the current height, to be checked
+// once the retention window elapsed again. It returns the number of pruned
+// receipts.
+func (k Keeper) PruneReceipts(ctx sdk.Context) int {
+	window := k.GetReceiptRetentionWindow(ctx)
+	height := uint64(ctx.BlockHeight())
+	if window == 0 || height <= window {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the queue entries before modifying the store, as the iterated
+	// domain must not be written to
+	type queueEntry struct {
+		key, receiptKey []byte
+	}
+	var entries []queueEntry
+
+	start := []byte(ibctypes.KeyReceiptQueuePrefix + "/")
+	end := []byte(ibctypes.ReceiptQueueHeightPrefix(height - window + 1))
+	iterator := store.Iterator(start, end)
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, queueEntry{key: iterator.Key(), receiptKey: iterator.Value()})
+	}
+	iterator.Close()
+
+	selfHeight := ibctypes.GetSelfHeight(ctx)
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+
+	pruned := 0
+	for _, entry := range entries {
+		store.Delete(entry.key)
+
+		bz := store.Get(entry.receiptKey)
+		if bz == nil {
+			continue
+		}
+
+		var receipt types.PacketReceipt
+		k.cdc.MustUnmarshalBinaryBare(bz, &receipt)
+		if receipt.IsPrunable(selfHeight, blockTime, window) {
+			store.Delete(entry.receiptKey)
+			pruned++
+			continue
+		}
+
+		// queue the receipt again by replacing the height of its queue key
+		requeueKey := append(
+			[]byte(ibctypes.ReceiptQueueHeightPrefix(height)),
+			entry.key[len(ibctypes.ReceiptQueueHeightPrefix(0)):]...,
+		)
+		store.Set(requeueKey, entry.receiptKey)
+	}
+
+	if pruned > 0 {
+		k.Logger(ctx).Info(fmt.Sprintf("pruned %d packet receipts", pruned))
+	}
+
+	return pruned
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestPruneReceipts() {
+	counterparty := types.NewCounterparty(testPort1, testChannel1)
+	packetKey := ibctypes.KeyPacketCommitment(testPort2, testChannel2, 1)
+
+	// the heights are relative to the height the packet is received at
+	testCases := []struct {
+		msg           string
+		window        uint64
+		timeoutHeight uint64
+		pruneHeights  []int64
+		expPruned     bool
+	}{
+		{"pruning disabled", 0, 5, []int64{20}, false},
+		{"within the retention window", 10, 5, []int64{9}, false},
+		{"packet not timed out", 10, 100, []int64{10, 20}, false},
+		{"timed out after the retention window", 10, 5, []int64{10}, true},
+		{"timed out after being queued again", 10, 15, []int64{10, 20}, true},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+
+			suite.chainB.CreateClient(suite.chainA)
+			suite.chainA.CreateClient(suite.chainB)
+			suite.chainB.createConnection(testConnectionIDA, testConnectionIDB, testClientIDA, testClientIDB, connectionexported.OPEN)
+			suite.chainA.createConnection(testConnectionIDB, testConnectionIDA, testClientIDB, testClientIDA, connectionexported.OPEN)
+			suite.chainB.createChannel(testPort1, testChannel1, testPort2, testChannel2, exported.OPEN, exported.UNORDERED, testConnectionIDA)
+			suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, exported.OPEN, exported.UNORDERED, testConnectionIDB)
+
+			channelKeeper := suite.chainB.App.IBCKeeper.ChannelKeeper
+			ctx := suite.chainB.GetContext()
+			recvHeight := ctx.BlockHeight()
+
+			packet := types.NewPacket(
+				mockSuccessPacket{}.GetBytes(), 1, testPort2, testChannel2, counterparty.GetPortID(), counterparty.GetChannelID(),
+				uint64(recvHeight)+tc.timeoutHeight, 0,
+			)
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), testPort2, testChannel2, 1, types.CommitPacket(packet))
+
+			suite.chainB.updateClient(suite.chainA)
+			proof, proofHeight := queryProof(suite.chainA, packetKey)
+
+			_, err := channelKeeper.RecvPacket(ctx, packet, proof, proofHeight+1)
+			suite.Require().NoError(err)
+
+			channelCap, err := suite.chainB.App.ScopedIBCKeeper.NewCapability(ctx, ibctypes.ChannelCapabilityPath(testPort1, testChannel1))
+			suite.Require().NoError(err)
+			suite.Require().NoError(channelKeeper.PacketExecuted(ctx, channelCap, packet, mockSuccessPacket{}.GetBytes()))
+
+			receipt, found := channelKeeper.GetPacketReceipt(ctx, testPort1, testChannel1, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewPacketReceipt(uint64(recvHeight), packet.GetTimeoutHeight(), 0), receipt)
+
+			channelKeeper.SetReceiptRetentionWindow(ctx, tc.window)
+			for _, height := range tc.pruneHeights {
+				ctx = ctx.WithBlockHeight(recvHeight + height)
+				channelKeeper.PruneReceipts(ctx)
+			}
+
+			_, found = channelKeeper.GetPacketReceipt(ctx, testPort1, testChannel1, 1)
+			suite.Require().Equal(!tc.expPruned, found)
+
+			// the packet is still received, pruned or not, so a resubmitted packet
+			// isn't executed again
+			suite.Require().True(channelKeeper.IsPacketReceived(ctx, packet))
+
+			if tc.expPruned {
+				// the packet timed out, so it can't be received again once its
+				// receipt is pruned
+				_, err = channelKeeper.RecvPacket(ctx, packet, proof, proofHeight+1)
+				suite.Require().True(errors.Is(err, types.ErrPacketTimeout), "unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// GetRelayerAllowlist returns the relayers allowed to relay the packets and
+// acknowledgements of a channel. An empty allowlist allows any relayer.
+func (k Keeper) GetRelayerAllowlist(ctx sdk.Context, portID, channelID string) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ibctypes.KeyRelayerAllowlist(portID, channelID))
+	if bz == nil {
+		return nil
+	}
+
+	var relayers []sdk.AccAddress
+	k.cdc.MustUnmarshalBinaryBare(bz, &relayers)
+	return relayers
+}
+
+// SetRelayerAllowlist sets the relayers allowed on a channel. An empty
+// allowlist is deleted from the store, so that any relayer is allowed.
+func (k Keeper) SetRelayerAllowlist(ctx sdk.Context, portID, channelID string, relayers []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if len(relayers) == 0 {
+		store.Delete(ibctypes.KeyRelayerAllowlist(portID, channelID))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(relayers)
+	store.Set(ibctypes.KeyRelayerAllowlist(portID, channelID), bz)
+}
+
+// IsRelayerAllowed returns true if the relayer is allowed to relay the packets
+// and acknowledgements of a channel, i.e the channel has no allowlist or the
+// relayer is listed on it.
+func (k Keeper) IsRelayerAllowed(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress) bool {
+	relayers := k.GetRelayerAllowlist(ctx, portID, channelID)
+	if len(relayers) == 0 {
+		return true
+	}
+
+	for _, allowed := range relayers {
+		if allowed.Equals(relayer) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRelayer returns an unauthorized error if the relayer isn't allowed on
+// the channel.
+func (k Keeper) ValidateRelayer(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress) error {
+	if !k.IsRelayerAllowed(ctx, portID, channelID, relayer) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"relayer %s is not allowed on channel (port ID: %s, channel ID: %s)", relayer, portID, channelID,
+		)
+	}
+	return nil
+}
+
+// HandleRelayerAllowlistProposal is a handler for executing a passed relayer
+// allowlist proposal
+func HandleRelayerAllowlistProposal(ctx sdk.Context, k Keeper, p *types.RelayerAllowlistProposal) error {
+	if _, found := k.GetChannel(ctx, p.PortID, p.ChannelID); !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.PortID, p.ChannelID)
+	}
+
+	k.SetRelayerAllowlist(ctx, p.PortID, p.ChannelID, p.Relayers)
+
+	k.Logger(ctx).Info(fmt.Sprintf(
+		"set %d allowed relayers on channel %s/%s", len(p.Relayers), p.PortID, p.ChannelID,
+	))
+	return nil
+}
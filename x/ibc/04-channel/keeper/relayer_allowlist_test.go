@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestRelayerAllowlist() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+	relayerA := sdk.AccAddress([]byte("relayera"))
+	relayerB := sdk.AccAddress([]byte("relayerb"))
+
+	// any relayer is allowed by default
+	suite.Require().Empty(channelKeeper.GetRelayerAllowlist(ctx, testPort1, testChannel1))
+	suite.Require().True(channelKeeper.IsRelayerAllowed(ctx, testPort1, testChannel1, relayerA))
+	suite.Require().NoError(channelKeeper.ValidateRelayer(ctx, testPort1, testChannel1, relayerB))
+
+	channelKeeper.SetRelayerAllowlist(ctx, testPort1, testChannel1, []sdk.AccAddress{relayerA})
+	suite.Require().Equal([]sdk.AccAddress{relayerA}, channelKeeper.GetRelayerAllowlist(ctx, testPort1, testChannel1))
+	suite.Require().True(channelKeeper.IsRelayerAllowed(ctx, testPort1, testChannel1, relayerA))
+	suite.Require().False(channelKeeper.IsRelayerAllowed(ctx, testPort1, testChannel1, relayerB))
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(channelKeeper.ValidateRelayer(ctx, testPort1, testChannel1, relayerB)))
+
+	// the allowlist only applies to its channel
+	suite.Require().True(channelKeeper.IsRelayerAllowed(ctx, testPort1, testChannel2, relayerB))
+
+	// an empty allowlist allows any relayer again
+	channelKeeper.SetRelayerAllowlist(ctx, testPort1, testChannel1, nil)
+	suite.Require().Empty(channelKeeper.GetRelayerAllowlist(ctx, testPort1, testChannel1))
+	suite.Require().True(channelKeeper.IsRelayerAllowed(ctx, testPort1, testChannel1, relayerB))
+}
+
+func (suite *KeeperTestSuite) TestHandleRelayerAllowlistProposal() {
+	relayers := []sdk.AccAddress{sdk.AccAddress([]byte("relayera"))}
+
+	testCases := []struct {
+		msg      string
+		proposal *types.RelayerAllowlistProposal
+		expPass  bool
+	}{
+		{"set allowlist", types.NewRelayerAllowlistProposal("title", "description", testPort1, testChannel1, relayers), true},
+		{"clear allowlist", types.NewRelayerAllowlistProposal("title", "description", testPort1, testChannel1, nil), true},
+		{"channel not found", types.NewRelayerAllowlistProposal("title", "description", testPort1, testChannel2, relayers), false},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, exported.OPEN, exported.ORDERED, testConnectionIDA)
+		ctx := suite.chainA.GetContext()
+		channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+
+		err := keeper.HandleRelayerAllowlistProposal(ctx, channelKeeper, tc.proposal)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			suite.Require().Equal(tc.proposal.Relayers, channelKeeper.GetRelayerAllowlist(ctx, tc.proposal.PortID, tc.proposal.ChannelID), "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrChannelNotFound.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
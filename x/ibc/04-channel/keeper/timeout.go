@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
 	connection "github.com/cosmos/cosmos-sdk/x/ibc/03-connection"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
@@ -66,9 +67,9 @@ func (k Keeper) TimeoutPacket(
 		)
 	}
 
-	// check that timeout height has passed on the other end
-	if proofHeight < packet.GetTimeoutHeight() {
-		return nil, types.ErrPacketTimeout
+	// check that the timeout height or timeout timestamp has passed on the other end
+	if err := k.checkTimeoutReached(ctx, connectionEnd.GetClientID(), packet, proofHeight); err != nil {
+		return nil, err
 	}
 
 	// check that packet has not been received
@@ -110,7 +111,7 @@ func (k Keeper) TimeoutPacket(
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTimeoutPacket,
-			sdk.NewAttribute(types.AttributeKeyTimeout, fmt.Sprintf("%d", packet.GetTimeoutHeight())),
+			sdk.NewAttribute(types.AttributeKeyTimeout, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
 			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
@@ -134,7 +135,7 @@ func (k Keeper) TimeoutExecuted(ctx sdk.Context, chanCap *capability.Capability,
 		return sdkerrors.Wrap(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel")
 	}
 
-	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if channel.Ordering == exported.ORDERED {
 		channel.State = exported.CLOSED
@@ -239,15 +240,13 @@ func (k Keeper) TimeoutOnClose(
 		return nil, err
 	}
 
-	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-
 	k.Logger(ctx).Info(fmt.Sprintf("packet timed-out on close: %v", packet))
 
 	// emit an event marking that we have processed the timeout
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTimeoutPacket,
-			sdk.NewAttribute(types.AttributeKeyTimeout, fmt.Sprintf("%d", packet.GetTimeoutHeight())),
+			sdk.NewAttribute(types.AttributeKeyTimeout, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
 			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
 			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
@@ -256,5 +255,51 @@ func (k Keeper) TimeoutOnClose(
 		),
 	})
 
+	// NOTE: the remaining code is located on the TimeoutExecuted function
 	return packet, nil
 }
+
+// checkTimeoutReached checks that the timeout height or the timeout timestamp of
+// the packet has passed on the counterparty chain at the proof height. The
+// timestamp of the counterparty chain is the time of the client's consensus
+// state at the proof height. A zero timeout height or timestamp disables the
+// corresponding timeout.
+func (k Keeper) checkTimeoutReached(ctx sdk.Context, clientID string, packet exported.PacketI, proofHeight uint64) error {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(client.ErrClientNotFound, clientID)
+	}
+
+	// the proof height belongs to the current revision of the counterparty chain
+	counterpartyHeight := ibctypes.NewHeight(ibctypes.ParseChainID(clientState.GetChainID()), proofHeight)
+	timeoutHeight := packet.GetTimeoutHeight()
+	if ibctypes.IsTimeoutHeightReached(counterpartyHeight, timeoutHeight) {
+		return nil
+	}
+
+	timeoutTimestamp := packet.GetTimeoutTimestamp()
+	if timeoutTimestamp == 0 {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"packet timeout height not reached on the counterparty chain (%s < %s)", counterpartyHeight, timeoutHeight,
+		)
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, proofHeight)
+	if !found {
+		return sdkerrors.Wrapf(
+			client.ErrConsensusStateNotFound,
+			"client ID (%s) height (%d)", clientID, proofHeight,
+		)
+	}
+
+	if consensusState.GetTimestamp() < timeoutTimestamp {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"packet timeout not reached on the counterparty chain: timeout height %s, timeout timestamp %d, proof height %s, counterparty timestamp %d",
+			timeoutHeight, timeoutTimestamp, counterpartyHeight, consensusState.GetTimestamp(),
+		)
+	}
+
+	return nil
+}
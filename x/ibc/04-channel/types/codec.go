@@ -32,6 +32,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgChannelCloseConfirm{}, "ibc/channel/MsgChannelCloseConfirm", nil)
 
 	cdc.RegisterConcrete(MsgPacket{}, "ibc/channel/MsgPacket", nil)
+	cdc.RegisterConcrete(MsgRecvPackets{}, "ibc/channel/MsgRecvPackets", nil)
+	cdc.RegisterConcrete(MsgAcknowledgement{}, "ibc/channel/MsgAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgTimeout{}, "ibc/channel/MsgTimeout", nil)
+	cdc.RegisterConcrete(MsgTimeoutOnClose{}, "ibc/channel/MsgTimeoutOnClose", nil)
 
 	SetSubModuleCodec(cdc)
 }
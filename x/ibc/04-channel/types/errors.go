@@ -20,4 +20,11 @@ var (
 	ErrPacketTimeout             = sdkerrors.Register(SubModuleName, 12, "packet timeout")
 	ErrTooManyConnectionHops     = sdkerrors.Register(SubModuleName, 13, "too many connection hops")
 	ErrAcknowledgementTooLong    = sdkerrors.Register(SubModuleName, 14, "acknowledgement too long")
+	ErrInvalidAcknowledgement    = sdkerrors.Register(SubModuleName, 15, "invalid acknowledgement")
+	ErrPacketSequenceOutOfOrder  = sdkerrors.Register(SubModuleName, 16, "packet sequence is out of order")
+	ErrPacketDataTooLong         = sdkerrors.Register(SubModuleName, 17, "packet data too long")
+	ErrPacketProofTooOld         = sdkerrors.Register(SubModuleName, 18, "packet proof too old")
+	ErrPacketCommitmentNotFound  = sdkerrors.Register(SubModuleName, 19, "packet commitment not found")
+	ErrPacketAckNotFound         = sdkerrors.Register(SubModuleName, 20, "packet acknowledgement not found")
+	ErrPacketAlreadyReceived     = sdkerrors.Register(SubModuleName, 21, "packet already received")
 )
@@ -20,6 +20,10 @@ const (
 	EventTypeCleanupPacket     = "cleanup_packet"
 	EventTypeTimeoutPacket     = "timeout_packet"
 
+	// EventTypePacketAlreadyReceived is emitted when a packet is relayed again
+	// after being received
+	EventTypePacketAlreadyReceived = "packet_already_received"
+
 	AttributeKeyData       = "packet_data"
 	AttributeKeyTimeout    = "packet_timeout"
 	AttributeKeySequence   = "packet_sequence"
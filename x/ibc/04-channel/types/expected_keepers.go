@@ -13,6 +13,7 @@ import (
 // ClientKeeper expected account IBC client keeper
 type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (clientexported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height uint64) (clientexported.ConsensusState, bool)
 }
 
 // ConnectionKeeper expected account IBC connection keeper
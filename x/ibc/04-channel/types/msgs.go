@@ -49,10 +49,10 @@ func (msg MsgChannelOpenInit) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgChannelOpenInit) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.PortID); err != nil {
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.ChannelID); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
 		return sdkerrors.Wrap(err, "invalid channel ID")
 	}
 	// Signer can be empty
@@ -112,10 +112,10 @@ func (msg MsgChannelOpenTry) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgChannelOpenTry) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.PortID); err != nil {
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.ChannelID); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
 		return sdkerrors.Wrap(err, "invalid channel ID")
 	}
 	if strings.TrimSpace(msg.CounterpartyVersion) == "" {
@@ -182,10 +182,10 @@ func (msg MsgChannelOpenAck) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgChannelOpenAck) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.PortID); err != nil {
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.ChannelID); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
 		return sdkerrors.Wrap(err, "invalid channel ID")
 	}
 	if strings.TrimSpace(msg.CounterpartyVersion) == "" {
@@ -250,10 +250,10 @@ func (msg MsgChannelOpenConfirm) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgChannelOpenConfirm) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.PortID); err != nil {
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.ChannelID); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
 		return sdkerrors.Wrap(err, "invalid channel ID")
 	}
 	if msg.ProofAck == nil {
@@ -308,10 +308,10 @@ func (msg MsgChannelCloseInit) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgChannelCloseInit) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.PortID); err != nil {
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.ChannelID); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
 		return sdkerrors.Wrap(err, "invalid channel ID")
 	}
 	// Signer can be empty
@@ -364,10 +364,10 @@ func (msg MsgChannelCloseConfirm) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgChannelCloseConfirm) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.PortID); err != nil {
+	if err := host.PortIdentifierValidator(msg.PortID); err != nil {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.ChannelID); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.ChannelID); err != nil {
 		return sdkerrors.Wrap(err, "invalid channel ID")
 	}
 	if msg.ProofInit == nil {
@@ -420,6 +420,10 @@ func (msg MsgPacket) Route() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgPacket) ValidateBasic() error {
+	// reject oversized packets before any other check is performed
+	if err := validatePacketDataSize(msg.Packet.Data); err != nil {
+		return err
+	}
 	if msg.Proof == nil {
 		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
 	}
@@ -458,6 +462,69 @@ func (msg MsgPacket) Type() string {
 	return "ics04/opaque"
 }
 
+var _ sdk.Msg = MsgRecvPackets{}
+
+// MsgRecvPackets receives a batch of incoming IBC packets. The packets are
+// proven at the same height and executed in order on a single transaction.
+type MsgRecvPackets struct {
+	Packets     []Packet                   `json:"packets" yaml:"packets"`
+	Proofs      []commitmentexported.Proof `json:"proofs" yaml:"proofs"`
+	ProofHeight uint64                     `json:"proof_height" yaml:"proof_height"`
+	Signer      sdk.AccAddress             `json:"signer" yaml:"signer"`
+}
+
+// NewMsgRecvPackets constructs new MsgRecvPackets
+func NewMsgRecvPackets(packets []Packet, proofs []commitmentexported.Proof, proofHeight uint64, signer sdk.AccAddress) MsgRecvPackets {
+	return MsgRecvPackets{
+		Packets:     packets,
+		Proofs:      proofs,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRecvPackets) Route() string {
+	return ibctypes.RouterKey
+}
+
+// ValidateBasic implements sdk.Msg. Each packet is validated along with its
+// proof as a MsgPacket.
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "cannot submit an empty batch of packets")
+	}
+	if len(msg.Proofs) != len(msg.Packets) {
+		return sdkerrors.Wrapf(
+			commitmenttypes.ErrInvalidProof,
+			"number of proofs doesn't match the number of packets (%d ≠ %d)", len(msg.Proofs), len(msg.Packets),
+		)
+	}
+
+	for i, packet := range msg.Packets {
+		if err := NewMsgPacket(packet, msg.Proofs[i], msg.ProofHeight, msg.Signer).ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRecvPackets) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPackets) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// Type implements sdk.Msg
+func (msg MsgRecvPackets) Type() string {
+	return "ics04/recv_packets"
+}
+
 var _ sdk.Msg = MsgTimeout{}
 
 // MsgTimeout receives timed-out packet
@@ -518,6 +585,79 @@ func (msg MsgTimeout) Type() string {
 	return "ics04/timeout"
 }
 
+var _ sdk.Msg = MsgTimeoutOnClose{}
+
+// MsgTimeoutOnClose timeouts a packet which was sent on a channel that has been
+// closed on the counterparty chain
+type MsgTimeoutOnClose struct {
+	Packet           `json:"packet" yaml:"packet"`
+	NextSequenceRecv uint64                   `json:"next_sequence_recv" yaml:"next_sequence_recv"`
+	Proof            commitmentexported.Proof `json:"proof" yaml:"proof"`             // proof of the packet being unreceived
+	ProofClose       commitmentexported.Proof `json:"proof_close" yaml:"proof_close"` // proof of the counterparty channel being closed
+	ProofHeight      uint64                   `json:"proof_height" yaml:"proof_height"`
+	Signer           sdk.AccAddress           `json:"signer" yaml:"signer"`
+}
+
+// NewMsgTimeoutOnClose constructs new MsgTimeoutOnClose
+func NewMsgTimeoutOnClose(
+	packet Packet, nextSequenceRecv uint64,
+	proof, proofClose commitmentexported.Proof,
+	proofHeight uint64, signer sdk.AccAddress,
+) MsgTimeoutOnClose {
+	return MsgTimeoutOnClose{
+		Packet:           packet,
+		NextSequenceRecv: nextSequenceRecv,
+		Proof:            proof,
+		ProofClose:       proofClose,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgTimeoutOnClose) Route() string {
+	return ibctypes.RouterKey
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTimeoutOnClose) ValidateBasic() error {
+	if msg.Proof == nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof of the unreceived packet")
+	}
+	if msg.ProofClose == nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof of the closed channel")
+	}
+	if err := msg.Proof.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid proof of the unreceived packet")
+	}
+	if err := msg.ProofClose.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid proof of the closed channel")
+	}
+	if msg.ProofHeight == 0 {
+		return sdkerrors.Wrap(ibctypes.ErrInvalidHeight, "proof height must be > 0")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+
+	return msg.Packet.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgTimeoutOnClose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTimeoutOnClose) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// Type implements sdk.Msg
+func (msg MsgTimeoutOnClose) Type() string {
+	return "ics04/timeout_on_close"
+}
+
 var _ sdk.Msg = MsgAcknowledgement{}
 
 // MsgAcknowledgement receives incoming IBC acknowledgement
@@ -553,8 +693,14 @@ func (msg MsgAcknowledgement) ValidateBasic() error {
 	if err := msg.Proof.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "proof ack cannot be nil")
 	}
-	if len(msg.Acknowledgement) > 100 {
-		return sdkerrors.Wrap(ErrAcknowledgementTooLong, "acknowledgement cannot exceed 100 bytes")
+	if len(msg.Acknowledgement) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAcknowledgement, "acknowledgement cannot be empty")
+	}
+	if len(msg.Acknowledgement) > MaxAcknowledgementSize {
+		return sdkerrors.Wrapf(
+			ErrAcknowledgementTooLong,
+			"acknowledgement cannot exceed %d bytes, got %d", MaxAcknowledgementSize, len(msg.Acknowledgement),
+		)
 	}
 	if msg.ProofHeight == 0 {
 		return sdkerrors.Wrap(ibctypes.ErrInvalidHeight, "proof height must be > 0")
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -357,10 +358,10 @@ var (
 	timeout           = uint64(100)
 	validPacketData   = []byte("testdata")
 	unknownPacketData = []byte("unknown")
-	invalidAckData    = []byte("123456789012345678901234567890123456789012345678901234567890123456789012345678901234567890123456789012345678901234567890")
+	invalidAckData    = bytes.Repeat([]byte("1"), MaxAcknowledgementSize+1)
 
-	packet        = NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, 100)
-	unknownPacket = NewPacket(unknownPacketData, 0, portid, chanid, cpportid, cpchanid, 100)
+	packet        = NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, 100, 0)
+	unknownPacket = NewPacket(unknownPacketData, 0, portid, chanid, cpportid, cpchanid, 100, 0)
 	invalidAck    = invalidAckData
 
 	emptyProof     = commitmenttypes.MerkleProof{Proof: nil}
@@ -425,7 +426,7 @@ func TestMsgPacketGetSignBytes(t *testing.T) {
 	res := msg.GetSignBytes()
 
 	expected := fmt.Sprintf(
-		`{"type":"ibc/channel/MsgPacket","value":{"packet":{"data":%s,"destination_channel":"testcpchannel","destination_port":"testcpport","sequence":"1","source_channel":"testchannel","source_port":"testportid","timeout_height":"100"},"proof":{"type":"ibc/commitment/MerkleProof","value":{"proof":{"ops":[]}}},"proof_height":"1","signer":"cosmos1w3jhxarpv3j8yvg4ufs4x"}}`,
+		`{"type":"ibc/channel/MsgPacket","value":{"packet":{"data":%s,"destination_channel":"testcpchannel","destination_port":"testcpport","sequence":"1","source_channel":"testchannel","source_port":"testportid","timeout_height":"100","timeout_timestamp":"0"},"proof":{"type":"ibc/commitment/MerkleProof","value":{"proof":{"ops":[]}}},"proof_height":"1","signer":"cosmos1w3jhxarpv3j8yvg4ufs4x"}}`,
 		string(msg.GetDataSignBytes()),
 	)
 	require.Equal(t, expected, string(res))
@@ -440,6 +441,45 @@ func TestMsgPacketGetSigners(t *testing.T) {
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
 }
 
+// TestMsgRecvPacketsValidation tests ValidateBasic for MsgRecvPackets
+func TestMsgRecvPacketsValidation(t *testing.T) {
+	proofs := []commitmentexported.Proof{proof, proof}
+
+	testCases := []struct {
+		msg     MsgRecvPackets
+		expPass bool
+		errMsg  string
+	}{
+		{NewMsgRecvPackets([]Packet{packet, packet}, proofs, 1, addr1), true, ""},
+		{NewMsgRecvPackets(nil, nil, 1, addr1), false, "empty batch"},
+		{NewMsgRecvPackets([]Packet{packet, packet}, proofs[:1], 1, addr1), false, "missing proof"},
+		{NewMsgRecvPackets([]Packet{packet}, proofs, 1, addr1), false, "too many proofs"},
+		{NewMsgRecvPackets([]Packet{packet, packet}, proofs, 0, addr1), false, "proof height is zero"},
+		{NewMsgRecvPackets([]Packet{packet, packet}, []commitmentexported.Proof{proof, nil}, 1, addr1), false, "empty proof"},
+		{NewMsgRecvPackets([]Packet{packet, packet}, []commitmentexported.Proof{proof, invalidProofs2}, 1, addr1), false, "proof contain empty proof"},
+		{NewMsgRecvPackets([]Packet{packet, unknownPacket}, proofs, 1, addr1), false, "invalid packet"},
+		{NewMsgRecvPackets([]Packet{packet, packet}, proofs, 1, emptyAddr), false, "missing signer address"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgRecvPacketsGetSigners tests GetSigners for MsgRecvPackets
+func TestMsgRecvPacketsGetSigners(t *testing.T) {
+	msg := NewMsgRecvPackets([]Packet{packet}, []commitmentexported.Proof{proof}, 1, addr1)
+	res := msg.GetSigners()
+
+	expected := "[746573746164647231]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
 // TestMsgTimeout tests ValidateBasic for MsgTimeout
 func (suite *MsgTestSuite) TestMsgTimeout() {
 	testMsgs := []MsgTimeout{
@@ -474,6 +514,57 @@ func (suite *MsgTestSuite) TestMsgTimeout() {
 	}
 }
 
+// TestMsgTimeoutGetSignBytes tests GetSignBytes for MsgTimeout
+func TestMsgTimeoutGetSignBytes(t *testing.T) {
+	msg := NewMsgTimeout(packet, 2, proof, 1, addr1)
+	res := msg.GetSignBytes()
+
+	expected := fmt.Sprintf(
+		`{"type":"ibc/channel/MsgTimeout","value":{"next_sequence_recv":"2","packet":{"data":%s,"destination_channel":"testcpchannel","destination_port":"testcpport","sequence":"1","source_channel":"testchannel","source_port":"testportid","timeout_height":"100","timeout_timestamp":"0"},"proof":{"type":"ibc/commitment/MerkleProof","value":{"proof":{"ops":[]}}},"proof_height":"1","signer":"cosmos1w3jhxarpv3j8yvg4ufs4x"}}`,
+		string(NewMsgPacket(packet, proof, 1, addr1).GetDataSignBytes()),
+	)
+	require.Equal(t, expected, string(res))
+}
+
+// TestMsgTimeoutOnClose tests ValidateBasic for MsgTimeoutOnClose
+func (suite *MsgTestSuite) TestMsgTimeoutOnClose() {
+	testCases := []struct {
+		msg     MsgTimeoutOnClose
+		expPass bool
+		errMsg  string
+	}{
+		{NewMsgTimeoutOnClose(packet, 0, proof, proof, 1, addr), true, ""},
+		{NewMsgTimeoutOnClose(packet, 0, proof, proof, 0, addr), false, "proof height must be > 0"},
+		{NewMsgTimeoutOnClose(packet, 0, proof, proof, 1, emptyAddr), false, "missing signer address"},
+		{NewMsgTimeoutOnClose(packet, 0, invalidProofs1, proof, 1, addr), false, "missing proof of the unreceived packet"},
+		{NewMsgTimeoutOnClose(packet, 0, proof, invalidProofs1, 1, addr), false, "missing proof of the closed channel"},
+		{NewMsgTimeoutOnClose(packet, 0, emptyProof, proof, 1, addr), false, "empty proof of the unreceived packet"},
+		{NewMsgTimeoutOnClose(packet, 0, proof, emptyProof, 1, addr), false, "empty proof of the closed channel"},
+		{NewMsgTimeoutOnClose(unknownPacket, 0, proof, proof, 1, addr), false, "invalid packet"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %s", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgTimeoutOnCloseGetSignBytes tests GetSignBytes for MsgTimeoutOnClose
+func TestMsgTimeoutOnCloseGetSignBytes(t *testing.T) {
+	msg := NewMsgTimeoutOnClose(packet, 2, proof, proof, 1, addr1)
+	res := msg.GetSignBytes()
+
+	expected := fmt.Sprintf(
+		`{"type":"ibc/channel/MsgTimeoutOnClose","value":{"next_sequence_recv":"2","packet":{"data":%s,"destination_channel":"testcpchannel","destination_port":"testcpport","sequence":"1","source_channel":"testchannel","source_port":"testportid","timeout_height":"100","timeout_timestamp":"0"},"proof":{"type":"ibc/commitment/MerkleProof","value":{"proof":{"ops":[]}}},"proof_close":{"type":"ibc/commitment/MerkleProof","value":{"proof":{"ops":[]}}},"proof_height":"1","signer":"cosmos1w3jhxarpv3j8yvg4ufs4x"}}`,
+		string(NewMsgPacket(packet, proof, 1, addr1).GetDataSignBytes()),
+	)
+	require.Equal(t, expected, string(res))
+}
+
 // TestMsgAcknowledgement tests ValidateBasic for MsgAcknowledgement
 func (suite *MsgTestSuite) TestMsgAcknowledgement() {
 	testMsgs := []MsgAcknowledgement{
@@ -484,6 +575,7 @@ func (suite *MsgTestSuite) TestMsgAcknowledgement() {
 		NewMsgAcknowledgement(unknownPacket, packet.GetData(), proof, 1, addr),
 		NewMsgAcknowledgement(packet, invalidAck, proof, 1, addr),
 		NewMsgAcknowledgement(packet, packet.GetData(), invalidProofs1, 1, addr),
+		NewMsgAcknowledgement(packet, []byte{}, proof, 1, addr),
 	}
 
 	testCases := []struct {
@@ -498,6 +590,7 @@ func (suite *MsgTestSuite) TestMsgAcknowledgement() {
 		{testMsgs[4], false, "invalid packet"},
 		{testMsgs[5], false, "invalid acknowledgement"},
 		{testMsgs[6], false, "cannot submit an invalid proof"},
+		{testMsgs[7], false, "empty acknowledgement"},
 	}
 
 	for i, tc := range testCases {
@@ -509,3 +602,24 @@ func (suite *MsgTestSuite) TestMsgAcknowledgement() {
 		}
 	}
 }
+
+func TestMsgAcknowledgementSize(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		size    int
+		expPass bool
+	}{
+		{"acknowledgement of an error with a long message", 512, true},
+		{"acknowledgement at the limit", MaxAcknowledgementSize, true},
+		{"acknowledgement one byte above the limit", MaxAcknowledgementSize + 1, false},
+	}
+
+	for i, tc := range testCases {
+		err := NewMsgAcknowledgement(packet, bytes.Repeat([]byte("a"), tc.size), proof, 1, addr).ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			require.True(t, ErrAcknowledgementTooLong.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
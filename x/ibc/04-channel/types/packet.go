@@ -1,19 +1,40 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
+// MaxPacketDataSize is the maximum size in bytes of the serialized data carried
+// by a packet.
+var MaxPacketDataSize = 64 * 1024
+
+// MaxAcknowledgementSize is the maximum size in bytes of the acknowledgement
+// relayed back to the sending chain. It must fit the error acknowledgements of
+// the applications, as a packet that was received can neither be acknowledged
+// nor timed out if its acknowledgement is rejected.
+var MaxAcknowledgementSize = 4 * 1024
+
 // CommitPacket return the hash of commitment bytes
 // TODO: no specification for packet commitment currently,
 // make it spec compatible once we have it
+//
+// Both the revision number and the revision height of the timeout height are
+// committed, so that the fixed size prefix of the commitment bytes is never
+// ambiguous.
 func CommitPacket(packet exported.PacketI) []byte {
-	buf := sdk.Uint64ToBigEndian(packet.GetTimeoutHeight())
+	timeoutHeight := packet.GetTimeoutHeight()
+
+	buf := sdk.Uint64ToBigEndian(timeoutHeight.RevisionNumber)
+	buf = append(buf, sdk.Uint64ToBigEndian(timeoutHeight.RevisionHeight)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(packet.GetTimeoutTimestamp())...)
 	buf = append(buf, packet.GetData()...)
 	return tmhash.Sum(buf)
 }
@@ -29,20 +50,36 @@ var _ exported.PacketI = Packet{}
 type Packet struct {
 	Data []byte `json:"data" yaml:"data"` // Actual opaque bytes transferred directly to the application module
 
-	Sequence           uint64 `json:"sequence" yaml:"sequence"`                       // number corresponds to the order of sends and receives, where a Packet with an earlier sequence number must be sent and received before a Packet with a later sequence number.
-	SourcePort         string `json:"source_port" yaml:"source_port"`                 // identifies the port on the sending chain.
-	SourceChannel      string `json:"source_channel" yaml:"source_channel"`           // identifies the channel end on the sending chain.
-	DestinationPort    string `json:"destination_port" yaml:"destination_port"`       // identifies the port on the receiving chain.
-	DestinationChannel string `json:"destination_channel" yaml:"destination_channel"` // identifies the channel end on the receiving chain.
-	TimeoutHeight      uint64 `json:"timeout_height" yaml:"timeout_height"`           // block height after which the packet times out
+	Sequence           uint64          `json:"sequence" yaml:"sequence"`                       // number corresponds to the order of sends and receives, where a Packet with an earlier sequence number must be sent and received before a Packet with a later sequence number.
+	SourcePort         string          `json:"source_port" yaml:"source_port"`                 // identifies the port on the sending chain.
+	SourceChannel      string          `json:"source_channel" yaml:"source_channel"`           // identifies the channel end on the sending chain.
+	DestinationPort    string          `json:"destination_port" yaml:"destination_port"`       // identifies the port on the receiving chain.
+	DestinationChannel string          `json:"destination_channel" yaml:"destination_channel"` // identifies the channel end on the receiving chain.
+	TimeoutHeight      ibctypes.Height `json:"timeout_height" yaml:"timeout_height"`           // block height after which the packet times out
+	TimeoutTimestamp   uint64          `json:"timeout_timestamp" yaml:"timeout_timestamp"`     // block timestamp (in nanoseconds) after which the packet times out
 }
 
-// NewPacket creates a new Packet instance
+// NewPacket creates a new Packet instance with a flat timeout height (see
+// ibctypes.Height).
 func NewPacket(
 	data []byte,
 	sequence uint64, sourcePort, sourceChannel,
 	destinationPort, destinationChannel string,
-	timeoutHeight uint64,
+	timeoutHeight, timeoutTimestamp uint64,
+) Packet {
+	return NewPacketWithTimeoutHeight(
+		data, sequence, sourcePort, sourceChannel, destinationPort, destinationChannel,
+		ibctypes.NewHeight(0, timeoutHeight), timeoutTimestamp,
+	)
+}
+
+// NewPacketWithTimeoutHeight creates a new Packet instance with a revision
+// aware timeout height.
+func NewPacketWithTimeoutHeight(
+	data []byte,
+	sequence uint64, sourcePort, sourceChannel,
+	destinationPort, destinationChannel string,
+	timeoutHeight ibctypes.Height, timeoutTimestamp uint64,
 ) Packet {
 	return Packet{
 		Data:               data,
@@ -52,6 +89,7 @@ func NewPacket(
 		DestinationPort:    destinationPort,
 		DestinationChannel: destinationChannel,
 		TimeoutHeight:      timeoutHeight,
+		TimeoutTimestamp:   timeoutTimestamp,
 	}
 }
 
@@ -74,29 +112,32 @@ func (p Packet) GetDestChannel() string { return p.DestinationChannel }
 func (p Packet) GetData() []byte { return p.Data }
 
 // GetTimeoutHeight implements PacketI interface
-func (p Packet) GetTimeoutHeight() uint64 { return p.TimeoutHeight }
+func (p Packet) GetTimeoutHeight() ibctypes.Height { return p.TimeoutHeight }
+
+// GetTimeoutTimestamp implements PacketI interface
+func (p Packet) GetTimeoutTimestamp() uint64 { return p.TimeoutTimestamp }
 
 // ValidateBasic implements PacketI interface
 func (p Packet) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(p.SourcePort); err != nil {
+	if err := host.PortIdentifierValidator(p.SourcePort); err != nil {
 		return sdkerrors.Wrapf(
 			ErrInvalidPacket,
 			sdkerrors.Wrapf(err, "invalid source port ID: %s", p.SourcePort).Error(),
 		)
 	}
-	if err := host.DefaultPortIdentifierValidator(p.DestinationPort); err != nil {
+	if err := host.PortIdentifierValidator(p.DestinationPort); err != nil {
 		return sdkerrors.Wrapf(
 			ErrInvalidPacket,
 			sdkerrors.Wrapf(err, "invalid destination port ID: %s", p.DestinationPort).Error(),
 		)
 	}
-	if err := host.DefaultChannelIdentifierValidator(p.SourceChannel); err != nil {
+	if err := host.ChannelIdentifierValidator(p.SourceChannel); err != nil {
 		return sdkerrors.Wrapf(
 			ErrInvalidPacket,
 			sdkerrors.Wrapf(err, "invalid source channel ID: %s", p.SourceChannel).Error(),
 		)
 	}
-	if err := host.DefaultChannelIdentifierValidator(p.DestinationChannel); err != nil {
+	if err := host.ChannelIdentifierValidator(p.DestinationChannel); err != nil {
 		return sdkerrors.Wrapf(
 			ErrInvalidPacket,
 			sdkerrors.Wrapf(err, "invalid destination channel ID: %s", p.DestinationChannel).Error(),
@@ -105,11 +146,109 @@ func (p Packet) ValidateBasic() error {
 	if p.Sequence == 0 {
 		return sdkerrors.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
 	}
-	if p.TimeoutHeight == 0 {
-		return sdkerrors.Wrap(ErrInvalidPacket, "packet timeout cannot be 0")
+	if p.TimeoutHeight.IsZero() && p.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "packet timeout height and packet timeout timestamp cannot both be 0")
 	}
 	if len(p.Data) == 0 {
 		return sdkerrors.Wrap(ErrInvalidPacket, "packet data bytes cannot be empty")
 	}
+	if err := validatePacketDataSize(p.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validatePacketDataSize checks that the packet data doesn't exceed the maximum
+// packet data size.
+func validatePacketDataSize(data []byte) error {
+	if len(data) > MaxPacketDataSize {
+		return sdkerrors.Wrapf(
+			ErrPacketDataTooLong,
+			"packet data cannot exceed %d bytes, got %d", MaxPacketDataSize, len(data),
+		)
+	}
 	return nil
 }
+
+// Equal returns true if both packets are the same.
+func (p Packet) Equal(other Packet) bool {
+	return bytes.Equal(p.Data, other.Data) &&
+		p.Sequence == other.Sequence &&
+		p.SourcePort == other.SourcePort &&
+		p.SourceChannel == other.SourceChannel &&
+		p.DestinationPort == other.DestinationPort &&
+		p.DestinationChannel == other.DestinationChannel &&
+		p.TimeoutHeight == other.TimeoutHeight &&
+		p.TimeoutTimestamp == other.TimeoutTimestamp
+}
+
+// Marshal implements the gogo proto custom type interface. The packet is encoded
+// with amino, so that it can be embedded in the protobuf governance proposals.
+func (p Packet) Marshal() ([]byte, error) {
+	return SubModuleCdc.MarshalBinaryBare(p)
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (p *Packet) MarshalTo(data []byte) (n int, err error) {
+	bz, err := p.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (p *Packet) Unmarshal(data []byte) error {
+	*p = Packet{}
+	if len(data) == 0 {
+		return nil
+	}
+
+	return SubModuleCdc.UnmarshalBinaryBare(data, p)
+}
+
+// Size implements the gogo proto custom type interface.
+func (p *Packet) Size() int {
+	bz, _ := p.Marshal()
+	return len(bz)
+}
+
+// RecvPacketResult defines the outcome of the execution of a packet received
+// through a MsgRecvPackets. The acknowledgement is empty if the packet wasn't
+// executed (e.g it was already received), in which case the error is set.
+type RecvPacketResult struct {
+	DestinationPort    string `json:"destination_port" yaml:"destination_port"`
+	DestinationChannel string `json:"destination_channel" yaml:"destination_channel"`
+	Sequence           uint64 `json:"sequence" yaml:"sequence"`
+	Acknowledgement    []byte `json:"acknowledgement" yaml:"acknowledgement"`
+	Error              string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NewRecvPacketResult creates a new RecvPacketResult instance for an executed
+// packet
+func NewRecvPacketResult(packet exported.PacketI, acknowledgement []byte) RecvPacketResult {
+	return RecvPacketResult{
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		Sequence:           packet.GetSequence(),
+		Acknowledgement:    acknowledgement,
+	}
+}
+
+// NewRecvPacketErrorResult creates a new RecvPacketResult instance for a packet
+// that failed to be executed
+func NewRecvPacketErrorResult(packet exported.PacketI, err error) RecvPacketResult {
+	return RecvPacketResult{
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		Sequence:           packet.GetSequence(),
+		Error:              err.Error(),
+	}
+}
+
+// Success returns true if the packet was executed.
+func (r RecvPacketResult) Success() bool {
+	return r.Error == ""
+}
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 func TestPacketValidateBasic(t *testing.T) {
@@ -12,13 +17,15 @@ func TestPacketValidateBasic(t *testing.T) {
 		expPass bool
 		errMsg  string
 	}{
-		{NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeout), true, ""},
-		{NewPacket(validPacketData, 0, portid, chanid, cpportid, cpchanid, timeout), false, "invalid sequence"},
-		{NewPacket(validPacketData, 1, invalidPort, chanid, cpportid, cpchanid, timeout), false, "invalid source port"},
-		{NewPacket(validPacketData, 1, portid, invalidChannel, cpportid, cpchanid, timeout), false, "invalid source channel"},
-		{NewPacket(validPacketData, 1, portid, chanid, invalidPort, cpchanid, timeout), false, "invalid destination port"},
-		{NewPacket(validPacketData, 1, portid, chanid, cpportid, invalidChannel, timeout), false, "invalid destination channel"},
-		{NewPacket(unknownPacketData, 1, portid, chanid, cpportid, cpchanid, timeout), true, ""},
+		{NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeout, 0), true, ""},
+		{NewPacket(validPacketData, 0, portid, chanid, cpportid, cpchanid, timeout, 0), false, "invalid sequence"},
+		{NewPacket(validPacketData, 1, invalidPort, chanid, cpportid, cpchanid, timeout, 0), false, "invalid source port"},
+		{NewPacket(validPacketData, 1, portid, invalidChannel, cpportid, cpchanid, timeout, 0), false, "invalid source channel"},
+		{NewPacket(validPacketData, 1, portid, chanid, invalidPort, cpchanid, timeout, 0), false, "invalid destination port"},
+		{NewPacket(validPacketData, 1, portid, chanid, cpportid, invalidChannel, timeout, 0), false, "invalid destination channel"},
+		{NewPacket(unknownPacketData, 1, portid, chanid, cpportid, cpchanid, timeout, 0), true, ""},
+		{NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, 0, 100), true, ""},
+		{NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, 0, 0), false, "zero timeout height and timestamp"},
 	}
 
 	for i, tc := range testCases {
@@ -30,3 +37,53 @@ func TestPacketValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestPacketValidateBasicDataSize(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		size    int
+		expPass bool
+	}{
+		{"data one byte below the limit", MaxPacketDataSize - 1, true},
+		{"data at the limit", MaxPacketDataSize, true},
+		{"data one byte above the limit", MaxPacketDataSize + 1, false},
+	}
+
+	for i, tc := range testCases {
+		packet := NewPacket(bytes.Repeat([]byte("a"), tc.size), 1, portid, chanid, cpportid, cpchanid, timeout, 0)
+
+		err := packet.ValidateBasic()
+		msgErr := NewMsgPacket(packet, proof, 1, addr1).ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.msg)
+			require.NoError(t, msgErr, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			require.True(t, ErrPacketDataTooLong.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+			require.True(t, ErrPacketDataTooLong.Is(msgErr), "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+// TestCommitPacketTimeoutHeight tests that both fields of the timeout height are
+// committed, so that no two packets share the same commitment bytes.
+func TestCommitPacketTimeoutHeight(t *testing.T) {
+	packet := NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeout, 100)
+
+	buf := append(sdk.Uint64ToBigEndian(0), sdk.Uint64ToBigEndian(timeout)...)
+	buf = append(buf, sdk.Uint64ToBigEndian(100)...)
+	require.Equal(t, tmhash.Sum(append(buf, validPacketData...)), CommitPacket(packet))
+
+	revisionPacket := NewPacketWithTimeoutHeight(
+		validPacketData, 1, portid, chanid, cpportid, cpchanid, ibctypes.NewHeight(1, timeout), 100,
+	)
+	require.NotEqual(t, CommitPacket(packet), CommitPacket(revisionPacket))
+	require.NotEqual(t, CommitPacket(revisionPacket), CommitPacket(
+		NewPacketWithTimeoutHeight(validPacketData, 1, portid, chanid, cpportid, cpchanid, ibctypes.NewHeight(2, timeout), 100),
+	))
+
+	// a flat timeout height whose fields and data shift into the ones of the
+	// revision packet doesn't collide with it
+	shiftedData := append(sdk.Uint64ToBigEndian(100), validPacketData...)
+	shiftedPacket := NewPacket(shiftedData, 1, portid, chanid, cpportid, cpchanid, 1, timeout)
+	require.NotEqual(t, CommitPacket(revisionPacket), CommitPacket(shiftedPacket))
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultMaxPacketProofAge disables the age check of the received packet
+	// proofs
+	DefaultMaxPacketProofAge uint64 = 0
+
+	// DefaultReceiptRetentionWindow disables the pruning of the packet receipts
+	DefaultReceiptRetentionWindow uint64 = 0
+)
+
+// Parameter store keys
+var (
+	KeyMaxPacketProofAge      = []byte("MaxPacketProofAge")
+	KeyReceiptRetentionWindow = []byte("ReceiptRetentionWindow")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(KeyMaxPacketProofAge, DefaultMaxPacketProofAge, validateMaxPacketProofAge),
+		paramtypes.NewParamSetPair(KeyReceiptRetentionWindow, DefaultReceiptRetentionWindow, validateReceiptRetentionWindow),
+	)
+}
+
+func validateMaxPacketProofAge(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateReceiptRetentionWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// PacketCommitmentPath returns the commitment path under which the commitment
+// of a packet sent on a channel is stored, given the commitment prefix of the
+// sending chain. It is the path the light clients verify the packet commitment
+// proofs against.
+func PacketCommitmentPath(
+	prefix commitmentexported.Prefix, portID, channelID string, sequence uint64,
+) (commitmenttypes.MerklePath, error) {
+	return commitmenttypes.ApplyPrefix(prefix, ibctypes.PacketCommitmentPath(portID, channelID, sequence))
+}
+
+// PacketAcknowledgementPath returns the commitment path under which the
+// acknowledgement of a packet received on a channel is stored, given the
+// commitment prefix of the receiving chain.
+func PacketAcknowledgementPath(
+	prefix commitmentexported.Prefix, portID, channelID string, sequence uint64,
+) (commitmenttypes.MerklePath, error) {
+	return commitmenttypes.ApplyPrefix(prefix, ibctypes.PacketAcknowledgementPath(portID, channelID, sequence))
+}
+
+// PacketReceiptPath returns the commitment path under which the receipt of a
+// packet received on a channel is stored, given the commitment prefix of the
+// receiving chain.
+func PacketReceiptPath(
+	prefix commitmentexported.Prefix, portID, channelID string, sequence uint64,
+) (commitmenttypes.MerklePath, error) {
+	return commitmenttypes.ApplyPrefix(prefix, ibctypes.PacketReceiptPath(portID, channelID, sequence))
+}
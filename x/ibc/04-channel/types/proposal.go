@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+const (
+	// ProposalTypeRelayerAllowlist defines the type for a RelayerAllowlistProposal
+	ProposalTypeRelayerAllowlist = "RelayerAllowlist"
+)
+
+// Assert RelayerAllowlistProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &RelayerAllowlistProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRelayerAllowlist)
+	govtypes.RegisterProposalTypeCodec(&RelayerAllowlistProposal{}, "ibc/channel/RelayerAllowlistProposal")
+}
+
+// RelayerAllowlistProposal defines a governance proposal to set the relayers
+// allowed to relay the packets and acknowledgements of a channel. The proposal
+// replaces the current allowlist of the channel, and an empty list of relayers
+// allows any relayer again.
+type RelayerAllowlistProposal struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	PortID      string           `json:"port_id" yaml:"port_id"`
+	ChannelID   string           `json:"channel_id" yaml:"channel_id"`
+	Relayers    []sdk.AccAddress `json:"relayers" yaml:"relayers"`
+}
+
+// NewRelayerAllowlistProposal creates a new relayer allowlist proposal.
+func NewRelayerAllowlistProposal(title, description, portID, channelID string, relayers []sdk.AccAddress) *RelayerAllowlistProposal {
+	return &RelayerAllowlistProposal{title, description, portID, channelID, relayers}
+}
+
+// GetTitle returns the title of a relayer allowlist proposal.
+func (rap *RelayerAllowlistProposal) GetTitle() string { return rap.Title }
+
+// GetDescription returns the description of a relayer allowlist proposal.
+func (rap *RelayerAllowlistProposal) GetDescription() string { return rap.Description }
+
+// ProposalRoute returns the routing key of a relayer allowlist proposal.
+func (rap *RelayerAllowlistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a relayer allowlist proposal.
+func (rap *RelayerAllowlistProposal) ProposalType() string { return ProposalTypeRelayerAllowlist }
+
+// ValidateBasic runs basic stateless validity checks
+func (rap *RelayerAllowlistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rap); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(rap.PortID); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(rap.ChannelID); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+
+	seen := make(map[string]bool)
+	for _, relayer := range rap.Relayers {
+		if relayer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty relayer address")
+		}
+		if seen[relayer.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicated relayer %s", relayer)
+		}
+		seen[relayer.String()] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (rap RelayerAllowlistProposal) String() string {
+	return fmt.Sprintf(`Relayer Allowlist Proposal:
+  Title:       %s
+  Description: %s
+  Port:        %s
+  Channel:     %s
+  Relayers:    %v
+`, rap.Title, rap.Description, rap.PortID, rap.ChannelID, rap.Relayers)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRelayerAllowlistProposalValidateBasic(t *testing.T) {
+	relayer := sdk.AccAddress([]byte("relayer"))
+
+	testCases := []struct {
+		name     string
+		proposal *RelayerAllowlistProposal
+		expPass  bool
+	}{
+		{"valid proposal", NewRelayerAllowlistProposal("title", "description", "testportid", "testchannel", []sdk.AccAddress{relayer}), true},
+		{"empty allowlist", NewRelayerAllowlistProposal("title", "description", "testportid", "testchannel", nil), true},
+		{"empty title", NewRelayerAllowlistProposal("", "description", "testportid", "testchannel", nil), false},
+		{"invalid port", NewRelayerAllowlistProposal("title", "description", "(testportid)", "testchannel", nil), false},
+		{"invalid channel", NewRelayerAllowlistProposal("title", "description", "testportid", "(testchannel)", nil), false},
+		{"empty relayer", NewRelayerAllowlistProposal("title", "description", "testportid", "testchannel", []sdk.AccAddress{nil}), false},
+		{"duplicated relayer", NewRelayerAllowlistProposal("title", "description", "testportid", "testchannel", []sdk.AccAddress{relayer, relayer}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
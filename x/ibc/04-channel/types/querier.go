@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
@@ -14,8 +15,20 @@ const (
 	QueryAllChannels        = "channels"
 	QueryChannel            = "channel"
 	QueryConnectionChannels = "connection-channels"
+	QueryNextSequenceSend   = "next-sequence-send"
+	QueryPacketCommitment   = "packet-commitment"
+	QueryPacketCommitments  = "packet-commitments"
+	QueryPacketAck          = "packet-ack"
+
+	QueryUnreceivedPackets      = "unreceived-packets"
+	QueryUnreceivedPacketsRange = "unreceived-packets-range"
+	QueryUnrelayedAcks          = "unrelayed-acks"
 )
 
+// MaxQuerySequences is the maximum number of packet sequences that can be
+// checked by a single unreceived packets or unrelayed acknowledgements query.
+const MaxQuerySequences = 1000
+
 type IdentifiedChannel struct {
 	Channel           Channel `json:"channel_end" yaml:"channel_end"`
 	PortIdentifier    string  `json:"port_identifier" yaml:"port_identifier"`
@@ -75,6 +88,154 @@ func NewQueryConnectionChannelsParams(connection string, page, limit int) QueryC
 	}
 }
 
+// QueryNextSequenceSendParams defines the parameters necessary for querying
+// the next send sequence of a channel.
+type QueryNextSequenceSendParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+}
+
+// NewQueryNextSequenceSendParams creates a new QueryNextSequenceSendParams instance.
+func NewQueryNextSequenceSendParams(portID, channelID string) QueryNextSequenceSendParams {
+	return QueryNextSequenceSendParams{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+}
+
+// QueryPacketCommitmentParams defines the parameters necessary for querying
+// the commitment of a packet sent on a channel.
+type QueryPacketCommitmentParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Sequence  uint64 `json:"sequence" yaml:"sequence"`
+}
+
+// NewQueryPacketCommitmentParams creates a new QueryPacketCommitmentParams instance.
+func NewQueryPacketCommitmentParams(portID, channelID string, sequence uint64) QueryPacketCommitmentParams {
+	return QueryPacketCommitmentParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// QueryPacketCommitmentsParams defines the parameters necessary for querying
+// the commitments of all the packets in flight on a channel.
+type QueryPacketCommitmentsParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Page      int    `json:"page" yaml:"page"`
+	Limit     int    `json:"limit" yaml:"limit"`
+}
+
+// NewQueryPacketCommitmentsParams creates a new QueryPacketCommitmentsParams instance.
+func NewQueryPacketCommitmentsParams(portID, channelID string, page, limit int) QueryPacketCommitmentsParams {
+	return QueryPacketCommitmentsParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Page:      page,
+		Limit:     limit,
+	}
+}
+
+// QueryPacketAckParams defines the parameters necessary for querying the
+// acknowledgement of a packet received on a channel.
+type QueryPacketAckParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Sequence  uint64 `json:"sequence" yaml:"sequence"`
+}
+
+// NewQueryPacketAckParams creates a new QueryPacketAckParams instance.
+func NewQueryPacketAckParams(portID, channelID string, sequence uint64) QueryPacketAckParams {
+	return QueryPacketAckParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// QueryUnreceivedPacketsParams defines the parameters necessary for querying
+// which of the given packet sequences haven't been received on a channel.
+type QueryUnreceivedPacketsParams struct {
+	PortID    string   `json:"port_id" yaml:"port_id"`
+	ChannelID string   `json:"channel_id" yaml:"channel_id"`
+	Sequences []uint64 `json:"sequences" yaml:"sequences"`
+}
+
+// NewQueryUnreceivedPacketsParams creates a new QueryUnreceivedPacketsParams instance.
+func NewQueryUnreceivedPacketsParams(portID, channelID string, sequences []uint64) QueryUnreceivedPacketsParams {
+	return QueryUnreceivedPacketsParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequences: sequences,
+	}
+}
+
+// ValidateBasic checks that the number of sequences doesn't exceed the maximum.
+func (params QueryUnreceivedPacketsParams) ValidateBasic() error {
+	return validateQuerySequences(params.Sequences)
+}
+
+// QueryUnreceivedPacketsRangeParams defines the parameters necessary for
+// querying which of the packet sequences from a range haven't been received on a
+// channel. The range is paginated, so that each page checks up to limit
+// sequences.
+type QueryUnreceivedPacketsRangeParams struct {
+	PortID        string `json:"port_id" yaml:"port_id"`
+	ChannelID     string `json:"channel_id" yaml:"channel_id"`
+	StartSequence uint64 `json:"start_sequence" yaml:"start_sequence"` // inclusive
+	EndSequence   uint64 `json:"end_sequence" yaml:"end_sequence"`     // inclusive
+	Page          int    `json:"page" yaml:"page"`
+	Limit         int    `json:"limit" yaml:"limit"`
+}
+
+// NewQueryUnreceivedPacketsRangeParams creates a new QueryUnreceivedPacketsRangeParams instance.
+func NewQueryUnreceivedPacketsRangeParams(
+	portID, channelID string, startSequence, endSequence uint64, page, limit int,
+) QueryUnreceivedPacketsRangeParams {
+	return QueryUnreceivedPacketsRangeParams{
+		PortID:        portID,
+		ChannelID:     channelID,
+		StartSequence: startSequence,
+		EndSequence:   endSequence,
+		Page:          page,
+		Limit:         limit,
+	}
+}
+
+// QueryUnrelayedAcksParams defines the parameters necessary for querying which
+// of the given packets sent on a channel haven't been acknowledged yet.
+type QueryUnrelayedAcksParams struct {
+	PortID    string   `json:"port_id" yaml:"port_id"`
+	ChannelID string   `json:"channel_id" yaml:"channel_id"`
+	Sequences []uint64 `json:"sequences" yaml:"sequences"`
+}
+
+// NewQueryUnrelayedAcksParams creates a new QueryUnrelayedAcksParams instance.
+func NewQueryUnrelayedAcksParams(portID, channelID string, sequences []uint64) QueryUnrelayedAcksParams {
+	return QueryUnrelayedAcksParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequences: sequences,
+	}
+}
+
+// ValidateBasic checks that the number of sequences doesn't exceed the maximum.
+func (params QueryUnrelayedAcksParams) ValidateBasic() error {
+	return validateQuerySequences(params.Sequences)
+}
+
+func validateQuerySequences(sequences []uint64) error {
+	if len(sequences) > MaxQuerySequences {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "too many packet sequences: %d > %d", len(sequences), MaxQuerySequences,
+		)
+	}
+	return nil
+}
+
 // PacketResponse defines the client query response for a packet which also
 // includes a proof, its path and the height form which the proof was retrieved
 type PacketResponse struct {
@@ -96,6 +257,78 @@ func NewPacketResponse(
 	}
 }
 
+// PacketCommitmentResponse defines the client query response for the
+// commitment of a packet which also includes a proof, its path and the height
+// from which the proof was retrieved
+type PacketCommitmentResponse struct {
+	PortID      string                      `json:"port_id" yaml:"port_id"`
+	ChannelID   string                      `json:"channel_id" yaml:"channel_id"`
+	Sequence    uint64                      `json:"sequence" yaml:"sequence"`
+	Commitment  []byte                      `json:"commitment" yaml:"commitment"`
+	Proof       commitmenttypes.MerkleProof `json:"proof,omitempty" yaml:"proof,omitempty"`
+	ProofPath   commitmenttypes.MerklePath  `json:"proof_path,omitempty" yaml:"proof_path,omitempty"`
+	ProofHeight uint64                      `json:"proof_height,omitempty" yaml:"proof_height,omitempty"`
+}
+
+// NewPacketCommitmentResponse creates a new PacketCommitmentResponse instance
+func NewPacketCommitmentResponse(
+	portID, channelID string, sequence uint64, commitment []byte, proof *merkle.Proof, height int64,
+) PacketCommitmentResponse {
+	return PacketCommitmentResponse{
+		PortID:      portID,
+		ChannelID:   channelID,
+		Sequence:    sequence,
+		Commitment:  commitment,
+		Proof:       commitmenttypes.MerkleProof{Proof: proof},
+		ProofPath:   commitmenttypes.NewMerklePath(strings.Split(ibctypes.PacketCommitmentPath(portID, channelID, sequence), "/")),
+		ProofHeight: uint64(height),
+	}
+}
+
+// PacketCommitment defines the commitment hash of a packet sent on a channel,
+// along with its sequence.
+type PacketCommitment struct {
+	Sequence   uint64 `json:"sequence" yaml:"sequence"`
+	Commitment []byte `json:"commitment" yaml:"commitment"`
+}
+
+// NewPacketCommitment creates a new PacketCommitment instance
+func NewPacketCommitment(sequence uint64, commitment []byte) PacketCommitment {
+	return PacketCommitment{
+		Sequence:   sequence,
+		Commitment: commitment,
+	}
+}
+
+// PacketAckResponse defines the client query response for the acknowledgement
+// of a packet which also includes a proof, its path and the height from which
+// the proof was retrieved. The acknowledgement is stored as a hash, which is
+// the value proven on the counterparty chain.
+type PacketAckResponse struct {
+	PortID          string                      `json:"port_id" yaml:"port_id"`
+	ChannelID       string                      `json:"channel_id" yaml:"channel_id"`
+	Sequence        uint64                      `json:"sequence" yaml:"sequence"`
+	Acknowledgement []byte                      `json:"acknowledgement" yaml:"acknowledgement"`
+	Proof           commitmenttypes.MerkleProof `json:"proof,omitempty" yaml:"proof,omitempty"`
+	ProofPath       commitmenttypes.MerklePath  `json:"proof_path,omitempty" yaml:"proof_path,omitempty"`
+	ProofHeight     uint64                      `json:"proof_height,omitempty" yaml:"proof_height,omitempty"`
+}
+
+// NewPacketAckResponse creates a new PacketAckResponse instance
+func NewPacketAckResponse(
+	portID, channelID string, sequence uint64, ack []byte, proof *merkle.Proof, height int64,
+) PacketAckResponse {
+	return PacketAckResponse{
+		PortID:          portID,
+		ChannelID:       channelID,
+		Sequence:        sequence,
+		Acknowledgement: ack,
+		Proof:           commitmenttypes.MerkleProof{Proof: proof},
+		ProofPath:       commitmenttypes.NewMerklePath(strings.Split(ibctypes.PacketAcknowledgementPath(portID, channelID, sequence), "/")),
+		ProofHeight:     uint64(height),
+	}
+}
+
 // RecvResponse defines the client query response for the next receive sequence
 // number which also includes a proof, its path and the height form which the
 // proof was retrieved
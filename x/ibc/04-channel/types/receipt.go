@@ -0,0 +1,38 @@
+package types
+
+import (
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// PacketReceipt records that a packet was received on an unordered channel, so
+// that it can't be received again. Along with the height it was received at, it
+// keeps the timeout of the packet, which determines when the receipt can be
+// pruned (see IsPrunable).
+type PacketReceipt struct {
+	Height           uint64          `json:"height" yaml:"height"`                       // height of this chain at which the packet was received
+	TimeoutHeight    ibctypes.Height `json:"timeout_height" yaml:"timeout_height"`       // timeout height of the packet on this chain
+	TimeoutTimestamp uint64          `json:"timeout_timestamp" yaml:"timeout_timestamp"` // timeout timestamp (in nanoseconds) of the packet on this chain
+}
+
+// NewPacketReceipt creates a new PacketReceipt for a packet received at the
+// given height.
+func NewPacketReceipt(height uint64, timeoutHeight ibctypes.Height, timeoutTimestamp uint64) PacketReceipt {
+	return PacketReceipt{
+		Height:           height,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// IsPrunable returns true if the receipt is older than the retention window and
+// the packet timed out on this chain at the given height and block time (in
+// nanoseconds). A timed out packet is rejected by RecvPacket, so the
+// counterparty can no longer resubmit it once its receipt is pruned.
+func (r PacketReceipt) IsPrunable(height ibctypes.Height, blockTime, retentionWindow uint64) bool {
+	if height.RevisionHeight < r.Height || height.RevisionHeight-r.Height < retentionWindow {
+		return false
+	}
+
+	return ibctypes.IsTimeoutHeightReached(height, r.TimeoutHeight) ||
+		(r.TimeoutTimestamp != 0 && blockTime >= r.TimeoutTimestamp)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func TestPacketReceiptIsPrunable(t *testing.T) {
+	testCases := []struct {
+		msg         string
+		receipt     PacketReceipt
+		height      ibctypes.Height
+		blockTime   uint64
+		expPrunable bool
+	}{
+		{"within the retention window", NewPacketReceipt(10, ibctypes.NewHeight(0, 11), 0), ibctypes.NewHeight(0, 19), 0, false},
+		{"timeout height not reached", NewPacketReceipt(10, ibctypes.NewHeight(0, 100), 0), ibctypes.NewHeight(0, 20), 0, false},
+		{"timeout height reached", NewPacketReceipt(10, ibctypes.NewHeight(0, 11), 0), ibctypes.NewHeight(0, 20), 0, true},
+		{"timeout height reached by a revision bump", NewPacketReceipt(10, ibctypes.NewHeight(1, 100), 0), ibctypes.NewHeight(2, 20), 0, true},
+		{"timeout timestamp not reached", NewPacketReceipt(10, ibctypes.Height{}, 1000), ibctypes.NewHeight(0, 20), 999, false},
+		{"timeout timestamp reached", NewPacketReceipt(10, ibctypes.Height{}, 1000), ibctypes.NewHeight(0, 20), 1000, true},
+		{"no timeout", NewPacketReceipt(10, ibctypes.Height{}, 0), ibctypes.NewHeight(0, 1000), 1000, false},
+		{"received after the given height", NewPacketReceipt(30, ibctypes.NewHeight(0, 11), 0), ibctypes.NewHeight(0, 20), 0, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPrunable, tc.receipt.IsPrunable(tc.height, tc.blockTime, 10), tc.msg)
+	}
+}
@@ -59,19 +59,24 @@ type IBCModule interface {
 		channelID string,
 	) error
 
+	// The relayer is the signer of the message that relayed the packet, the
+	// acknowledgement or the timeout, so that modules can incentivize relaying.
 	OnRecvPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 
 	OnAcknowledgementPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
 		acknowledgement []byte,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 
 	OnTimeoutPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 }
@@ -72,8 +72,23 @@ func checkMisbehaviour(
 		)
 	}
 
-	// NOTE: header height and commitment root assertions are checked with the
-	// evidence and msg ValidateBasic functions at the AnteHandler level.
+	// NOTE: the evidence ValidateBasic is only run at the AnteHandler level when
+	// it's submitted through a msg, so the headers are checked again here to
+	// ensure that they aren't identical.
+	if evidence.Header1.Height != evidence.Header2.Height {
+		return fmt.Errorf("headers are on different heights (%d ≠ %d)", evidence.Header1.Height, evidence.Header2.Height)
+	}
+
+	if evidence.Header1.Commit.BlockID.Equals(evidence.Header2.Commit.BlockID) {
+		return errors.New("headers commit to the same blockID")
+	}
+
+	if err := types.ValidCommit(evidence.ChainID, evidence.Header1.Commit, evidence.Header1.ValidatorSet); err != nil {
+		return err
+	}
+	if err := types.ValidCommit(evidence.ChainID, evidence.Header2.Commit, evidence.Header2.ValidatorSet); err != nil {
+		return err
+	}
 
 	// assert that the timestamp is not from more than an unbonding period ago
 	if currentTimestamp.Sub(consensusState.Timestamp) >= clientState.UnbondingPeriod {
@@ -34,9 +34,10 @@ func (cs ConsensusState) GetHeight() uint64 {
 	return cs.Height
 }
 
-// GetTimestamp returns block time at which the consensus state was stored
-func (cs ConsensusState) GetTimestamp() time.Time {
-	return cs.Timestamp
+// GetTimestamp returns block time (in nanoseconds since the Unix epoch) at which
+// the consensus state was stored
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return uint64(cs.Timestamp.UnixNano())
 }
 
 // ValidateBasic defines a basic validation for the tendermint consensus state.
@@ -21,9 +21,10 @@ const (
 )
 
 var (
-	_ clientexported.MsgCreateClient     = MsgCreateClient{}
-	_ clientexported.MsgUpdateClient     = MsgUpdateClient{}
-	_ evidenceexported.MsgSubmitEvidence = MsgSubmitClientMisbehaviour{}
+	_ clientexported.MsgCreateClient       = MsgCreateClient{}
+	_ clientexported.MsgUpdateClient       = MsgUpdateClient{}
+	_ evidenceexported.MsgSubmitEvidence   = MsgSubmitClientMisbehaviour{}
+	_ clientexported.MsgSubmitMisbehaviour = MsgSubmitClientMisbehaviour{}
 )
 
 // MsgCreateClient defines a message to create an IBC client
@@ -74,7 +75,7 @@ func (msg MsgCreateClient) ValidateBasic() error {
 	if err := msg.Header.ValidateBasic(msg.Header.ChainID); err != nil {
 		return sdkerrors.Wrapf(ErrInvalidHeader, "header failed validatebasic with its own chain-id: %v", err)
 	}
-	return host.DefaultClientIdentifierValidator(msg.ClientID)
+	return host.ClientIdentifierValidator(msg.ClientID)
 }
 
 // GetSignBytes implements sdk.Msg
@@ -140,7 +141,7 @@ func (msg MsgUpdateClient) ValidateBasic() error {
 	if msg.Signer.Empty() {
 		return sdkerrors.ErrInvalidAddress
 	}
-	return host.DefaultClientIdentifierValidator(msg.ClientID)
+	return host.ClientIdentifierValidator(msg.ClientID)
 }
 
 // GetSignBytes implements sdk.Msg
@@ -208,10 +209,12 @@ func (msg MsgSubmitClientMisbehaviour) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Submitter}
 }
 
+// GetEvidence implements clientexported.MsgSubmitMisbehaviour
 func (msg MsgSubmitClientMisbehaviour) GetEvidence() evidenceexported.Evidence {
 	return msg.Evidence
 }
 
+// GetSubmitter implements clientexported.MsgSubmitMisbehaviour
 func (msg MsgSubmitClientMisbehaviour) GetSubmitter() sdk.AccAddress {
 	return msg.Submitter
 }
@@ -0,0 +1,20 @@
+package transfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker snapshots the escrow balances of the transfer channels every
+// EscrowSnapshotInterval blocks, and prunes the snapshots older than the
+// EscrowSnapshotRetention latest ones. The snapshots are disabled by default.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	params := k.GetParams(ctx)
+	if !params.IsEscrowSnapshotHeight(ctx.BlockHeight()) {
+		return
+	}
+
+	k.SnapshotEscrowBalances(ctx)
+	if pruneHeight, ok := params.EscrowSnapshotPruneHeight(ctx.BlockHeight()); ok {
+		k.PruneEscrowSnapshots(ctx, pruneHeight)
+	}
+}
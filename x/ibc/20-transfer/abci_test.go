@@ -0,0 +1,41 @@
+package transfer_test
+
+import (
+	transfer "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *HandlerTestSuite) TestEndBlockerEscrowSnapshots() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	// the snapshots are disabled by default
+	transfer.EndBlocker(ctx.WithBlockHeight(10), transferKeeper)
+	_, found := transferKeeper.GetEscrowSnapshot(ctx, 10)
+	suite.Require().False(found)
+
+	transferKeeper.SetParams(ctx, types.NewParams(true, true, nil, nil, false, 5, false, 0, 2))
+
+	for height := int64(11); height <= 17; height++ {
+		transfer.EndBlocker(ctx.WithBlockHeight(height), transferKeeper)
+	}
+
+	_, found = transferKeeper.GetEscrowSnapshot(ctx, 14)
+	suite.Require().False(found)
+
+	snapshot, found := transferKeeper.GetEscrowSnapshot(ctx, 17)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(15), snapshot.Height)
+
+	// only the 2 latest snapshots are kept
+	for height := int64(18); height <= 25; height++ {
+		transfer.EndBlocker(ctx.WithBlockHeight(height), transferKeeper)
+	}
+
+	_, found = transferKeeper.GetEscrowSnapshot(ctx, 19)
+	suite.Require().False(found)
+
+	snapshot, found = transferKeeper.GetEscrowSnapshot(ctx, 24)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(20), snapshot.Height)
+}
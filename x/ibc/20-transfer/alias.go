@@ -12,44 +12,267 @@ import (
 )
 
 const (
-	DefaultPacketTimeout       = keeper.DefaultPacketTimeout
-	EventTypeTimeout           = types.EventTypeTimeout
-	EventTypePacket            = types.EventTypePacket
-	EventTypeChannelClose      = types.EventTypeChannelClose
-	AttributeKeyReceiver       = types.AttributeKeyReceiver
-	AttributeKeyValue          = types.AttributeKeyValue
-	AttributeKeyRefundReceiver = types.AttributeKeyRefundReceiver
-	AttributeKeyRefundValue    = types.AttributeKeyRefundValue
-	AttributeKeyAckSuccess     = types.AttributeKeyAckSuccess
-	AttributeKeyAckError       = types.AttributeKeyAckError
-	ModuleName                 = types.ModuleName
-	StoreKey                   = types.StoreKey
-	RouterKey                  = types.RouterKey
-	QuerierRoute               = types.QuerierRoute
+	DefaultPacketTimeout          = keeper.DefaultPacketTimeout
+	MetricsSubsystem              = keeper.MetricsSubsystem
+	EventTypeTimeout              = types.EventTypeTimeout
+	EventTypePacket               = types.EventTypePacket
+	EventTypeChannelClose         = types.EventTypeChannelClose
+	EventTypeTransfer             = types.EventTypeTransfer
+	EventTypeRecvTransfer         = types.EventTypeRecvTransfer
+	EventTypeNFTTransfer          = types.EventTypeNFTTransfer
+	EventTypeTransferFee          = types.EventTypeTransferFee
+	EventTypeCancel               = types.EventTypeCancel
+	EventTypeNFTPacket            = types.EventTypeNFTPacket
+	EventTypeForward              = types.EventTypeForward
+	EventTypeForwardRefund        = types.EventTypeForwardRefund
+	EventTypePayPacketFee         = types.EventTypePayPacketFee
+	EventTypeDistributeFee        = types.EventTypeDistributeFee
+	EventTypeRefundStuck          = types.EventTypeRefundStuck
+	AttributeKeyReceiver          = types.AttributeKeyReceiver
+	AttributeKeyValue             = types.AttributeKeyValue
+	AttributeKeyRefundReceiver    = types.AttributeKeyRefundReceiver
+	AttributeKeyRefundValue       = types.AttributeKeyRefundValue
+	AttributeKeyAckSuccess        = types.AttributeKeyAckSuccess
+	AttributeKeyAckError          = types.AttributeKeyAckError
+	AttributeKeyDenom             = types.AttributeKeyDenom
+	AttributeKeyAmount            = types.AttributeKeyAmount
+	AttributeKeySourcePort        = types.AttributeKeySourcePort
+	AttributeKeySourceChannel     = types.AttributeKeySourceChannel
+	AttributeKeyDestPort          = types.AttributeKeyDestPort
+	AttributeKeyDestChannel       = types.AttributeKeyDestChannel
+	AttributeKeyIsSource          = types.AttributeKeyIsSource
+	AttributeKeyVoucherDenom      = types.AttributeKeyVoucherDenom
+	AttributeKeyClassID           = types.AttributeKeyClassID
+	AttributeKeyTokenIDs          = types.AttributeKeyTokenIDs
+	AttributeKeySequence          = types.AttributeKeySequence
+	AttributeKeyRecvFee           = types.AttributeKeyRecvFee
+	AttributeKeyAckFee            = types.AttributeKeyAckFee
+	AttributeKeyTimeoutFee        = types.AttributeKeyTimeoutFee
+	AttributeKeyRelayer           = types.AttributeKeyRelayer
+	AttributeKeyFeeRecipient      = types.AttributeKeyFeeRecipient
+	AttributeKeyFee               = types.AttributeKeyFee
+	AttributeKeyTimeoutHeight     = types.AttributeKeyTimeoutHeight
+	AttributeKeyTimeoutTime       = types.AttributeKeyTimeoutTime
+	AttributeKeyDataHash          = types.AttributeKeyDataHash
+	DefaultForwardTimeout         = types.DefaultForwardTimeout
+	ModuleName                    = types.ModuleName
+	StoreKey                      = types.StoreKey
+	RouterKey                     = types.RouterKey
+	QuerierRoute                  = types.QuerierRoute
+	DenomPrefix                   = types.DenomPrefix
+	MaxDenomPathLength            = types.MaxDenomPathLength
+	QueryDenomTrace               = types.QueryDenomTrace
+	QueryDenomTraces              = types.QueryDenomTraces
+	QueryDenomTraceByPath         = types.QueryDenomTraceByPath
+	QueryVerifyDenomTrace         = types.QueryVerifyDenomTrace
+	QueryRateLimit                = types.QueryRateLimit
+	QueryTotalEscrow              = types.QueryTotalEscrow
+	QuerySimulateTransfer         = types.QuerySimulateTransfer
+	QueryPacketFees               = types.QueryPacketFees
+	QueryTransferChannels         = types.QueryTransferChannels
+	QuerySendDenylist             = types.QuerySendDenylist
+	QueryEscrowAddress            = types.QueryEscrowAddress
+	QueryEscrowAddresses          = types.QueryEscrowAddresses
+	EscrowAddressVersionLegacy    = types.EscrowAddressVersionLegacy
+	EscrowAddressVersionADR028    = types.EscrowAddressVersionADR028
+	CurrentEscrowAddressVersion   = types.CurrentEscrowAddressVersion
+	DefaultParamspace             = types.DefaultParamspace
+	DefaultSelfLoopbackCheck      = types.DefaultSelfLoopbackCheck
+	PacketEncodingJSON            = types.PacketEncodingJSON
+	PacketEncodingProto           = types.PacketEncodingProto
+	ProposalTypeRefundStuckPacket = types.ProposalTypeRefundStuckPacket
+	ProposalTypeUpdateParams      = types.ProposalTypeUpdateParams
+	QueryPacketState              = types.QueryPacketState
+	QueryParams                   = types.QueryParams
+	QueryEscrowSnapshot           = types.QueryEscrowSnapshot
+	QueryChannelClientState       = types.QueryChannelClientState
+	DefaultEscrowSnapshotInterval = types.DefaultEscrowSnapshotInterval
+	DefaultAllowOrderedChannels   = types.DefaultAllowOrderedChannels
+	DefaultMaxDenomTraceDepth     = types.DefaultMaxDenomTraceDepth
+	DefaultSendEnabled            = types.DefaultSendEnabled
+	DefaultReceiveEnabled         = types.DefaultReceiveEnabled
+	PacketStateUnknown            = types.PacketStateUnknown
+	PacketStateSent               = types.PacketStateSent
+	PacketStateReceived           = types.PacketStateReceived
+
+	DefaultEscrowSnapshotRetention = types.DefaultEscrowSnapshotRetention
 )
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	RegisterCodec        = types.RegisterCodec
-	GetEscrowAddress     = types.GetEscrowAddress
-	GetDenomPrefix       = types.GetDenomPrefix
-	GetModuleAccountName = types.GetModuleAccountName
-	NewMsgTransfer       = types.NewMsgTransfer
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+	PrometheusMetrics                = keeper.PrometheusMetrics
+	NopMetrics                       = keeper.NopMetrics
+	RegisterCodec                    = types.RegisterCodec
+	RegisterPacketData               = types.RegisterPacketData
+	GetEscrowAddress                 = types.GetEscrowAddress
+	GetDenomPrefix                   = types.GetDenomPrefix
+	GetModuleAccountName             = types.GetModuleAccountName
+	NewMsgTransfer                   = types.NewMsgTransfer
+	NewMsgTransferNFT                = types.NewMsgTransferNFT
+	NewNonFungibleTokenPacketData    = types.NewNonFungibleTokenPacketData
+	ValidateClassID                  = types.ValidateClassID
+	NewTransferOutput                = types.NewTransferOutput
+	NewTransferFee                   = types.NewTransferFee
+	ValidateMinReceived              = types.ValidateMinReceived
+	NewMsgCancelTransfer             = types.NewMsgCancelTransfer
+	NewMsgMultiTransfer              = types.NewMsgMultiTransfer
+	GetAcknowledgement               = types.GetAcknowledgement
+	NewErrorAcknowledgement          = types.NewErrorAcknowledgement
+	GetPacketData                    = types.GetPacketData
+	NewDenomTrace                    = types.NewDenomTrace
+	ParseDenomTrace                  = types.ParseDenomTrace
+	ParseHexHash                     = types.ParseHexHash
+	ParseHexHashWithSize             = types.ParseHexHashWithSize
+	VerifyDenomTrace                 = types.VerifyDenomTrace
+	VerifyDenomTraceWith             = types.VerifyDenomTraceWith
+	ValidateDenomTraceHasher         = types.ValidateDenomTraceHasher
+	ValidatePrefixedDenom            = types.ValidatePrefixedDenom
+	IsIBCDenom                       = types.IsIBCDenom
+	NewQueryDenomTraceParams         = types.NewQueryDenomTraceParams
+	NewQueryDenomTracesParams        = types.NewQueryDenomTracesParams
+	NewQueryDenomTraceByPathParams   = types.NewQueryDenomTraceByPathParams
+	NewDenomTraceByPathResponse      = types.NewDenomTraceByPathResponse
+	NewQueryVerifyDenomTraceParams   = types.NewQueryVerifyDenomTraceParams
+	NewVerifyDenomTraceResponse      = types.NewVerifyDenomTraceResponse
+	RegisterQueryServer              = types.RegisterQueryServer
+	NewMsgUpdateParams               = types.NewMsgUpdateParams
+	NewQueryClient                   = types.NewQueryClient
+	NewRateLimit                     = types.NewRateLimit
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesis                   = types.DefaultGenesis
+	NewQueryRateLimitParams          = types.NewQueryRateLimitParams
+	NewRateLimitResponse             = types.NewRateLimitResponse
+	NewQueryTotalEscrowParams        = types.NewQueryTotalEscrowParams
+	NewTotalEscrowResponse           = types.NewTotalEscrowResponse
+	NewQuerySimulateTransferParams   = types.NewQuerySimulateTransferParams
+	NewSimulateTransferResponse      = types.NewSimulateTransferResponse
+	GetPacketEncoding                = types.GetPacketEncoding
+	DecodePacketData                 = types.DecodePacketData
+	ParseForwardMetadata             = types.ParseForwardMetadata
+	NewForwardRecord                 = types.NewForwardRecord
+	NewFee                           = types.NewFee
+	NewPacketFee                     = types.NewPacketFee
+	NewIdentifiedPacketFees          = types.NewIdentifiedPacketFees
+	NewMsgPayPacketFee               = types.NewMsgPayPacketFee
+	GetFeeEscrowAddress              = types.GetFeeEscrowAddress
+	NewQueryPacketFeesParams         = types.NewQueryPacketFeesParams
+	NewQueryTransferChannelsParams   = types.NewQueryTransferChannelsParams
+	NewTransferChannel               = types.NewTransferChannel
+	NewRefundStuckPacketProposal     = types.NewRefundStuckPacketProposal
+	HandleRefundStuckPacketProposal  = keeper.HandleRefundStuckPacketProposal
+	NewUpdateParamsProposal          = types.NewUpdateParamsProposal
+	HandleUpdateParamsProposal       = keeper.HandleUpdateParamsProposal
+	GetVersionedEscrowAddress        = types.GetVersionedEscrowAddress
+	IsEscrowAddress                  = types.IsEscrowAddress
+	NewChannelEscrowAddressVersion   = types.NewChannelEscrowAddressVersion
+	NewQueryEscrowAddressParams      = types.NewQueryEscrowAddressParams
+	NewEscrowAddressResponse         = types.NewEscrowAddressResponse
+	NewQueryEscrowAddressesParams    = types.NewQueryEscrowAddressesParams
+	NewChannelEscrowAddress          = types.NewChannelEscrowAddress
+	NewEscrowAddressesResponse       = types.NewEscrowAddressesResponse
+	ParamKeyTable                    = types.ParamKeyTable
+	NewSentCoins                     = types.NewSentCoins
+	NewQueryPacketStateParams        = types.NewQueryPacketStateParams
+	NewPacketStateResponse           = types.NewPacketStateResponse
+	NewDenomEnabled                  = types.NewDenomEnabled
+	NewParams                        = types.NewParams
+	DefaultParams                    = types.DefaultParams
+	NewChannelEscrowBalance          = types.NewChannelEscrowBalance
+	NewEscrowSnapshot                = types.NewEscrowSnapshot
+	NewQueryEscrowSnapshotParams     = types.NewQueryEscrowSnapshotParams
+	NewQueryChannelClientStateParams = types.NewQueryChannelClientStateParams
+	NewChannelClientState            = types.NewChannelClientState
 
 	// variable aliases
-	ModuleCdc              = types.ModuleCdc
-	AttributeValueCategory = types.AttributeValueCategory
+	ModuleCdc                 = types.ModuleCdc
+	AttributeValueCategory    = types.AttributeValueCategory
+	KeySelfLoopbackCheck      = types.KeySelfLoopbackCheck
+	KeySendEnabled            = types.KeySendEnabled
+	KeyReceiveEnabled         = types.KeyReceiveEnabled
+	KeyDenomSendEnabled       = types.KeyDenomSendEnabled
+	KeyDenomReceiveEnabled    = types.KeyDenomReceiveEnabled
+	DefaultDenomTraceHasher   = types.DefaultDenomTraceHasher
+	KeyEscrowSnapshotInterval = types.KeyEscrowSnapshotInterval
+	KeyAllowOrderedChannels   = types.KeyAllowOrderedChannels
+	KeyMaxDenomTraceDepth     = types.KeyMaxDenomTraceDepth
+
+	KeyEscrowSnapshotRetention = types.KeyEscrowSnapshotRetention
 )
 
 type (
 	Keeper                             = keeper.Keeper
+	Metrics                            = keeper.Metrics
 	BankKeeper                         = types.BankKeeper
 	ChannelKeeper                      = types.ChannelKeeper
 	ClientKeeper                       = types.ClientKeeper
 	ConnectionKeeper                   = types.ConnectionKeeper
 	SupplyKeeper                       = types.SupplyKeeper
 	FungibleTokenPacketData            = types.FungibleTokenPacketData
+	RefundStuckPacketProposal          = types.RefundStuckPacketProposal
+	UpdateParamsProposal               = types.UpdateParamsProposal
 	FungibleTokenPacketAcknowledgement = types.FungibleTokenPacketAcknowledgement
 	MsgTransfer                        = types.MsgTransfer
+	MsgTransferNFT                     = types.MsgTransferNFT
+	NonFungibleTokenPacketData         = types.NonFungibleTokenPacketData
+	NFTKeeper                          = types.NFTKeeper
+	PostReceiveHook                    = types.PostReceiveHook
+	TransferOutput                     = types.TransferOutput
+	TransferFee                        = types.TransferFee
+	MsgCancelTransfer                  = types.MsgCancelTransfer
+	MsgMultiTransfer                   = types.MsgMultiTransfer
+	DenomTrace                         = types.DenomTrace
+	QueryDenomTraceParams              = types.QueryDenomTraceParams
+	QueryDenomTracesParams             = types.QueryDenomTracesParams
+	QueryDenomTraceByPathParams        = types.QueryDenomTraceByPathParams
+	DenomTraceByPathResponse           = types.DenomTraceByPathResponse
+	QueryVerifyDenomTraceParams        = types.QueryVerifyDenomTraceParams
+	VerifyDenomTraceResponse           = types.VerifyDenomTraceResponse
+	QueryServer                        = types.QueryServer
+	MsgUpdateParams                    = types.MsgUpdateParams
+	QueryClient                        = types.QueryClient
+	QueryDenomTraceRequest             = types.QueryDenomTraceRequest
+	QueryDenomTraceResponse            = types.QueryDenomTraceResponse
+	QueryDenomTracesRequest            = types.QueryDenomTracesRequest
+	QueryDenomTracesResponse           = types.QueryDenomTracesResponse
+	QueryParamsRequest                 = types.QueryParamsRequest
+	QueryParamsResponse                = types.QueryParamsResponse
+	RateLimit                          = types.RateLimit
+	GenesisState                       = types.GenesisState
+	QueryRateLimitParams               = types.QueryRateLimitParams
+	RateLimitResponse                  = types.RateLimitResponse
+	QueryTotalEscrowParams             = types.QueryTotalEscrowParams
+	TotalEscrowResponse                = types.TotalEscrowResponse
+	QuerySimulateTransferParams        = types.QuerySimulateTransferParams
+	SimulateTransferResponse           = types.SimulateTransferResponse
+	PacketEncoding                     = types.PacketEncoding
+	ForwardMemo                        = types.ForwardMemo
+	ForwardMetadata                    = types.ForwardMetadata
+	ForwardRecord                      = types.ForwardRecord
+	Fee                                = types.Fee
+	PacketFee                          = types.PacketFee
+	IdentifiedPacketFees               = types.IdentifiedPacketFees
+	MsgPayPacketFee                    = types.MsgPayPacketFee
+	QueryPacketFeesParams              = types.QueryPacketFeesParams
+	QueryTransferChannelsParams        = types.QueryTransferChannelsParams
+	TransferChannel                    = types.TransferChannel
+	EscrowAddressVersion               = types.EscrowAddressVersion
+	ChannelEscrowAddressVersion        = types.ChannelEscrowAddressVersion
+	QueryEscrowAddressParams           = types.QueryEscrowAddressParams
+	EscrowAddressResponse              = types.EscrowAddressResponse
+	QueryEscrowAddressesParams         = types.QueryEscrowAddressesParams
+	ChannelEscrowAddress               = types.ChannelEscrowAddress
+	EscrowAddressesResponse            = types.EscrowAddressesResponse
+	PacketState                        = types.PacketState
+	SentCoins                          = types.SentCoins
+	QueryPacketStateParams             = types.QueryPacketStateParams
+	PacketStateResponse                = types.PacketStateResponse
+	DenomEnabled                       = types.DenomEnabled
+	Params                             = types.Params
+	ChannelEscrowBalance               = types.ChannelEscrowBalance
+	EscrowSnapshot                     = types.EscrowSnapshot
+	QueryEscrowSnapshotParams          = types.QueryEscrowSnapshotParams
+	QueryChannelClientStateParams      = types.QueryChannelClientStateParams
+	ChannelClientState                 = types.ChannelClientState
 )
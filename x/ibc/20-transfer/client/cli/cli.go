@@ -16,6 +16,22 @@ func GetQueryCmd(cdc *codec.Codec, queryRoute string) *cobra.Command {
 
 	ics20TransferQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryNextSequence(cdc, queryRoute),
+		GetCmdQueryDenomTrace(cdc, queryRoute),
+		GetCmdQueryDenomTraces(cdc, queryRoute),
+		GetCmdQueryDenomTraceByPath(cdc, queryRoute),
+		GetCmdQueryVerifyDenomTrace(cdc, queryRoute),
+		GetCmdQueryRateLimit(cdc, queryRoute),
+		GetCmdQueryEscrowBalances(cdc, queryRoute),
+		GetCmdQueryEscrowAddresses(cdc, queryRoute),
+		GetCmdQueryDenomEscrow(cdc, queryRoute),
+		GetCmdQueryTotalEscrow(cdc, queryRoute),
+		GetCmdQueryPacketFees(cdc, queryRoute),
+		GetCmdQueryTransferChannels(cdc, queryRoute),
+		GetCmdQuerySendDenylist(cdc, queryRoute),
+		GetCmdQueryPacketState(cdc, queryRoute),
+		GetCmdQueryEscrowSnapshot(cdc, queryRoute),
+		GetCmdQueryChannelClientState(cdc, queryRoute),
+		GetCmdQueryParams(cdc, queryRoute),
 	)...)
 
 	return ics20TransferQueryCmd
@@ -30,6 +46,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	ics20TransferTxCmd.AddCommand(flags.PostCommands(
 		GetTransferTxCmd(cdc),
+		GetPayPacketFeeTxCmd(cdc),
 	)...)
 
 	return ics20TransferTxCmd
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 )
 
 // GetCmdQueryNextSequence defines the command to query a next receive sequence
@@ -45,3 +48,581 @@ $ %s query ibc channel next-recv [port-id] [channel-id]
 
 	return cmd
 }
+
+// GetCmdQueryDenomTrace defines the command to query a denomination trace from
+// a given hash.
+func GetCmdQueryDenomTrace(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-trace [hash]",
+		Short: "Query the denom trace info from a given trace hash",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the full path and base denomination of an IBC voucher
+from the hash of its denomination trace (with or without the ibc/ prefix).
+
+Example:
+$ %s query ibc transfer denom-trace [hash]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer denom-trace [hash]", version.ClientName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denomTrace, height, err := utils.QueryDenomTrace(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(denomTrace)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryDenomTraces defines the command to query all the denomination
+// traces that this chain mantains.
+func GetCmdQueryDenomTraces(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-traces",
+		Short: "Query the trace info for all token denominations",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the trace info for all the IBC vouchers denominations
+
+Example:
+$ %s query ibc transfer denom-traces
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer denom-traces", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			denomTraces, height, err := utils.QueryDenomTraces(cliCtx, queryRoute, page, limit)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(denomTraces)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of denomination traces to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of denomination traces to query for")
+	return cmd
+}
+
+// GetCmdQueryDenomTraceByPath defines the command to query a denomination trace
+// from its path and base denomination.
+func GetCmdQueryDenomTraceByPath(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-trace-by-path [path] [base-denom]",
+		Short: "Query the denom trace info and hash from a given trace path and base denomination",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the denomination trace of an IBC voucher and the hash
+under which it is stored from its path of port and channel identifiers and its
+base denomination.
+
+Example:
+$ %s query ibc transfer denom-trace-by-path transfer/channelidone/transfer/channelidtwo uatom
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer denom-trace-by-path [path] [base-denom]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denomTraceRes, height, err := utils.QueryDenomTraceByPath(cliCtx, queryRoute, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(denomTraceRes)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryVerifyDenomTrace defines the command to verify that a denomination
+// trace hash matches a path and base denomination.
+func GetCmdQueryVerifyDenomTrace(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-denom-trace [hash] [path] [base-denom]",
+		Short: "Verify that a denom trace hash matches a given trace path and base denomination",
+		Long: strings.TrimSpace(fmt.Sprintf(`Verify that the hash of an IBC voucher denomination, with or
+without the ibc/ prefix, matches a path of port and channel identifiers and a
+base denomination. The hash is recomputed from the arguments, the stored
+denomination traces are not read.
+
+Example:
+$ %s query ibc transfer verify-denom-trace ibc/5DF315D7C8ECB6E1CFFAAB4088FFE54736BD8AF0628241816DEB4E7E409F9562 transfer/channelidone uatom
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer verify-denom-trace [hash] [path] [base-denom]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			verifyRes, err := utils.QueryVerifyDenomTrace(cliCtx, queryRoute, args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(verifyRes)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryRateLimit defines the command to query the outbound rate limit of
+// a denomination on a channel.
+func GetCmdQueryRateLimit(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit [port-id] [channel-id] [denom]",
+		Short: "Query the outbound rate limit of a denomination on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the outbound quota of a denomination on a channel and
+the amount that can still be sent during the current window.
+
+Example:
+$ %s query ibc transfer rate-limit [port-id] [channel-id] [denom]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer rate-limit [port-id] [channel-id] [denom]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rateLimit, height, err := utils.QueryRateLimit(cliCtx, queryRoute, args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(rateLimit)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQuerySendDenylist defines the command to query the denominations that
+// can't be transferred out of the chain.
+func GetCmdQuerySendDenylist(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-denylist",
+		Short: "Query the denominations that can't be transferred out of the chain",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the denominations that can't be transferred out of the
+chain through any channel. IBC vouchers are listed with their hashed denomination.
+
+Example:
+$ %s query ibc transfer send-denylist
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer send-denylist", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denylist, height, err := utils.QuerySendDenylist(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(denylist)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryParams defines the command to query the parameters of the IBC
+// transfer module.
+func GetCmdQueryParams(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the IBC transfer module",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the parameters of the IBC transfer module, which define
+whether the transfers out of and into the chain are enabled, along with the
+denominations overriding them. IBC vouchers are listed with their hashed
+denomination.
+
+Example:
+$ %s query ibc transfer params
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer params", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, height, err := utils.QueryParams(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryPacketFees defines the command to query the relayer fees escrowed
+// for the packets in flight of a channel.
+func GetCmdQueryPacketFees(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-fees [port-id] [channel-id]",
+		Short: "Query the relayer fees escrowed for the packets in flight of a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the recv, ack and timeout fees escrowed for the packets sent through a
+channel that haven't been acknowledged or timed out yet.
+
+Example:
+$ %s query ibc transfer packet-fees [port-id] [channel-id]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer packet-fees [port-id] [channel-id]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			packetFees, height, err := utils.QueryPacketFees(cliCtx, queryRoute, args[0], args[1], page, limit)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(packetFees)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of packet fees to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of packet fees to query for")
+	return cmd
+}
+
+// GetCmdQueryTransferChannels defines the command to query the channels of the
+// port bound by the transfer module.
+func GetCmdQueryTransferChannels(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channels",
+		Short: "Query the channels of the transfer port",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the identifiers, states and counterparties of the channels of the port
+bound by the transfer module. The channels are printed as a table unless the
+output is JSON.
+
+Example:
+$ %s query ibc transfer channels
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer channels", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			channels, height, err := utils.QueryTransferChannels(cliCtx, queryRoute, page, limit)
+			if err != nil {
+				return err
+			}
+
+			if cliCtx.OutputFormat == "json" {
+				cliCtx = cliCtx.WithHeight(height)
+				return cliCtx.PrintOutput(channels)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CHANNEL\tSTATE\tCOUNTERPARTY PORT\tCOUNTERPARTY CHANNEL")
+			for _, ch := range channels {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ch.ChannelID, ch.State, ch.Counterparty.PortID, ch.Counterparty.ChannelID)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of channels to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of channels to query for")
+	return cmd
+}
+
+// GetCmdQueryEscrowBalances defines the command to query the balances held by
+// the escrow account of a channel.
+func GetCmdQueryEscrowBalances(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow [port-id] [channel-id]",
+		Short: "Query the tokens escrowed on a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the balances of the escrow account of a channel, which
+holds the native tokens sent through it. Use the --%s flag to query a single
+denomination.
+
+Example:
+$ %s query ibc transfer escrow [port-id] [channel-id]
+		`, FlagDenom, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer escrow [port-id] [channel-id] --%s [denom]", version.ClientName, FlagDenom),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			denom := viper.GetString(FlagDenom)
+
+			balances, height, err := utils.QueryEscrowBalances(cliCtx, queryRoute, args[0], args[1], denom)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(balances)
+		},
+	}
+	cmd.Flags().String(FlagDenom, "", "the denomination to query the escrowed balance for")
+
+	return cmd
+}
+
+// GetCmdQueryEscrowAddresses defines the command to query the escrow addresses
+// of all the transfer channels.
+func GetCmdQueryEscrowAddresses(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-addresses",
+		Short: "Query the escrow addresses of all the transfer channels",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the addresses controlled by the transfer module for each
+of its channels: the escrow address of the tokens sent through the channel and
+the address escrowing the relayer fees of its packets. The channels are queried
+by pages of --%s channels. Use the --%s flag to query a single page.
+
+Example:
+$ %s query ibc transfer escrow-addresses
+		`, flags.FlagLimit, flags.FlagPage, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer escrow-addresses", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			var (
+				escrowAddresses types.EscrowAddressesResponse
+				height          int64
+				err             error
+			)
+
+			if cmd.Flags().Changed(flags.FlagPage) {
+				escrowAddresses, height, err = utils.QueryEscrowAddressesPage(cliCtx, queryRoute, viper.GetInt(flags.FlagPage), limit)
+			} else {
+				escrowAddresses, height, err = utils.QueryEscrowAddresses(cliCtx, queryRoute, limit)
+			}
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(escrowAddresses)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of channels to query the escrow addresses of")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of channels to query for")
+	return cmd
+}
+
+// GetCmdQueryDenomEscrow defines the command to query the counterparty escrow
+// account of an IBC voucher from its denomination trace hash.
+func GetCmdQueryDenomEscrow(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-escrow [hash]",
+		Short: "Query the counterparty escrow account holding the base denomination of an IBC voucher",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the port and channel identifiers of the first hop of the
+denomination trace of an IBC voucher, from the hash of the trace (with or without
+the ibc/ prefix), along with their counterparty and the address of the escrow
+account holding the base denomination on the counterparty chain. The balance must
+be queried on the counterparty chain. Native denominations have no escrow account.
+
+Example:
+$ %s query ibc transfer denom-escrow [hash]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer denom-escrow [hash]", version.ClientName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denomEscrow, height, err := utils.QueryDenomEscrow(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(denomEscrow)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryPacketState defines the command to query the lifecycle state of a
+// packet.
+func GetCmdQueryPacketState(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-state [port-id] [channel-id] [sequence]",
+		Short: "Query the lifecycle state of a packet",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query whether the packet with the given sequence on a channel end
+is in flight or was received, along with the height at which a packet in flight
+was sent. The state is unknown if the packet isn't in flight and wasn't
+received, which includes the packets that were acknowledged or timed out.
+
+Example:
+$ %s query ibc transfer packet-state [port-id] [channel-id] [sequence]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer packet-state [port-id] [channel-id] [sequence]", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			packetState, height, err := utils.QueryPacketState(cliCtx, queryRoute, args[0], args[1], sequence)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(packetState)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryEscrowSnapshot defines the command to query a snapshot of the
+// escrow balances of the transfer channels.
+func GetCmdQueryEscrowSnapshot(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-snapshot [height]",
+		Short: "Query the snapshot of the escrow balances taken at or before a height",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the latest snapshot of the balances held by the escrow
+accounts of the transfer channels taken at or before the given height. The
+snapshots are taken every escrow_snapshot_interval blocks, when the parameter is
+set.
+
+Example:
+$ %s query ibc transfer escrow-snapshot [height]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer escrow-snapshot [height]", version.ClientName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			snapshotHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			snapshot, height, err := utils.QueryEscrowSnapshot(cliCtx, queryRoute, snapshotHeight)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(snapshot)
+		},
+	}
+
+	return cmd
+}
+
+// channelClientInfo is the output of the channel-client-state command, which
+// leaves out the full client state.
+type channelClientInfo struct {
+	ConnectionHops []string `json:"connection_hops" yaml:"connection_hops"`
+	ClientID       string   `json:"client_id" yaml:"client_id"`
+	LatestHeight   uint64   `json:"latest_height" yaml:"latest_height"`
+}
+
+// GetCmdQueryChannelClientState defines the command to query the client backing
+// a transfer channel.
+func GetCmdQueryChannelClientState(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-client-state [port-id] [channel-id]",
+		Short: "Query the client backing a channel",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the identifier and the latest height of the client backing
+a channel through its connection, along with the connection hops of the
+channel, e.g to check the client the packet proofs are verified against.
+
+Example:
+$ %s query ibc transfer channel-client-state [port-id] [channel-id]
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer channel-client-state [port-id] [channel-id]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			channelClientState, height, err := utils.QueryChannelClientState(cliCtx, queryRoute, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(channelClientInfo{
+				ConnectionHops: channelClientState.ConnectionHops,
+				ClientID:       channelClientState.ClientID,
+				LatestHeight:   channelClientState.LatestHeight,
+			})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTotalEscrow defines the command to query the total amount of a
+// denomination escrowed by all the transfer channels.
+func GetCmdQueryTotalEscrow(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-escrow [denom]",
+		Short: "Query the total amount of a denomination escrowed by all the channels",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the sum of the balances of a denomination held by the
+escrow accounts of all the transfer channels. The channels are queried by pages
+of --%s channels. Use the --%s flag to query the amount escrowed by a single page.
+
+Example:
+$ %s query ibc transfer total-escrow [denom]
+		`, flags.FlagLimit, flags.FlagPage, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc transfer total-escrow [denom]", version.ClientName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			var (
+				totalEscrow types.TotalEscrowResponse
+				height      int64
+				err         error
+			)
+
+			if cmd.Flags().Changed(flags.FlagPage) {
+				totalEscrow, height, err = utils.QueryTotalEscrowPage(cliCtx, queryRoute, args[0], viper.GetInt(flags.FlagPage), limit)
+			} else {
+				totalEscrow, height, err = utils.QueryTotalEscrow(cliCtx, queryRoute, args[0], limit)
+			}
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(totalEscrow)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of channels to sum the escrowed amount of")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of channels to query for")
+	return cmd
+}
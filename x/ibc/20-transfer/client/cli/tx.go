@@ -2,17 +2,25 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
+	"io"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // IBC transfer flags
@@ -24,14 +32,69 @@ var (
 	FlagChainID2 = "chain-id2"
 	FlagSequence = "packet-sequence"
 	FlagTimeout  = "timeout"
+
+	FlagTimeoutHeight    = "timeout-height"
+	FlagTimeoutOffset    = "timeout-offset"
+	FlagTimeoutTimestamp = "timeout-timestamp"
+	FlagMemo             = "memo"
+	FlagDenom            = "denom"
+	FlagRecvFee          = "recv-fee"
+	FlagAckFee           = "ack-fee"
+	FlagTimeoutFee       = "timeout-fee"
+	FlagDestPrefix       = "dest-prefix"
+	FlagRefundAddress    = "refund-address"
+	FlagTransferFee      = "transfer-fee"
+	FlagFeeRecipient     = "transfer-fee-recipient"
+	FlagMinReceived      = "min-received"
 )
 
+// maxDestPrefixLength is the maximum length of the address prefix of the
+// destination chain, i.e the maximum length of a bech32 human-readable part
+const maxDestPrefixLength = 83
+
+// validateDestPrefix checks that the receiver starts with the given address
+// prefix of the destination chain. The receiver isn't decoded, as the address
+// format of the destination chain is unknown to this chain. A warning is written
+// to out if the prefix is the account address prefix of this chain.
+func validateDestPrefix(out io.Writer, receiver, destPrefix string) error {
+	if len(destPrefix) > maxDestPrefixLength {
+		return fmt.Errorf("destination chain prefix %s is longer than %d characters", destPrefix, maxDestPrefixLength)
+	}
+	if len(receiver) <= len(destPrefix) || !strings.HasPrefix(receiver, destPrefix) {
+		return fmt.Errorf("receiver %s doesn't have the destination chain prefix %s", receiver, destPrefix)
+	}
+
+	if destPrefix == sdk.GetConfig().GetBech32AccountAddrPrefix() {
+		_, _ = fmt.Fprintf(out, "warning: %s is the address prefix of this chain\n", destPrefix)
+	}
+	return nil
+}
+
 // GetTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func GetTransferTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer [src-port] [src-channel] [dest-height] [receiver] [amount]",
+		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
 		Short: "Transfer fungible token through IBC",
-		Args:  cobra.ExactArgs(5),
+		Long: strings.TrimSpace(`Transfer fungible tokens to a receiver on the chain at the other end of the
+given channel. The receiver is passed through as is, since it's an address of the
+destination chain: use --dest-prefix to check it against the address prefix of
+the destination chain instead of the local one. At least one of the timeout height or timeout timestamp of the
+destination chain must be provided. Alternatively, the timeout height can be
+computed by adding an offset to the latest height of the destination chain
+known by the channel's client.
+
+Whether the tokens are escrowed or burned is determined from their denomination:
+native tokens are escrowed, while vouchers that are sent back through the channel
+they were received on are burned.
+
+With --dry-run, the transfer is simulated instead of broadcasted: the packet that
+would be sent and the coins credited on the destination chain (i.e with their
+voucher denomination) are printed along with the estimated gas.`),
+		Example: fmt.Sprintf(
+			"%s tx ibc transfer transfer [src-port] [src-channel] [receiver] [amount] --%s 1000",
+			version.ClientName, FlagTimeoutHeight,
+		),
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
@@ -40,18 +103,133 @@ func GetTransferTxCmd(cdc *codec.Codec) *cobra.Command {
 			sender := cliCtx.GetFromAddress()
 			srcPort := args[0]
 			srcChannel := args[1]
-			destHeight, err := strconv.Atoi(args[2])
-			if err != nil {
-				return err
+			receiver := args[2]
+
+			if destPrefix := viper.GetString(FlagDestPrefix); destPrefix != "" {
+				if err := validateDestPrefix(cmd.ErrOrStderr(), receiver, destPrefix); err != nil {
+					return err
+				}
 			}
 
 			// parse coin trying to be sent
-			coins, err := sdk.ParseCoins(args[4])
+			coins, err := sdk.ParseCoins(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q, expected coins (eg: 100atom,50stake): %w", args[3], err)
+			}
+
+			timeoutHeight, err := ibctypes.ParseHeight(viper.GetString(FlagTimeoutHeight))
 			if err != nil {
+				return fmt.Errorf("invalid timeout height %q, expected {revision number}-{revision height} (eg: 1-1000): %w", viper.GetString(FlagTimeoutHeight), err)
+			}
+			if cmd.Flags().Changed(FlagTimeoutOffset) {
+				if cmd.Flags().Changed(FlagTimeoutHeight) {
+					return fmt.Errorf("flags --%s and --%s are mutually exclusive", FlagTimeoutHeight, FlagTimeoutOffset)
+				}
+
+				timeoutHeight, err = utils.QueryTimeoutHeight(cliCtx, srcPort, srcChannel, viper.GetUint64(FlagTimeoutOffset))
+				if err != nil {
+					return fmt.Errorf("failed to compute the timeout height: %w", err)
+				}
+			}
+
+			timeoutTimestamp := viper.GetUint64(FlagTimeoutTimestamp)
+			memo := viper.GetString(FlagMemo)
+
+			msg := types.NewMsgTransfer(srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo)
+			if refundAddress := viper.GetString(FlagRefundAddress); refundAddress != "" {
+				msg.RefundAddress, err = sdk.AccAddressFromBech32(refundAddress)
+				if err != nil {
+					return fmt.Errorf("invalid refund address %q: %w", refundAddress, err)
+				}
+			}
+			if fee := viper.GetString(FlagTransferFee); fee != "" {
+				feeAmount, err := sdk.ParseCoin(fee)
+				if err != nil {
+					return fmt.Errorf("invalid transfer fee %q: %w", fee, err)
+				}
+				feeRecipient, err := sdk.AccAddressFromBech32(viper.GetString(FlagFeeRecipient))
+				if err != nil {
+					return fmt.Errorf("invalid transfer fee recipient %q: %w", viper.GetString(FlagFeeRecipient), err)
+				}
+				msg.TransferFee = types.NewTransferFee(feeAmount, feeRecipient)
+			}
+			if minReceived := viper.GetString(FlagMinReceived); minReceived != "" {
+				msg.MinReceived, err = sdk.ParseCoin(minReceived)
+				if err != nil {
+					return fmt.Errorf("invalid minimum received amount %q: %w", minReceived, err)
+				}
+			}
+			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			msg := types.NewMsgTransfer(srcPort, srcChannel, uint64(destHeight), coins, sender, args[3])
+			if cliCtx.Simulate {
+				simulation, height, err := utils.QuerySimulateTransfer(cliCtx, types.QuerierRoute, msg)
+				if err != nil {
+					return fmt.Errorf("failed to simulate the transfer: %w", err)
+				}
+
+				if err := cliCtx.WithHeight(height).PrintOutput(simulation); err != nil {
+					return err
+				}
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(
+		FlagTimeoutHeight, "0",
+		"timeout height of the destination chain after which the packet times out, formatted as {revision number}-{revision height} (a single number is a height without revision)",
+	)
+	cmd.Flags().Uint64(
+		FlagTimeoutOffset, 0,
+		fmt.Sprintf("number of blocks (eg: %d) added to the latest height of the destination chain to compute the timeout height, cannot be used with --%s", keeper.DefaultPacketTimeout, FlagTimeoutHeight),
+	)
+	cmd.Flags().Uint64(FlagTimeoutTimestamp, 0, "timeout timestamp (in nanoseconds) of the destination chain after which the packet times out")
+	cmd.Flags().String(FlagMemo, "", "optional memo relayed to the destination chain with the packet data")
+	cmd.Flags().String(FlagDestPrefix, "", "account address prefix of the destination chain (eg: osmo) the receiver must start with")
+	cmd.Flags().String(FlagRefundAddress, "", "optional address the tokens are refunded to on a timeout or a failed transfer, defaults to the sender")
+	cmd.Flags().String(FlagTransferFee, "", fmt.Sprintf("optional fee (eg: 10stake) deducted from the amount and paid to the --%s address", FlagFeeRecipient))
+	cmd.Flags().String(FlagFeeRecipient, "", "address the transfer fee is paid to")
+	cmd.Flags().String(FlagMinReceived, "", "optional minimum amount (eg: 90stake) of the sent denomination the receiver must be credited with, the tokens are refunded otherwise")
+	return cmd
+}
+
+// GetPayPacketFeeTxCmd returns the command to create a MsgPayPacketFee transaction
+func GetPayPacketFeeTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Pay the relayer fees of a packet in flight",
+		Long: strings.TrimSpace(`Escrow the fees paid to the relayers of a packet sent through a transfer channel
+that hasn't been acknowledged or timed out yet. The recv and ack fees are paid to
+the relayers of the packet and its acknowledgement, and the timeout fee to the
+relayer of its timeout. The unused fees are refunded to the sender.`),
+		Example: fmt.Sprintf(
+			"%s tx ibc transfer pay-packet-fee [src-port] [src-channel] [sequence] --%s 10stake --%s 10stake --%s 10stake",
+			version.ClientName, FlagRecvFee, FlagAckFee, FlagTimeoutFee,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc).WithBroadcastMode(flags.BroadcastBlock)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid packet sequence %q: %w", args[2], err)
+			}
+
+			var fees [3]sdk.Coins
+			for i, flag := range []string{FlagRecvFee, FlagAckFee, FlagTimeoutFee} {
+				fees[i], err = sdk.ParseCoins(viper.GetString(flag))
+				if err != nil {
+					return fmt.Errorf("invalid --%s, expected coins (eg: 10stake): %w", flag, err)
+				}
+			}
+
+			fee := types.NewFee(fees[0], fees[1], fees[2])
+			msg := types.NewMsgPayPacketFee(args[0], args[1], sequence, fee, cliCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -59,5 +237,9 @@ func GetTransferTxCmd(cdc *codec.Codec) *cobra.Command {
 			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(FlagRecvFee, "", "fee paid to the relayer of the packet")
+	cmd.Flags().String(FlagAckFee, "", "fee paid to the relayer of the packet acknowledgement")
+	cmd.Flags().String(FlagTimeoutFee, "", "fee paid to the relayer of the packet timeout")
 	return cmd
 }
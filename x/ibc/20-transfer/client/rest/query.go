@@ -10,10 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/ibc/ports/{%s}/channels/{%s}/next-sequence-recv", RestPortID, RestChannelID), queryNextSequenceRecvHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/ibc/transfer/denom_traces/{%s}", RestDenomTraceHash), queryDenomTraceHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/ibc/transfer/denom_traces", queryDenomTracesHandlerFn(cliCtx)).Methods("GET")
 }
 
 // queryNextSequenceRecvHandlerFn implements a next sequence receive querying route
@@ -49,3 +52,70 @@ func queryNextSequenceRecvHandlerFn(cliCtx context.CLIContext) http.HandlerFunc
 		rest.PostProcessResponse(w, cliCtx, sequenceRes)
 	}
 }
+
+// queryDenomTraceHandlerFn implements a denomination trace querying route
+//
+// @Summary Query a denomination trace
+// @Tags IBC
+// @Produce  json
+// @Param hash path string true "Denomination trace hash"
+// @Success 200 {object} QueryDenomTrace "OK"
+// @Failure 400 {object} rest.ErrorResponse "Invalid height"
+// @Failure 404 {object} rest.ErrorResponse "Denomination trace not found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /ibc/transfer/denom_traces/{hash} [get]
+func queryDenomTraceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		hash := vars[RestDenomTraceHash]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		denomTrace, height, err := utils.QueryDenomTrace(cliCtx, types.QuerierRoute, hash)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, denomTrace)
+	}
+}
+
+// queryDenomTracesHandlerFn implements a denomination traces querying route
+//
+// @Summary Query all denomination traces
+// @Tags IBC
+// @Produce  json
+// @Param page query int false "The page number to query" default(1)
+// @Param limit query int false "The number of results per page" default(100)
+// @Success 200 {object} QueryDenomTraces "OK"
+// @Failure 400 {object} rest.ErrorResponse "Invalid pagination or height"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /ibc/transfer/denom_traces [get]
+func queryDenomTracesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 100)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		denomTraces, height, err := utils.QueryDenomTraces(cliCtx, types.QuerierRoute, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, denomTraces)
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 const (
-	RestChannelID = "channel-id"
-	RestPortID    = "port-id"
+	RestChannelID      = "channel-id"
+	RestPortID         = "port-id"
+	RestDenomTraceHash = "hash"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -21,8 +23,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 // TransferTxReq defines the properties of a transfer tx request's body.
 type TransferTxReq struct {
-	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
-	DestHeight uint64       `json:"dest_height" yaml:"dest_height"`
-	Amount     sdk.Coins    `json:"amount" yaml:"amount"`
-	Receiver   string       `json:"receiver" yaml:"receiver"`
+	BaseReq          rest.BaseReq    `json:"base_req" yaml:"base_req"`
+	Amount           sdk.Coins       `json:"amount" yaml:"amount"`
+	Receiver         string          `json:"receiver" yaml:"receiver"`
+	TimeoutHeight    ibctypes.Height `json:"timeout_height" yaml:"timeout_height"`
+	TimeoutTimestamp uint64          `json:"timeout_timestamp" yaml:"timeout_timestamp"`
+	Memo             string          `json:"memo" yaml:"memo"`
 }
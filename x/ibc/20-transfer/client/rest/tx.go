@@ -56,10 +56,12 @@ func transferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgTransfer(
 			portID,
 			channelID,
-			req.DestHeight,
 			req.Amount,
 			fromAddr,
 			req.Receiver,
+			req.TimeoutHeight,
+			req.TimeoutTimestamp,
+			req.Memo,
 		)
 
 		if err := msg.ValidateBasic(); err != nil {
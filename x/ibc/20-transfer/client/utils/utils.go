@@ -2,11 +2,18 @@ package utils
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clientutils "github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	connectionutils "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/client/utils"
+	channelutils "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/client/utils"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
@@ -31,3 +38,552 @@ func QueryNextSequenceRecv(
 
 	return sequenceRes, nil
 }
+
+// QueryDenomTrace returns the denomination trace stored for the given hash. It
+// _does not_ return any merkle proof.
+func QueryDenomTrace(cliCtx context.CLIContext, queryRoute, hash string) (types.DenomTrace, int64, error) {
+	params := types.NewQueryDenomTraceParams(hash)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.DenomTrace{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomTrace)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.DenomTrace{}, 0, err
+	}
+
+	var denomTrace types.DenomTrace
+	err = cliCtx.Codec.UnmarshalJSON(res, &denomTrace)
+	if err != nil {
+		return types.DenomTrace{}, 0, fmt.Errorf("failed to unmarshal denomination trace: %w", err)
+	}
+	return denomTrace, height, nil
+}
+
+// QuerySimulateTransfer returns the packet that the given transfer would send
+// and the coins it would credit on the destination chain, without broadcasting
+// it. It _does not_ return any merkle proof.
+func QuerySimulateTransfer(
+	cliCtx context.CLIContext, queryRoute string, msg types.MsgTransfer,
+) (types.SimulateTransferResponse, int64, error) {
+	params := types.NewQuerySimulateTransferParams(msg)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.SimulateTransferResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySimulateTransfer)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.SimulateTransferResponse{}, 0, err
+	}
+
+	var simulation types.SimulateTransferResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &simulation)
+	if err != nil {
+		return types.SimulateTransferResponse{}, 0, fmt.Errorf("failed to unmarshal transfer simulation: %w", err)
+	}
+	return simulation, height, nil
+}
+
+// QueryDenomTraceByPath returns the denomination trace stored for the given
+// path and base denomination, along with its hash. It _does not_ return any
+// merkle proof.
+func QueryDenomTraceByPath(cliCtx context.CLIContext, queryRoute, path, baseDenom string) (types.DenomTraceByPathResponse, int64, error) {
+	params := types.NewQueryDenomTraceByPathParams(path, baseDenom)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.DenomTraceByPathResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomTraceByPath)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.DenomTraceByPathResponse{}, 0, err
+	}
+
+	var denomTraceRes types.DenomTraceByPathResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &denomTraceRes)
+	if err != nil {
+		return types.DenomTraceByPathResponse{}, 0, fmt.Errorf("failed to unmarshal denomination trace: %w", err)
+	}
+
+	return denomTraceRes, height, nil
+}
+
+// QueryVerifyDenomTrace verifies that the given denomination trace hash matches
+// the path and base denomination. The verification doesn't depend on the chain
+// state.
+func QueryVerifyDenomTrace(cliCtx context.CLIContext, queryRoute, hash, path, baseDenom string) (types.VerifyDenomTraceResponse, error) {
+	params := types.NewQueryVerifyDenomTraceParams(hash, path, baseDenom)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.VerifyDenomTraceResponse{}, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVerifyDenomTrace)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.VerifyDenomTraceResponse{}, err
+	}
+
+	var verifyRes types.VerifyDenomTraceResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &verifyRes)
+	if err != nil {
+		return types.VerifyDenomTraceResponse{}, fmt.Errorf("failed to unmarshal denomination trace verification: %w", err)
+	}
+
+	return verifyRes, nil
+}
+
+// QueryDenomTraces returns all the denomination traces. It _does not_ return
+// any merkle proof.
+func QueryDenomTraces(cliCtx context.CLIContext, queryRoute string, page, limit int) ([]types.DenomTrace, int64, error) {
+	params := types.NewQueryDenomTracesParams(page, limit)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomTraces)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var denomTraces []types.DenomTrace
+	err = cliCtx.Codec.UnmarshalJSON(res, &denomTraces)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal denomination traces: %w", err)
+	}
+	return denomTraces, height, nil
+}
+
+// QueryPacketFees returns the relayer fees escrowed for the packets in flight of
+// a channel. It _does not_ return any merkle proof.
+func QueryPacketFees(
+	cliCtx context.CLIContext, queryRoute, portID, channelID string, page, limit int,
+) ([]types.IdentifiedPacketFees, int64, error) {
+	params := types.NewQueryPacketFeesParams(portID, channelID, page, limit)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPacketFees)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var packetFees []types.IdentifiedPacketFees
+	err = cliCtx.Codec.UnmarshalJSON(res, &packetFees)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal packet fees: %w", err)
+	}
+	return packetFees, height, nil
+}
+
+// QueryTransferChannels returns the channels of the port bound by the transfer
+// module. It _does not_ return any merkle proof.
+func QueryTransferChannels(cliCtx context.CLIContext, queryRoute string, page, limit int) ([]types.TransferChannel, int64, error) {
+	params := types.NewQueryTransferChannelsParams(page, limit)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTransferChannels)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var channels []types.TransferChannel
+	err = cliCtx.Codec.UnmarshalJSON(res, &channels)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal transfer channels: %w", err)
+	}
+	return channels, height, nil
+}
+
+// QuerySendDenylist returns the denominations that can't be transferred out of
+// the chain. It _does not_ return any merkle proof.
+func QuerySendDenylist(cliCtx context.CLIContext, queryRoute string) ([]string, int64, error) {
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySendDenylist)
+	res, height, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var denylist []string
+	err = cliCtx.Codec.UnmarshalJSON(res, &denylist)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal send denylist: %w", err)
+	}
+	return denylist, height, nil
+}
+
+// QueryParams returns the parameters of the IBC transfer module. It _does not_
+// return any merkle proof.
+func QueryParams(cliCtx context.CLIContext, queryRoute string) (types.Params, int64, error) {
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams)
+	res, height, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return types.Params{}, 0, err
+	}
+
+	var params types.Params
+	err = cliCtx.Codec.UnmarshalJSON(res, &params)
+	if err != nil {
+		return types.Params{}, 0, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+	return params, height, nil
+}
+
+// QueryRateLimit returns the outbound rate limit of a denomination on a channel
+// along with its remaining quota. It _does not_ return any merkle proof.
+func QueryRateLimit(
+	cliCtx context.CLIContext, queryRoute, portID, channelID, denom string,
+) (types.RateLimitResponse, int64, error) {
+	params := types.NewQueryRateLimitParams(portID, channelID, denom)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.RateLimitResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRateLimit)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.RateLimitResponse{}, 0, err
+	}
+
+	var rateLimit types.RateLimitResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &rateLimit)
+	if err != nil {
+		return types.RateLimitResponse{}, 0, fmt.Errorf("failed to unmarshal rate limit: %w", err)
+	}
+	return rateLimit, height, nil
+}
+
+// QueryTotalEscrowPage returns the total amount of a denomination escrowed by
+// the given page of transfer channels. It _does not_ return any merkle proof.
+func QueryTotalEscrowPage(
+	cliCtx context.CLIContext, queryRoute, denom string, page, limit int,
+) (types.TotalEscrowResponse, int64, error) {
+	params := types.NewQueryTotalEscrowParams(denom, page, limit)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.TotalEscrowResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTotalEscrow)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.TotalEscrowResponse{}, 0, err
+	}
+
+	var totalEscrow types.TotalEscrowResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &totalEscrow)
+	if err != nil {
+		return types.TotalEscrowResponse{}, 0, fmt.Errorf("failed to unmarshal total escrow: %w", err)
+	}
+	return totalEscrow, height, nil
+}
+
+// QueryTotalEscrow returns the total amount of a denomination escrowed by all
+// the transfer channels. The channels are queried by pages of the given limit,
+// all of them at the height of the first page.
+func QueryTotalEscrow(
+	cliCtx context.CLIContext, queryRoute, denom string, limit int,
+) (types.TotalEscrowResponse, int64, error) {
+	totalEscrow, height, err := QueryTotalEscrowPage(cliCtx, queryRoute, denom, 1, limit)
+	if err != nil {
+		return types.TotalEscrowResponse{}, 0, err
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	for page := 2; totalEscrow.Channels < totalEscrow.TotalChannels; page++ {
+		pageEscrow, _, err := QueryTotalEscrowPage(cliCtx, queryRoute, denom, page, limit)
+		if err != nil {
+			return types.TotalEscrowResponse{}, 0, err
+		}
+		if pageEscrow.Channels == 0 {
+			break
+		}
+
+		totalEscrow.Amount = totalEscrow.Amount.Add(pageEscrow.Amount)
+		totalEscrow.Channels += pageEscrow.Channels
+	}
+
+	return totalEscrow, height, nil
+}
+
+// QueryEscrowAddress returns the escrow address of the given channel along with
+// the version of the derivation it uses. It _does not_ return any merkle proof.
+func QueryEscrowAddress(
+	cliCtx context.CLIContext, queryRoute, portID, channelID string,
+) (types.EscrowAddressResponse, int64, error) {
+	params := types.NewQueryEscrowAddressParams(portID, channelID)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.EscrowAddressResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEscrowAddress)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.EscrowAddressResponse{}, 0, err
+	}
+
+	var escrowAddress types.EscrowAddressResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &escrowAddress)
+	if err != nil {
+		return types.EscrowAddressResponse{}, 0, fmt.Errorf("failed to unmarshal escrow address: %w", err)
+	}
+	return escrowAddress, height, nil
+}
+
+// QueryEscrowAddressesPage returns the escrow addresses of the given page of
+// transfer channels. It _does not_ return any merkle proof.
+func QueryEscrowAddressesPage(
+	cliCtx context.CLIContext, queryRoute string, page, limit int,
+) (types.EscrowAddressesResponse, int64, error) {
+	params := types.NewQueryEscrowAddressesParams(page, limit)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.EscrowAddressesResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEscrowAddresses)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.EscrowAddressesResponse{}, 0, err
+	}
+
+	var escrowAddresses types.EscrowAddressesResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &escrowAddresses)
+	if err != nil {
+		return types.EscrowAddressesResponse{}, 0, fmt.Errorf("failed to unmarshal escrow addresses: %w", err)
+	}
+	return escrowAddresses, height, nil
+}
+
+// QueryEscrowAddresses returns the escrow addresses of all the transfer
+// channels. The channels are queried by pages of the given limit, all of them
+// at the height of the first page.
+func QueryEscrowAddresses(
+	cliCtx context.CLIContext, queryRoute string, limit int,
+) (types.EscrowAddressesResponse, int64, error) {
+	escrowAddresses, height, err := QueryEscrowAddressesPage(cliCtx, queryRoute, 1, limit)
+	if err != nil {
+		return types.EscrowAddressesResponse{}, 0, err
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	for page := 2; len(escrowAddresses.EscrowAddresses) < escrowAddresses.TotalChannels; page++ {
+		pageAddresses, _, err := QueryEscrowAddressesPage(cliCtx, queryRoute, page, limit)
+		if err != nil {
+			return types.EscrowAddressesResponse{}, 0, err
+		}
+		if len(pageAddresses.EscrowAddresses) == 0 {
+			break
+		}
+
+		escrowAddresses.EscrowAddresses = append(escrowAddresses.EscrowAddresses, pageAddresses.EscrowAddresses...)
+	}
+
+	return escrowAddresses, height, nil
+}
+
+// QueryDenomEscrow returns the counterparty escrow account holding the base
+// denomination of the voucher with the given denomination trace hash. It _does
+// not_ return any merkle proof.
+func QueryDenomEscrow(cliCtx context.CLIContext, queryRoute, hash string) (types.DenomEscrowResponse, int64, error) {
+	params := types.NewQueryDenomTraceParams(hash)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.DenomEscrowResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomEscrow)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.DenomEscrowResponse{}, 0, err
+	}
+
+	var denomEscrow types.DenomEscrowResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &denomEscrow)
+	if err != nil {
+		return types.DenomEscrowResponse{}, 0, fmt.Errorf("failed to unmarshal denomination escrow: %w", err)
+	}
+	return denomEscrow, height, nil
+}
+
+// QueryPacketState returns the lifecycle state of the packet with the given
+// sequence on a channel end. It _does not_ return any merkle proof.
+func QueryPacketState(
+	cliCtx context.CLIContext, queryRoute, portID, channelID string, sequence uint64,
+) (types.PacketStateResponse, int64, error) {
+	params := types.NewQueryPacketStateParams(portID, channelID, sequence)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.PacketStateResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPacketState)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.PacketStateResponse{}, 0, err
+	}
+
+	var packetState types.PacketStateResponse
+	err = cliCtx.Codec.UnmarshalJSON(res, &packetState)
+	if err != nil {
+		return types.PacketStateResponse{}, 0, fmt.Errorf("failed to unmarshal packet state: %w", err)
+	}
+	return packetState, height, nil
+}
+
+// QueryEscrowSnapshot returns the latest snapshot of the escrow balances of the
+// transfer channels taken at or before the given height. It _does not_ return
+// any merkle proof.
+func QueryEscrowSnapshot(cliCtx context.CLIContext, queryRoute string, height uint64) (types.EscrowSnapshot, int64, error) {
+	params := types.NewQueryEscrowSnapshotParams(height)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.EscrowSnapshot{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEscrowSnapshot)
+	res, queryHeight, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.EscrowSnapshot{}, 0, err
+	}
+
+	var snapshot types.EscrowSnapshot
+	err = cliCtx.Codec.UnmarshalJSON(res, &snapshot)
+	if err != nil {
+		return types.EscrowSnapshot{}, 0, fmt.Errorf("failed to unmarshal escrow snapshot: %w", err)
+	}
+	return snapshot, queryHeight, nil
+}
+
+// QueryChannelClientState returns the client backing the given channel through
+// its connection, along with the connection hops of the channel. It _does not_
+// return any merkle proof.
+func QueryChannelClientState(
+	cliCtx context.CLIContext, queryRoute, portID, channelID string,
+) (types.ChannelClientState, int64, error) {
+	params := types.NewQueryChannelClientStateParams(portID, channelID)
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return types.ChannelClientState{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryChannelClientState)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.ChannelClientState{}, 0, err
+	}
+
+	var channelClientState types.ChannelClientState
+	err = cliCtx.Codec.UnmarshalJSON(res, &channelClientState)
+	if err != nil {
+		return types.ChannelClientState{}, 0, fmt.Errorf("failed to unmarshal channel client state: %w", err)
+	}
+	return channelClientState, height, nil
+}
+
+// QueryEscrowBalances returns the balances held by the escrow account of the
+// given channel. If a denomination is provided, only its balance is returned.
+// An empty set of coins is returned if nothing is escrowed.
+func QueryEscrowBalances(
+	cliCtx context.CLIContext, queryRoute, portID, channelID, denom string,
+) (sdk.Coins, int64, error) {
+	escrowRes, _, err := QueryEscrowAddress(cliCtx, queryRoute, portID, channelID)
+	if err != nil {
+		return nil, 0, err
+	}
+	escrowAddress := escrowRes.Address
+
+	var (
+		params interface{}
+		route  string
+	)
+
+	if denom == "" {
+		params = banktypes.NewQueryAllBalancesParams(escrowAddress)
+		route = fmt.Sprintf("custom/%s/%s", banktypes.QuerierRoute, banktypes.QueryAllBalances)
+	} else {
+		params = banktypes.NewQueryBalanceParams(escrowAddress, denom)
+		route = fmt.Sprintf("custom/%s/%s", banktypes.QuerierRoute, banktypes.QueryBalance)
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	balances := sdk.Coins{}
+	if denom == "" {
+		if err := cliCtx.Codec.UnmarshalJSON(res, &balances); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal escrow balances: %w", err)
+		}
+		// the bank querier returns null for accounts without balances
+		if balances == nil {
+			balances = sdk.Coins{}
+		}
+		return balances, height, nil
+	}
+
+	var balance sdk.Coin
+	if err := cliCtx.Codec.UnmarshalJSON(res, &balance); err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal escrow balance: %w", err)
+	}
+	if balance.IsPositive() {
+		balances = sdk.NewCoins(balance)
+	}
+	return balances, height, nil
+}
+
+// QueryTimeoutHeight returns a timeout height for a packet sent on the given
+// channel, computed by adding the offset to the latest height of the
+// counterparty chain known by the client of the channel connection. The timeout
+// height belongs to the current revision of the counterparty chain.
+func QueryTimeoutHeight(cliCtx context.CLIContext, portID, channelID string, offset uint64) (ibctypes.Height, error) {
+	channelRes, err := channelutils.QueryChannel(cliCtx, portID, channelID, false)
+	if err != nil {
+		return ibctypes.Height{}, err
+	}
+
+	connectionHops := channelRes.Channel.Channel.ConnectionHops
+	if len(connectionHops) == 0 {
+		return ibctypes.Height{}, fmt.Errorf("channel %s/%s not found", portID, channelID)
+	}
+
+	connectionRes, err := connectionutils.QueryConnection(cliCtx, connectionHops[0], false)
+	if err != nil {
+		return ibctypes.Height{}, err
+	}
+
+	clientID := connectionRes.Connection.Connection.ClientID
+	clientStateRes, err := clientutils.QueryClientState(cliCtx, clientID, false)
+	if err != nil {
+		return ibctypes.Height{}, err
+	}
+
+	if clientStateRes.ClientState == nil {
+		return ibctypes.Height{}, fmt.Errorf("client %s of connection %s not found", clientID, connectionHops[0])
+	}
+
+	clientState := clientStateRes.ClientState
+	return ibctypes.NewHeight(ibctypes.ParseChainID(clientState.GetChainID()), clientState.GetLatestHeight()+offset), nil
+}
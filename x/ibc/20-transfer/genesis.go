@@ -7,14 +7,40 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 )
 
-// InitGenesis binds to portid from genesis state
+// InitGenesis binds to portid from genesis state and stores the parameters, the
+// denomination traces and the escrow address versions. The port is only bound if the module
+// doesn't own it already. It panics if a denomination trace is invalid or if two
+// of them have the same hash.
 func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) {
+	keeper.SetPort(ctx, state.PortID)
+	keeper.SetParams(ctx, state.Params)
+
 	// transfer module binds to the transfer port on InitChain
 	// and claims the returned capability
-	err := keeper.BindPort(ctx, state.PortID)
-	if err != nil {
-		panic(fmt.Sprintf("could not claim port capability: %v", err))
+	if !keeper.IsBound(ctx, state.PortID) {
+		err := keeper.BindPort(ctx, state.PortID)
+		if err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
 	}
+
+	for i, denomTrace := range state.DenomTraces {
+		if err := denomTrace.Validate(); err != nil {
+			panic(fmt.Sprintf("invalid denom trace %d: %v", i, err))
+		}
+
+		// a trace stored under the same hash would be silently overwritten
+		hash := keeper.DenomTraceHash(denomTrace)
+		if keeper.HasDenomTrace(ctx, hash) {
+			panic(fmt.Sprintf("duplicated denom trace %s with hash %s", denomTrace.GetFullDenomPath(), hash))
+		}
+		keeper.SetDenomTrace(ctx, denomTrace)
+	}
+
+	for _, escrowVersion := range state.EscrowAddressVersions {
+		keeper.SetEscrowAddressVersion(ctx, escrowVersion.PortID, escrowVersion.ChannelID, escrowVersion.Version)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetTransferAccount(ctx)
 	if moduleAcc == nil {
@@ -22,11 +48,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) {
 	}
 }
 
-// ExportGenesis exports transfer module's portID into its geneis state
+// ExportGenesis exports transfer module's portID, denomination traces, escrow
+// address versions and parameters into its geneis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	portID := keeper.GetPort(ctx)
-
-	return types.GenesisState{
-		PortID: portID,
-	}
+	return types.NewGenesisState(
+		keeper.GetPort(ctx),
+		keeper.GetAllDenomTraces(ctx),
+		keeper.GetAllEscrowAddressVersions(ctx),
+		keeper.GetParams(ctx),
+	)
 }
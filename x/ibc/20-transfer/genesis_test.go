@@ -0,0 +1,86 @@
+package transfer_test
+
+import (
+	"fmt"
+
+	transfer "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *HandlerTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+
+	var denomTraces []types.DenomTrace
+	for i := 0; i < 3; i++ {
+		denomTrace := types.ParseDenomTrace(fmt.Sprintf("%s/%s/denom%d", testPort2, testChannel2, i))
+		suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+		denomTraces = append(denomTraces, denomTrace)
+	}
+
+	params := types.DefaultParams()
+	params.ReceiveEnabled = false
+	params.MaxDenomTraceDepth = 4
+	suite.chainA.App.TransferKeeper.SetParams(ctx, params)
+
+	genesis := transfer.ExportGenesis(ctx, suite.chainA.App.TransferKeeper)
+	suite.Require().Equal(types.PortID, genesis.PortID)
+	suite.Require().ElementsMatch(denomTraces, genesis.DenomTraces)
+	suite.Require().Equal(params, genesis.Params)
+	suite.Require().NoError(genesis.Validate())
+
+	// import into a fresh chain that already bound the transfer port on InitChain
+	ctxB := suite.chainB.GetContext()
+	suite.Require().True(suite.chainB.App.TransferKeeper.IsBound(ctxB, types.PortID))
+	suite.Require().NotPanics(func() {
+		transfer.InitGenesis(ctxB, suite.chainB.App.TransferKeeper, genesis)
+	})
+
+	suite.Require().Equal(genesis, transfer.ExportGenesis(ctxB, suite.chainB.App.TransferKeeper))
+}
+
+func (suite *HandlerTestSuite) TestInitGenesisInvalidDenomTraces() {
+	denomTrace := types.ParseDenomTrace(fmt.Sprintf("%s/%s/denom", testPort2, testChannel2))
+
+	testCases := []struct {
+		msg         string
+		denomTraces []types.DenomTrace
+		expPass     bool
+	}{
+		{"valid traces", []types.DenomTrace{denomTrace, types.ParseDenomTrace("denom")}, true},
+		{"duplicated trace", []types.DenomTrace{denomTrace, types.ParseDenomTrace("denom"), denomTrace}, false},
+		{"invalid trace", []types.DenomTrace{types.NewDenomTrace(testPort2, "denom")}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			genesis := types.NewGenesisState(types.PortID, tc.denomTraces, []types.ChannelEscrowAddressVersion{}, types.DefaultParams())
+			if tc.expPass {
+				suite.Require().NoError(genesis.Validate())
+				suite.Require().NotPanics(func() {
+					transfer.InitGenesis(ctx, suite.chainA.App.TransferKeeper, genesis)
+				})
+				suite.Require().ElementsMatch(tc.denomTraces, suite.chainA.App.TransferKeeper.GetAllDenomTraces(ctx))
+				return
+			}
+
+			suite.Require().Error(genesis.Validate())
+			suite.Require().Panics(func() {
+				transfer.InitGenesis(ctx, suite.chainA.App.TransferKeeper, genesis)
+			})
+		})
+	}
+
+	// the error names the duplicated trace path
+	genesis := types.NewGenesisState(types.PortID, []types.DenomTrace{denomTrace, denomTrace}, []types.ChannelEscrowAddressVersion{}, types.DefaultParams())
+	err := genesis.Validate()
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), denomTrace.GetFullDenomPath())
+
+	// the traces of the same path received from different chains are distinct
+	genesis = types.NewGenesisState(types.PortID, []types.DenomTrace{denomTrace, denomTrace.WithChainID("testchain")}, []types.ChannelEscrowAddressVersion{}, types.DefaultParams())
+	suite.Require().NoError(genesis.Validate())
+}
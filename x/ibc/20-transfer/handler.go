@@ -3,6 +3,7 @@ package transfer
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns sdk.Handler for IBC token transfer module messages
@@ -11,16 +12,46 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgTransfer:
 			return handleMsgTransfer(ctx, k, msg)
+		case MsgMultiTransfer:
+			return handleMsgMultiTransfer(ctx, k, msg)
+		case MsgTransferNFT:
+			return handleMsgTransferNFT(ctx, k, msg)
+		case MsgPayPacketFee:
+			return handleMsgPayPacketFee(ctx, k, msg)
+		case MsgUpdateParams:
+			return handleMsgUpdateParams(ctx, k, msg)
+		case MsgCancelTransfer:
+			return handleMsgCancelTransfer(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-20 transfer message type: %T", msg)
 		}
 	}
 }
 
+// NewProposalHandler returns the governance handler of the stuck packet refund
+// and transfer parameters update proposals. The keeper must have the connection
+// and client keepers set to refund the stuck packets (see
+// Keeper.WithClientKeepers).
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *RefundStuckPacketProposal:
+			return HandleRefundStuckPacketProposal(ctx, k, c)
+
+		case *UpdateParamsProposal:
+			return HandleUpdateParamsProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-20 transfer proposal content type: %T", c)
+		}
+	}
+}
+
 // See createOutgoingPacket in spec:https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#packet-relay
 func handleMsgTransfer(ctx sdk.Context, k Keeper, msg MsgTransfer) (*sdk.Result, error) {
-	if err := k.SendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.DestHeight, msg.Amount, msg.Sender, msg.Receiver,
+	if err := k.SendTransferWithMinReceived(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.Amount, msg.Sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo, msg.RefundAddress,
+		msg.TransferFee, msg.MinReceived,
 	); err != nil {
 		return nil, err
 	}
@@ -38,3 +69,88 @@ func handleMsgTransfer(ctx sdk.Context, k Keeper, msg MsgTransfer) (*sdk.Result,
 		Events: ctx.EventManager().Events().ToABCIEvents(),
 	}, nil
 }
+
+// handleMsgMultiTransfer sends a packet for each of the transfer outputs. The
+// tokens of each packet are escrowed or burned independently, so the sender pays
+// the sum of the outputs.
+func handleMsgMultiTransfer(ctx sdk.Context, k Keeper, msg MsgMultiTransfer) (*sdk.Result, error) {
+	for _, out := range msg.Outputs {
+		if err := k.SendTransfer(
+			ctx, msg.SourcePort, msg.SourceChannel, out.Amount, msg.Sender, out.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+		); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to transfer %s to %s", out.Amount, out.Receiver)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// handleMsgPayPacketFee escrows the relayer fees of a packet in flight. The fees
+// are distributed when the packet is acknowledged or timed out.
+func handleMsgPayPacketFee(ctx sdk.Context, k Keeper, msg MsgPayPacketFee) (*sdk.Result, error) {
+	if err := k.PayPacketFee(ctx, msg.SourcePort, msg.SourceChannel, msg.Sequence, msg.Fee, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// handleMsgUpdateParams updates the parameters of the module, provided the msg
+// is signed by the authority of the keeper.
+func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) (*sdk.Result, error) {
+	if err := k.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// handleMsgCancelTransfer refunds the tokens of a transfer that timed out
+// without being received, provided the msg is signed by its sender.
+func handleMsgCancelTransfer(ctx sdk.Context, k Keeper, msg MsgCancelTransfer) (*sdk.Result, error) {
+	if err := k.CancelTransfer(ctx, msg.Packet, msg.Proof, msg.ProofHeight, msg.NextSequenceRecv, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
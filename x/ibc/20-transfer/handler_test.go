@@ -1,25 +1,37 @@
 package transfer_test
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
 	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
 	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	transfer "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/testutil"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/ante"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
@@ -48,6 +60,8 @@ var (
 	testCoins, _          = sdk.ParseCoins("100atom")
 	testPrefixedCoins1, _ = sdk.ParseCoins(fmt.Sprintf("100%satom", types.GetDenomPrefix(testPort1, testChannel1)))
 	testPrefixedCoins2, _ = sdk.ParseCoins(fmt.Sprintf("100%satom", types.GetDenomPrefix(testPort2, testChannel2)))
+
+	testTimeoutHeight = ibctypes.NewHeight(0, 110)
 )
 
 type HandlerTestSuite struct {
@@ -63,6 +77,9 @@ func (suite *HandlerTestSuite) SetupTest() {
 	suite.chainA = NewTestChain(testClientIDA)
 	suite.chainB = NewTestChain(testClientIDB)
 
+	// the tests send tokens through the bank port, which isn't bound on genesis
+	suite.chainA.bindPort(testPort1)
+
 	suite.cdc = suite.chainA.App.Codec()
 }
 
@@ -77,7 +94,7 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Nil(err, "transfer module could not claim capability")
 
 	ctx := suite.chainA.GetContext()
-	msg := transfer.NewMsgTransfer(testPort1, testChannel1, 10, testPrefixedCoins2, testAddr1, testAddr2.String())
+	msg := transfer.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins2, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
 	res, err := handler(ctx, msg)
 	suite.Require().Error(err)
 	suite.Require().Nil(res, "%+v", res) // channel does not exist
@@ -103,14 +120,14 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().NotNil(res, "%+v", res) // successfully executed
 
 	// test when the source is false
-	msg = transfer.NewMsgTransfer(testPort1, testChannel1, 10, testPrefixedCoins2, testAddr1, testAddr2.String())
+	msg = transfer.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins2, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
 	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testPrefixedCoins2)
 
 	res, err = handler(ctx, msg)
 	suite.Require().Error(err)
 	suite.Require().Nil(res, "%+v", res) // incorrect denom prefix
 
-	msg = transfer.NewMsgTransfer(testPort1, testChannel1, 10, testPrefixedCoins1, testAddr1, testAddr2.String())
+	msg = transfer.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins1, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
 	suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply.NewSupply(testPrefixedCoins1))
 	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testPrefixedCoins1)
 
@@ -119,6 +136,1095 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().NotNil(res, "%+v", res) // successfully executed
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgMultiTransfer() {
+	handler := transfer.NewHandler(suite.chainA.App.TransferKeeper)
+
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, testPort1, testChannel1, 1)
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testCoins)
+
+	outputs := []transfer.TransferOutput{
+		transfer.NewTransferOutput(testAddr2.String(), sdk.NewCoins(sdk.NewInt64Coin(testPrefixedCoins2[0].Denom, 60))),
+		transfer.NewTransferOutput(sdk.AccAddress([]byte("testaddr3")).String(), sdk.NewCoins(sdk.NewInt64Coin(testPrefixedCoins2[0].Denom, 40))),
+	}
+
+	// the sender cannot afford the sum of the outputs, the state changes of the
+	// transfers already sent are discarded with the cached context
+	msg := transfer.NewMsgMultiTransfer(testPort1, testChannel1, testAddr1, append(outputs, transfer.NewTransferOutput("testaddr4", testPrefixedCoins2)), testTimeoutHeight, 0, "")
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := handler(cacheCtx, msg)
+	suite.Require().Error(err)
+	suite.Require().Nil(res, "%+v", res)
+
+	msg = transfer.NewMsgMultiTransfer(testPort1, testChannel1, testAddr1, outputs, testTimeoutHeight, 0, "")
+	res, err = handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res, "%+v", res)
+
+	// a packet is sent for each of the outputs
+	for seq := uint64(1); seq <= uint64(len(outputs)); seq++ {
+		commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, seq)
+		suite.Require().NotNil(commitment, "missing commitment for packet %d", seq)
+	}
+
+	escrowBalance := suite.chainA.App.BankKeeper.GetBalance(ctx, types.GetEscrowAddress(testPort1, testChannel1), "atom")
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 100), escrowBalance)
+	suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, testAddr1).IsZero())
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgUpdateParams() {
+	ctx := suite.chainA.GetContext()
+	handler := transfer.NewHandler(suite.chainA.App.TransferKeeper)
+	authority := supply.NewModuleAddress(gov.ModuleName)
+	suite.Require().Equal(authority, suite.chainA.App.TransferKeeper.GetAuthority())
+
+	params := types.NewParams(false, true, []types.DenomEnabled{types.NewDenomEnabled("atom", true)}, nil, true, 0, false, 0, 0)
+
+	// only the authority can update the parameters
+	_, err := handler(ctx, transfer.NewMsgUpdateParams(testAddr1, params))
+	suite.Require().True(errors.Is(err, types.ErrInvalidAuthority), "unexpected error: %v", err)
+	suite.Require().Equal(types.DefaultParams(), suite.chainA.App.TransferKeeper.GetParams(ctx))
+
+	invalidParams := types.NewParams(true, true, []types.DenomEnabled{types.NewDenomEnabled("(atom)", false)}, nil, false, 0, false, 0, 0)
+	_, err = handler(ctx, transfer.NewMsgUpdateParams(authority, invalidParams))
+	suite.Require().True(errors.Is(err, types.ErrInvalidParams), "unexpected error: %v", err)
+	suite.Require().Equal(types.DefaultParams(), suite.chainA.App.TransferKeeper.GetParams(ctx))
+
+	res, err := handler(ctx, transfer.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(params, suite.chainA.App.TransferKeeper.GetParams(ctx))
+
+	// the authority can be overridden
+	handler = transfer.NewHandler(suite.chainA.App.TransferKeeper.WithAuthority(testAddr1))
+	_, err = handler(ctx, transfer.NewMsgUpdateParams(testAddr1, types.DefaultParams()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), suite.chainA.App.TransferKeeper.GetParams(ctx))
+}
+
+func (suite *HandlerTestSuite) TestHandleUpdateParamsProposal() {
+	ctx := suite.chainA.GetContext()
+	handler := transfer.NewProposalHandler(suite.chainA.App.TransferKeeper)
+
+	params := types.NewParams(false, true, []types.DenomEnabled{types.NewDenomEnabled("atom", true)}, nil, true, 0, false, 0, 0)
+	suite.Require().NoError(handler(ctx, types.NewUpdateParamsProposal("title", "description", params)))
+	suite.Require().Equal(params, suite.chainA.App.TransferKeeper.GetParams(ctx))
+
+	// the proposals can't update the parameters of a keeper with another authority
+	handler = transfer.NewProposalHandler(suite.chainA.App.TransferKeeper.WithAuthority(testAddr1))
+	err := handler(ctx, types.NewUpdateParamsProposal("title", "description", types.DefaultParams()))
+	suite.Require().True(errors.Is(err, types.ErrInvalidAuthority), "unexpected error: %v", err)
+	suite.Require().Equal(params, suite.chainA.App.TransferKeeper.GetParams(ctx))
+}
+
+// TestRefundStuckPacketProposal tests that a packet stuck on a dead client is
+// refunded once the refund proposal passes through governance.
+func (suite *HandlerTestSuite) TestRefundStuckPacketProposal() {
+	app := suite.chainA.App
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+
+	mockClient := testutil.NewMockClient("mockclientb", "mockchainb")
+	suite.chainA.openMockChannel(mockClient, testPort1, testChannel1, testPort2, testChannel2)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, sender, testCoins))
+	err := app.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, testCoins, sender, receiver.String(), testTimeoutHeight, 0, "")
+	suite.Require().NoError(err)
+
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, sender.String(), receiver.String(), "")
+	packet := channeltypes.NewPacketWithTimeoutHeight(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, testTimeoutHeight, 0)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1))
+
+	// the client is frozen before the packet times out, so it can't be timed out
+	// through the channel anymore
+	clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(ctx, mockClient.ClientID)
+	suite.Require().True(found)
+	tmClientState := clientState.(ibctmtypes.ClientState)
+	tmClientState.FrozenHeight = 1
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, tmClientState)
+
+	// a single validator votes the proposal
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.TokensFromConsensusPower(42))
+	_, err = staking.NewHandler(app.StakingKeeper)(ctx, staking.NewMsgCreateValidator(
+		sdk.ValAddress(addrs[0]), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)),
+		staking.Description{Moniker: "validator"}, staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	suite.Require().NoError(err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, types.NewRefundStuckPacketProposal("title", "description", packet))
+	suite.Require().NoError(err)
+	_, err = gov.NewHandler(app.GovKeeper)(ctx, gov.NewMsgDeposit(addrs[0], proposal.ProposalID, app.GovKeeper.GetDepositParams(ctx).MinDeposit))
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.OptionYes))
+
+	// the tokens are still escrowed until the proposal passes
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, sender).IsZero())
+
+	header := ctx.BlockHeader()
+	header.Time = header.Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(header)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, found = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	suite.Require().True(found)
+	suite.Require().Equal(gov.StatusPassed, proposal.Status)
+	suite.Require().Equal(testCoins, app.BankKeeper.GetAllBalances(ctx, sender))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, types.GetEscrowAddress(testPort1, testChannel1)).IsZero())
+	suite.Require().Empty(app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1))
+}
+
+// TestOnRecvPacketPostReceiveHookError tests that a failing post receive hook
+// reverts the receive and results on an error acknowledgement.
+func (suite *HandlerTestSuite) TestOnRecvPacketPostReceiveHookError() {
+	transferKeeper := suite.chainA.App.TransferKeeper
+	transferKeeper.SetPostReceiveHook(func(
+		sdk.Context, channelexported.PacketI, string, sdk.Int, sdk.AccAddress,
+	) error {
+		return errors.New("hook failed")
+	})
+	module := transfer.NewAppModule(transferKeeper)
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, escrow, testCoins)
+
+	// receiver address with a valid length
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins1, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	res, err := module.OnRecvPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	expAck := types.FungibleTokenPacketAcknowledgement{Success: false, Error: "hook failed"}
+	ack, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, testPort2, testChannel2, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.GetBytes()), ack)
+
+	// the unescrowed tokens are returned to the escrow account
+	suite.Require().Equal(testCoins, suite.chainA.App.BankKeeper.GetAllBalances(ctx, escrow))
+	suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver).IsZero())
+}
+
+// TestOnRecvPacketMinReceived tests that packets crediting the receiver with
+// less than their minimum received amount result on an error acknowledgement.
+func (suite *HandlerTestSuite) TestOnRecvPacketMinReceived() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper)
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, escrow, testCoins)
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins1, testAddr1.String(), receiver.String(), "")
+	minReceived := sdk.NewCoin(testPrefixedCoins1[0].Denom, testPrefixedCoins1[0].Amount.AddRaw(1))
+	data.MinReceived = &minReceived
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	cacheCtx, _ := ctx.CacheContext()
+	recvErr := suite.chainA.App.TransferKeeper.OnRecvPacket(cacheCtx, packet, data)
+	suite.Require().True(types.ErrMinReceivedNotMet.Is(recvErr), "unexpected error %v", recvErr)
+
+	relayer := sdk.AccAddress(crypto.AddressHash([]byte("relayer")))
+	recvCtx := ctx.WithEventManager(sdk.NewEventManager())
+	res, err := module.OnRecvPacket(recvCtx, packet, relayer)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	expAck := types.FungibleTokenPacketAcknowledgement{Success: false, Error: recvErr.Error(), Relayer: relayer.String()}
+	ack, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, testPort2, testChannel2, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.GetBytes()), ack)
+
+	// the error acknowledgement can be relayed back to the sending chain
+	bz := writtenAcknowledgement(recvCtx.EventManager().Events())
+	suite.Require().Equal(expAck.GetBytes(), bz)
+	proof := testutil.NewMockProof([]byte("root"), ibctypes.PacketAcknowledgementPath(testPort2, testChannel2, 1), bz)
+	suite.Require().NoError(channeltypes.NewMsgAcknowledgement(packet, bz, proof, 1, relayer).ValidateBasic())
+	suite.Require().Equal(testCoins, suite.chainA.App.BankKeeper.GetAllBalances(ctx, escrow))
+	suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver).IsZero())
+
+	// the packet is received once the minimum is met
+	data.MinReceived = &testPrefixedCoins1[0]
+	packet = channeltypes.NewPacket(data.GetBytes(), 2, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	_, err = module.OnRecvPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+
+	expAck = types.FungibleTokenPacketAcknowledgement{Success: true}
+	ack, found = suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, testPort2, testChannel2, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.GetBytes()), ack)
+	suite.Require().Equal(testCoins, suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver))
+}
+
+// TestOnRecvPacketUnknownDenom tests that packets with denominations that can't
+// be resolved result on an error acknowledgement instead of aborting the receive.
+func (suite *HandlerTestSuite) TestOnRecvPacketUnknownDenom() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper)
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+
+	testCases := []struct {
+		msg   string
+		denom string
+	}{
+		{"unknown channel prefix", "otherport/otherchannel/atom"},
+		{"missing base denomination", "bank/firstchannel/"},
+		{"invalid trace", "bank/firstchannel/otherport/atom"},
+		{"native denomination too short", "bank/firstchannel/at"},
+		{"native denomination too long", "bank/firstchannel/a" + strings.Repeat("b", 64)},
+	}
+
+	for i, tc := range testCases {
+		amount := sdk.Coins{sdk.NewInt64Coin("atom", 100)}
+		amount[0].Denom = tc.denom
+		data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), receiver.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), uint64(i+1), testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		cacheCtx, _ := ctx.CacheContext()
+		recvErr := suite.chainA.App.TransferKeeper.OnRecvPacket(cacheCtx, packet, data)
+		suite.Require().True(types.ErrUnknownDenom.Is(recvErr), "test case %d: %s", i, tc.msg)
+		suite.Require().Contains(recvErr.Error(), tc.denom, "test case %d: %s", i, tc.msg)
+
+		res, err := module.OnRecvPacket(ctx, packet, nil)
+		suite.Require().NoError(err, "test case %d: %s", i, tc.msg)
+		suite.Require().NotNil(res)
+
+		// an error acknowledgement is written for the packet
+		expAck := types.FungibleTokenPacketAcknowledgement{Success: false, Error: recvErr.Error()}
+		ack, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, testPort2, testChannel2, uint64(i+1))
+		suite.Require().True(found, "test case %d: %s", i, tc.msg)
+		suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.GetBytes()), ack, "test case %d: %s", i, tc.msg)
+	}
+}
+
+// TestErrorAcknowledgementValidateBasic tests that the error acknowledgements
+// written for the receive failures, along with the relayer, can be relayed back
+// to the sending chain.
+func (suite *HandlerTestSuite) TestErrorAcknowledgementValidateBasic() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper)
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	relayer := sdk.AccAddress(crypto.AddressHash([]byte("relayer")))
+
+	testCases := []struct {
+		msg      string
+		denom    string
+		receiver string
+	}{
+		{"receiver is the escrow account", testPrefixedCoins1[0].Denom, types.GetEscrowAddress(testPort2, testChannel2).String()},
+		{"unknown trace path", "otherport/otherchannel/transfer/channelidone/transfer/channelidtwo/transfer/channelidthree/atom", receiver.String()},
+		{"invalid receiver", testPrefixedCoins1[0].Denom, strings.Repeat("<", types.MaximumReceiverLength)},
+	}
+
+	for i, tc := range testCases {
+		amount := sdk.Coins{sdk.Coin{Denom: tc.denom, Amount: sdk.NewInt(100)}}
+		data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), tc.receiver, "")
+		packet := channeltypes.NewPacket(data.GetBytes(), uint64(i+1), testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		recvCtx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := module.OnRecvPacket(recvCtx, packet, relayer)
+		suite.Require().NoError(err, "test case %d: %s", i, tc.msg)
+
+		bz := writtenAcknowledgement(recvCtx.EventManager().Events())
+		ack, err := types.GetAcknowledgement(bz)
+		suite.Require().NoError(err, "test case %d: %s", i, tc.msg)
+		suite.Require().False(ack.Success, "test case %d: %s", i, tc.msg)
+		suite.Require().Equal(relayer.String(), ack.Relayer, "test case %d: %s", i, tc.msg)
+
+		proof := testutil.NewMockProof([]byte("root"), ibctypes.PacketAcknowledgementPath(testPort2, testChannel2, packet.GetSequence()), bz)
+		msg := channeltypes.NewMsgAcknowledgement(packet, bz, proof, 1, relayer)
+		suite.Require().NoError(msg.ValidateBasic(), "test case %d: %s", i, tc.msg)
+	}
+}
+
+// writtenAcknowledgement returns the acknowledgement written on the receive
+// packet event of an executed packet.
+func writtenAcknowledgement(events sdk.Events) []byte {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeRecvPacket {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == channeltypes.AttributeKeyData {
+				return attr.Value
+			}
+		}
+	}
+	return nil
+}
+
+// TestRecvPacketFailureRefund tests that a packet failing on the receiving chain
+// is acknowledged with an error that can be relayed back to the sending chain,
+// where the tokens are refunded.
+func (suite *HandlerTestSuite) TestRecvPacketFailureRefund() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	relayer := sdk.AccAddress(crypto.AddressHash([]byte("relayer")))
+
+	// chain A sends a voucher it holds back through another channel, so that it
+	// is escrowed
+	mockClientB := testutil.NewMockClient("mockclientb", "mockchainb")
+	prefixB := suite.chainA.openMockChannel(mockClientB, testPort1, testChannel1, testPort2, testChannel2)
+
+	ctxA := suite.chainA.GetContext()
+	trace := types.ParseDenomTrace("transfer/channelidone/transfer/channelidtwo/atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctxA, trace)
+	voucher := trace.IBCDenom()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(voucher, 100))
+	suite.Require().NoError(suite.chainA.App.BankKeeper.SetBalances(ctxA, sender, amount))
+
+	err := suite.chainA.App.TransferKeeper.SendTransfer(ctxA, testPort1, testChannel1, amount, sender, receiver.String(), testTimeoutHeight, 0, "")
+	suite.Require().NoError(err)
+
+	packetAmount := sdk.Coins{sdk.Coin{Denom: types.GetDenomPrefix(testPort2, testChannel2) + trace.GetFullDenomPath(), Amount: amount[0].Amount}}
+	data := types.NewFungibleTokenPacketData(packetAmount, sender.String(), receiver.String(), "")
+	packet := channeltypes.NewPacketWithTimeoutHeight(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, testTimeoutHeight, 0)
+	commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctxA, testPort1, testChannel1, 1)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+
+	// chain B doesn't accept traces as deep as the one of the voucher
+	mockClientA := testutil.NewMockClient("mockclienta", "mockchaina")
+	prefixA := suite.chainB.openMockChannel(mockClientA, testPort2, testChannel2, testPort1, testChannel1)
+
+	ctxB := suite.chainB.GetContext()
+	params := suite.chainB.App.TransferKeeper.GetParams(ctxB)
+	params.MaxDenomTraceDepth = 1
+	suite.chainB.App.TransferKeeper.SetParams(ctxB, params)
+
+	proof, proofHeight, err := mockClientA.PacketCommitmentProof(prefixA, packet)
+	suite.Require().NoError(err)
+	events, err := suite.chainB.deliverMsg(channeltypes.NewMsgPacket(packet, proof, proofHeight, relayer))
+	suite.Require().NoError(err)
+
+	bz := writtenAcknowledgement(events)
+	ack, err := types.GetAcknowledgement(bz)
+	suite.Require().NoError(err)
+	suite.Require().False(ack.Success)
+	suite.Require().Contains(ack.Error, packetAmount[0].Denom)
+	suite.Require().True(suite.chainB.App.BankKeeper.GetAllBalances(ctxB, receiver).IsZero())
+
+	// the error acknowledgement is relayed back and the sender is refunded
+	escrow := suite.chainA.App.TransferKeeper.GetEscrowAddress(ctxA, testPort1, testChannel1)
+	suite.Require().Equal(amount, suite.chainA.App.BankKeeper.GetAllBalances(ctxA, escrow))
+
+	proof, proofHeight, err = mockClientB.AcknowledgementProof(prefixB, packet, bz)
+	suite.Require().NoError(err)
+	_, err = suite.chainA.deliverMsg(channeltypes.NewMsgAcknowledgement(packet, bz, proof, proofHeight, relayer))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(amount, suite.chainA.App.BankKeeper.GetAllBalances(ctxA, sender))
+	suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctxA, escrow).IsZero())
+}
+
+// TestOnAcknowledgementPacketEvents tests that the acknowledgement result is
+// emitted, along with the refund of failed packets.
+func (suite *HandlerTestSuite) TestOnAcknowledgementPacketEvents() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper)
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	refundAddr := sdk.AccAddress(crypto.AddressHash([]byte("refund")))
+
+	testCases := []struct {
+		msg           string
+		refundAddress string
+		ack           types.FungibleTokenPacketAcknowledgement
+		expAttributes map[string]string
+	}{
+		{
+			"success",
+			"",
+			types.FungibleTokenPacketAcknowledgement{Success: true},
+			map[string]string{
+				types.AttributeKeySourcePort:    testPort1,
+				types.AttributeKeySourceChannel: testChannel1,
+				types.AttributeKeySequence:      "1",
+				types.AttributeKeyAckSuccess:    "true",
+			},
+		},
+		{
+			"failure",
+			"",
+			types.FungibleTokenPacketAcknowledgement{Success: false, Error: "failed"},
+			map[string]string{
+				types.AttributeKeySourcePort:     testPort1,
+				types.AttributeKeySourceChannel:  testChannel1,
+				types.AttributeKeySequence:       "1",
+				types.AttributeKeyAckSuccess:     "false",
+				types.AttributeKeyAckError:       "failed",
+				types.AttributeKeyRefundReceiver: sender.String(),
+				types.AttributeKeyRefundValue:    testCoins.String(),
+			},
+		},
+		{
+			"failure with refund address",
+			refundAddr.String(),
+			types.FungibleTokenPacketAcknowledgement{Success: false, Error: "failed"},
+			map[string]string{
+				types.AttributeKeyAckSuccess:     "false",
+				types.AttributeKeyRefundReceiver: refundAddr.String(),
+				types.AttributeKeyRefundValue:    testCoins.String(),
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		data := types.NewFungibleTokenPacketData(testCoins, sender.String(), testAddr2.String(), "")
+		data.RefundAddress = tc.refundAddress
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		ctx, _ := suite.chainA.GetContext().CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		res, err := module.OnAcknowledgementPacket(ctx, packet, tc.ack.GetBytes(), nil)
+		suite.Require().NoError(err, "test case %d: %s", i, tc.msg)
+		suite.Require().NotNil(res)
+
+		var attributes map[string]string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypePacket {
+				continue
+			}
+			suite.Require().Nil(attributes, "test case %d: %s: duplicated packet event", i, tc.msg)
+			attributes = make(map[string]string)
+			for _, attr := range event.Attributes {
+				attributes[string(attr.Key)] = string(attr.Value)
+			}
+		}
+
+		for key, value := range tc.expAttributes {
+			suite.Require().Equal(value, attributes[key], "test case %d: %s: attribute %s", i, tc.msg, key)
+		}
+		if tc.ack.Success {
+			suite.Require().NotContains(attributes, types.AttributeKeyRefundReceiver, "test case %d: %s", i, tc.msg)
+		}
+
+		_, found := suite.chainA.App.TransferKeeper.GetPacketSendHeight(ctx, testPort1, testChannel1, 1)
+		suite.Require().False(found, "test case %d: %s", i, tc.msg)
+	}
+}
+
+// TestChannelHandshake tests that a transfer channel is opened end to end
+// through the channel handshake messages, with the transfer module claiming the
+// channel capabilities on both chains.
+func (suite *HandlerTestSuite) TestChannelHandshake() {
+	handlerA := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	portID := suite.chainA.App.TransferKeeper.GetPort(suite.chainA.GetContext())
+
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainB.CreateClient(suite.chainA)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainB.createConnection(testConnection, testConnection, testClientIDA, testClientIDB, connectionexported.OPEN)
+
+	// the channel can't be opened on a port the transfer module isn't bound to
+	msgInit := channeltypes.NewMsgChannelOpenInit(
+		testPort2, testChannel1, types.Version, channelexported.UNORDERED, []string{testConnection}, portID, testChannel2, relayer,
+	)
+	_, err := handlerA(suite.chainA.GetContext(), msgInit)
+	suite.Require().Error(err)
+
+	suite.openTransferChannel(testConnection, testChannel1, testChannel2, channelexported.UNORDERED)
+
+	for _, tc := range []struct {
+		chain     *TestChain
+		channelID string
+	}{
+		{suite.chainA, testChannel1},
+		{suite.chainB, testChannel2},
+	} {
+		ctx := tc.chain.GetContext()
+		channel, found := tc.chain.App.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, tc.channelID)
+		suite.Require().True(found)
+		suite.Require().Equal(channelexported.OPEN, channel.State)
+
+		_, ok := tc.chain.App.ScopedTransferKeeper.GetCapability(ctx, ibctypes.ChannelCapabilityPath(portID, tc.channelID))
+		suite.Require().True(ok, "transfer module doesn't own the capability of channel %s", tc.channelID)
+
+		version, found := tc.chain.App.TransferKeeper.GetEscrowAddressVersion(ctx, portID, tc.channelID)
+		suite.Require().True(found)
+		suite.Require().Equal(types.CurrentEscrowAddressVersion, version)
+	}
+}
+
+func (suite *HandlerTestSuite) TestOnChanOpenTryVersion() {
+	counterparty := channeltypes.NewCounterparty(testPort1, testChannel1)
+
+	testCases := []struct {
+		msg                 string
+		protoEncoding       bool
+		version             string
+		counterpartyVersion string
+		expPass             bool
+	}{
+		{"protobuf encoding", true, types.VersionProto, types.VersionProto, true},
+		{"fall back to JSON", true, types.Version, types.VersionProto, true},
+		{"fall back to JSON without protobuf support", false, types.Version, types.VersionProto, true},
+		{"JSON encoding", true, types.Version, types.Version, true},
+		{"protobuf not advertised by counterparty", true, types.VersionProto, types.Version, false},
+		{"protobuf not supported", false, types.VersionProto, types.VersionProto, false},
+		{"invalid counterparty version", true, types.Version, "ics20-2", false},
+	}
+
+	for i, tc := range testCases {
+		transferKeeper := suite.chainA.App.TransferKeeper
+		if tc.protoEncoding {
+			transferKeeper = transferKeeper.WithProtoPacketEncoding()
+		}
+		module := transfer.NewAppModule(transferKeeper)
+
+		ctx := suite.chainA.GetContext()
+		channelID := fmt.Sprintf("channel%d", i)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(ctx, ibctypes.ChannelCapabilityPath(types.PortID, channelID))
+		suite.Require().NoError(err)
+
+		err = module.OnChanOpenTry(
+			ctx, channelexported.UNORDERED, []string{testConnection}, types.PortID, channelID, cap, counterparty, tc.version, tc.counterpartyVersion,
+		)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+// TestChannelOrdering tests that the transfer channels are UNORDERED unless the
+// ORDERED ones are allowed by the module parameters.
+func (suite *HandlerTestSuite) TestChannelOrdering() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper)
+	counterparty := channeltypes.NewCounterparty(testPort1, testChannel1)
+
+	testCases := []struct {
+		msg          string
+		order        channelexported.Order
+		allowOrdered bool
+		expPass      bool
+	}{
+		{"UNORDERED", channelexported.UNORDERED, false, true},
+		{"ORDERED not allowed", channelexported.ORDERED, false, false},
+		{"ORDERED allowed", channelexported.ORDERED, true, true},
+		{"UNORDERED with ORDERED allowed", channelexported.UNORDERED, true, true},
+		{"no ordering", channelexported.NONE, true, false},
+	}
+
+	for i, tc := range testCases {
+		ctx := suite.chainA.GetContext()
+		params := types.DefaultParams()
+		params.AllowOrderedChannels = tc.allowOrdered
+		suite.chainA.App.TransferKeeper.SetParams(ctx, params)
+
+		for _, handshake := range []string{"init", "try"} {
+			channelID := fmt.Sprintf("%schannel%d", handshake, i)
+			cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(ctx, ibctypes.ChannelCapabilityPath(types.PortID, channelID))
+			suite.Require().NoError(err)
+
+			if handshake == "init" {
+				err = module.OnChanOpenInit(
+					ctx, tc.order, []string{testConnection}, types.PortID, channelID, cap, counterparty, types.Version,
+				)
+			} else {
+				err = module.OnChanOpenTry(
+					ctx, tc.order, []string{testConnection}, types.PortID, channelID, cap, counterparty, types.Version, types.Version,
+				)
+			}
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed on %s: %s", i, handshake, tc.msg)
+			} else {
+				suite.Require().True(errors.Is(err, channeltypes.ErrInvalidChannelOrdering), "invalid test case %d passed on %s: %s", i, handshake, tc.msg)
+				suite.Require().Contains(err.Error(), tc.order.String())
+			}
+		}
+	}
+}
+
+// TestOnChanClose tests that transfer channels cannot be closed by users, while
+// counterparty closes are confirmed.
+// TestRecvPacketAlreadyReceived tests that a packet relayed twice is only
+// executed once.
+func (suite *HandlerTestSuite) TestRecvPacketAlreadyReceived() {
+	handler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	// NOTE: the packet proof is verified by the ante handler
+	msg := channeltypes.NewMsgPacket(packet, commitmenttypes.MerkleProof{}, 1, testAddr1)
+
+	res, err := handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	voucher := types.ParseDenomTrace(testPrefixedCoins2[0].Denom).IBCDenom()
+	expBalance := sdk.NewCoins(sdk.NewCoin(voucher, sdk.NewInt(100)))
+	suite.Require().Equal(expBalance, suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver))
+
+	// the packet is not executed again
+	res, err = handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal("packet already received", res.Log)
+	suite.Require().Equal(channeltypes.EventTypePacketAlreadyReceived, res.Events[0].Type)
+	suite.Require().Equal(expBalance, suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver))
+}
+
+// TestRecvPacketSequence tests that packets of ordered channels are received in
+// sequence order, while packets of unordered channels are received in any order.
+func (suite *HandlerTestSuite) TestRecvPacketSequence() {
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, testAddr1.String(), receiver.String(), "")
+
+	testCases := []struct {
+		msg      string
+		order    channelexported.Order
+		sequence uint64
+		expPass  bool
+	}{
+		{"ORDERED: next sequence", channelexported.ORDERED, 1, true},
+		{"ORDERED: out of order sequence", channelexported.ORDERED, 2, false},
+		{"UNORDERED: any sequence", channelexported.UNORDERED, 2, true},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		handler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+
+		capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+		suite.Require().Nil(err, "could not create capability")
+		err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+		suite.Require().Nil(err, "transfer module could not claim capability")
+
+		ctx := suite.chainA.GetContext()
+		suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, tc.order, testConnection)
+		suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceRecv(ctx, testPort2, testChannel2, 1)
+
+		packet := channeltypes.NewPacket(data.GetBytes(), tc.sequence, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+		_, err = handler(ctx, channeltypes.NewMsgPacket(packet, commitmenttypes.MerkleProof{}, 1, testAddr1))
+
+		nextSequenceRecv, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, testPort2, testChannel2)
+		suite.Require().True(found)
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			if tc.order == channelexported.ORDERED {
+				suite.Require().Equal(tc.sequence+1, nextSequenceRecv, "test case %d: %s", i, tc.msg)
+			} else {
+				suite.Require().Equal(uint64(1), nextSequenceRecv, "test case %d: %s", i, tc.msg)
+			}
+		} else {
+			suite.Require().True(channeltypes.ErrPacketSequenceOutOfOrder.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+			suite.Require().Equal(uint64(1), nextSequenceRecv, "test case %d: %s", i, tc.msg)
+			suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver).IsZero(), "test case %d: %s", i, tc.msg)
+		}
+	}
+}
+
+// createRecvChannel creates a transfer channel on chainA that receives packets
+// from the bank port.
+func (suite *HandlerTestSuite) createRecvChannel(order channelexported.Order) {
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, order, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceRecv(ctx, testPort2, testChannel2, 1)
+}
+
+// TestRecvPackets tests that the packets of a batch are executed in order and
+// that a packet that fails to be executed doesn't abort the others.
+func (suite *HandlerTestSuite) TestRecvPackets() {
+	handler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	suite.createRecvChannel(channelexported.ORDERED)
+	ctx := suite.chainA.GetContext()
+
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, testAddr1.String(), receiver.String(), "")
+	newPacket := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(data.GetBytes(), sequence, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	}
+
+	// the third sequence is out of order and the first one is relayed twice
+	packets := []channeltypes.Packet{newPacket(1), newPacket(3), newPacket(2), newPacket(1)}
+	proofs := []commitmentexported.Proof{
+		commitmenttypes.MerkleProof{}, commitmenttypes.MerkleProof{}, commitmenttypes.MerkleProof{}, commitmenttypes.MerkleProof{},
+	}
+
+	// NOTE: the packet proofs are verified by the ante handler
+	res, err := handler(ctx, channeltypes.NewMsgRecvPackets(packets, proofs, 1, testAddr1))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var results []channeltypes.RecvPacketResult
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(res.Data, &results))
+	suite.Require().Len(results, len(packets))
+
+	expAck := types.FungibleTokenPacketAcknowledgement{Success: true, Relayer: testAddr1.String()}
+	for i, expSuccess := range []bool{true, false, true, false} {
+		suite.Require().Equal(packets[i].Sequence, results[i].Sequence, "packet %d", i)
+		suite.Require().Equal(expSuccess, results[i].Success(), "packet %d", i)
+		if expSuccess {
+			suite.Require().Equal(expAck.GetBytes(), results[i].Acknowledgement, "packet %d", i)
+		} else {
+			suite.Require().Empty(results[i].Acknowledgement, "packet %d", i)
+		}
+	}
+	suite.Require().Contains(results[1].Error, channeltypes.ErrPacketSequenceOutOfOrder.Error())
+	suite.Require().Contains(results[3].Error, channeltypes.ErrPacketAlreadyReceived.Error())
+
+	nextSequenceRecv, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, testPort2, testChannel2)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(3), nextSequenceRecv)
+
+	voucher := types.ParseDenomTrace(testPrefixedCoins2[0].Denom).IBCDenom()
+	expBalance := sdk.NewCoins(sdk.NewCoin(voucher, sdk.NewInt(200)))
+	suite.Require().Equal(expBalance, suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver))
+}
+
+// TestRelayerAllowlist tests that only the relayers allowed on a channel can
+// relay its packets and acknowledgements.
+func (suite *HandlerTestSuite) TestRelayerAllowlist() {
+	handler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	suite.createRecvChannel(channelexported.ORDERED)
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+
+	relayer := sdk.AccAddress(crypto.AddressHash([]byte("relayer")))
+	channelKeeper.SetRelayerAllowlist(ctx, testPort2, testChannel2, []sdk.AccAddress{relayer})
+
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	// NOTE: the packet proofs are verified by the ante handler
+	_, err := handler(ctx, channeltypes.NewMsgPacket(packet, commitmenttypes.MerkleProof{}, 1, testAddr1))
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err), "unauthorized relayer received the packet: %v", err)
+
+	res, err := handler(ctx, channeltypes.NewMsgRecvPackets([]channeltypes.Packet{packet}, []commitmentexported.Proof{commitmenttypes.MerkleProof{}}, 1, testAddr1))
+	suite.Require().NoError(err)
+	var results []channeltypes.RecvPacketResult
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(res.Data, &results))
+	suite.Require().False(results[0].Success())
+	suite.Require().Contains(results[0].Error, sdkerrors.ErrUnauthorized.Error())
+	suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver).IsZero())
+
+	_, err = handler(ctx, channeltypes.NewMsgPacket(packet, commitmenttypes.MerkleProof{}, 1, relayer))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.chainA.App.BankKeeper.GetAllBalances(ctx, receiver).IsZero())
+
+	// the acknowledgements of the packets sent on the channel are checked too
+	sentPacket := channeltypes.NewPacket(data.GetBytes(), 1, testPort2, testChannel2, testPort1, testChannel1, 100, 0)
+	ack := types.FungibleTokenPacketAcknowledgement{Success: true}
+	_, err = handler(ctx, channeltypes.NewMsgAcknowledgement(sentPacket, ack.GetBytes(), commitmenttypes.MerkleProof{}, 1, testAddr1))
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err), "unauthorized relayer acknowledged the packet: %v", err)
+}
+
+// TestRecvPacketsGas tests that relaying a batch of packets consumes the same
+// gas as relaying each of them on its own MsgPacket, and that the gas consumed
+// by the packets that fail to be executed is charged.
+func (suite *HandlerTestSuite) TestRecvPacketsGas() {
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, testAddr1.String(), receiver.String(), "")
+	packets := []channeltypes.Packet{
+		channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0),
+		channeltypes.NewPacket(data.GetBytes(), 2, testPort1, testChannel1, testPort2, testChannel2, 100, 0),
+		// out of order sequence
+		channeltypes.NewPacket(data.GetBytes(), 4, testPort1, testChannel1, testPort2, testChannel2, 100, 0),
+	}
+
+	// recvGas returns the gas consumed by executing the given messages in order
+	recvGas := func(msgs ...sdk.Msg) (gas []uint64) {
+		suite.SetupTest() // reset
+		suite.createRecvChannel(channelexported.ORDERED)
+		handler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+
+		for _, msg := range msgs {
+			ctx := suite.chainA.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+			_, err := handler(ctx, msg)
+			if err == nil {
+				gas = append(gas, ctx.GasMeter().GasConsumed())
+			}
+		}
+		return gas
+	}
+
+	newBatch := func(packets ...channeltypes.Packet) sdk.Msg {
+		proofs := make([]commitmentexported.Proof, len(packets))
+		for i := range proofs {
+			proofs[i] = commitmenttypes.MerkleProof{}
+		}
+		return channeltypes.NewMsgRecvPackets(packets, proofs, 1, testAddr1)
+	}
+
+	singleGas := recvGas(
+		channeltypes.NewMsgPacket(packets[0], commitmenttypes.MerkleProof{}, 1, testAddr1),
+		channeltypes.NewMsgPacket(packets[1], commitmenttypes.MerkleProof{}, 1, testAddr1),
+	)
+	suite.Require().Len(singleGas, 2)
+
+	batchGas := recvGas(newBatch(packets[0], packets[1]))
+	suite.Require().Len(batchGas, 1)
+	suite.Require().Equal(singleGas[0]+singleGas[1], batchGas[0])
+
+	// the gas consumed by the failed packet is charged
+	failedGas := recvGas(newBatch(packets...))
+	suite.Require().Len(failedGas, 1)
+	suite.Require().Greater(failedGas[0], batchGas[0])
+}
+
+// TestErrorCodes tests that the transfer module errors are returned with their
+// registered code by the transfer and IBC handlers.
+func (suite *HandlerTestSuite) TestErrorCodes() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+	suite.createRecvChannel(channelexported.ORDERED)
+
+	transferHandler := transfer.NewHandler(suite.chainA.App.TransferKeeper)
+	ibcHandler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	sentPacket := channeltypes.NewPacket(nil, 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	recvPacket := channeltypes.NewPacket([]byte("invalid data"), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	testCases := []struct {
+		msg      string
+		malleate func(ctx sdk.Context)
+		handler  sdk.Handler
+		sdkMsg   sdk.Msg
+		expErr   *sdkerrors.Error
+	}{
+		{
+			"transfer to the counterparty escrow account",
+			func(sdk.Context) {},
+			transferHandler,
+			transfer.NewMsgTransfer(testPort1, testChannel1, testCoins, sender, types.GetEscrowAddress(testPort2, testChannel2).String(), testTimeoutHeight, 0, ""),
+			types.ErrEscrowReceiver,
+		},
+		{
+			"transfers disabled",
+			func(ctx sdk.Context) {
+				_ = suite.chainA.App.BankKeeper.SetBalances(ctx, sender, testCoins)
+				suite.chainA.App.TransferKeeper.SetParams(ctx, types.NewParams(false, true, nil, nil, false, 0, false, 0, 0))
+			},
+			transferHandler,
+			transfer.NewMsgTransfer(testPort1, testChannel1, sdk.NewCoins(sdk.NewInt64Coin("testportid/secondchannel/atom", 100)), sender, testAddr2.String(), testTimeoutHeight, 0, ""),
+			types.ErrSendDisabled,
+		},
+		{
+			"invalid packet data",
+			func(sdk.Context) {},
+			ibcHandler,
+			channeltypes.NewMsgPacket(recvPacket, commitmenttypes.MerkleProof{}, 1, testAddr1),
+			types.ErrInvalidPacketData,
+		},
+		{
+			"invalid acknowledgement",
+			func(sdk.Context) {},
+			ibcHandler,
+			channeltypes.NewMsgAcknowledgement(sentPacket, []byte("invalid ack"), commitmenttypes.MerkleProof{}, 1, testAddr1),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"channel closed by a user",
+			func(sdk.Context) {},
+			ibcHandler,
+			channeltypes.NewMsgChannelCloseInit(testPort1, testChannel1, testAddr1),
+			types.ErrChannelCloseNotAllowed,
+		},
+	}
+
+	for i, tc := range testCases {
+		ctx, _ := suite.chainA.GetContext().CacheContext()
+		tc.malleate(ctx)
+
+		_, err := tc.handler(ctx, tc.sdkMsg)
+		suite.Require().True(tc.expErr.Is(err), "test case %d: %s: %v", i, tc.msg, err)
+
+		codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+		suite.Require().Equal(types.ModuleName, codespace, "test case %d: %s", i, tc.msg)
+		suite.Require().Equal(tc.expErr.ABCICode(), code, "test case %d: %s", i, tc.msg)
+	}
+}
+
+func (suite *HandlerTestSuite) TestOnChanClose() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper)
+	ctx := suite.chainA.GetContext()
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+
+	err := module.OnChanCloseInit(ctx, testPort1, testChannel1)
+	suite.Require().True(types.ErrChannelCloseNotAllowed.Is(err))
+
+	err = module.OnChanCloseConfirm(ctx, testPort1, testChannel1)
+	suite.Require().NoError(err)
+}
+
+func (suite *HandlerTestSuite) TestOnRecvPacketProtoEncoding() {
+	module := transfer.NewAppModule(suite.chainA.App.TransferKeeper.WithProtoPacketEncoding())
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	channel := suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	channel.Version = types.VersionProto
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, testPort2, testChannel2, channel)
+
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	data := types.NewFungibleTokenPacketData(testPrefixedCoins2, testAddr1.String(), receiver.String(), "")
+
+	// JSON packet data is rejected on a channel using the protobuf encoding
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	_, err = module.OnRecvPacket(ctx, packet, nil)
+	suite.Require().Error(err)
+
+	packet = channeltypes.NewPacket(data.GetProtoBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	res, err := module.OnRecvPacket(ctx, packet, nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	voucher := types.ParseDenomTrace(testPrefixedCoins2[0].Denom).IBCDenom()
+	suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, voucher).Amount)
+}
+
+// TestRecvPacketMockProof tests the proof verification and the execution of
+// received packets built with the testutil helpers.
+func (suite *HandlerTestSuite) TestRecvPacketMockProof() {
+	handler := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	clientKeeper := suite.chainA.App.IBCKeeper.ClientKeeper
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+
+	capName := ibctypes.ChannelCapabilityPath(testPort2, testChannel2)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().Nil(err, "could not create capability")
+	err = suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName)
+	suite.Require().Nil(err, "transfer module could not claim capability")
+
+	ctx := suite.chainA.GetContext()
+	mockClient := testutil.NewMockClient("mockclient", "mockchain")
+	suite.Require().NoError(mockClient.Create(ctx, clientKeeper))
+	connection := suite.chainA.createConnection("mockconnection", "mockconnection", mockClient.ClientID, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.UNORDERED, "mockconnection")
+	prefix := connection.GetCounterparty().GetPrefix()
+
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	builder := testutil.NewPacketBuilder(testPort1, testChannel1, testPort2, testChannel2)
+
+	testCases := []struct {
+		msg      string
+		malleate func(packet channeltypes.Packet) (testutil.MockProof, uint64)
+		expPass  bool
+	}{
+		{"valid proof", func(packet channeltypes.Packet) (testutil.MockProof, uint64) {
+			proof, proofHeight, err := mockClient.PacketCommitmentProof(prefix, packet)
+			suite.Require().NoError(err)
+			return proof, proofHeight
+		}, true},
+		{"valid proof at an advanced client height", func(packet channeltypes.Packet) (testutil.MockProof, uint64) {
+			_, err := mockClient.AdvanceHeight(ctx, clientKeeper, 5)
+			suite.Require().NoError(err)
+			proof, proofHeight, err := mockClient.PacketCommitmentProof(prefix, packet)
+			suite.Require().NoError(err)
+			return proof, proofHeight
+		}, true},
+		{"proof height not reached by the client", func(packet channeltypes.Packet) (testutil.MockProof, uint64) {
+			proof, proofHeight, err := mockClient.PacketCommitmentProof(prefix, packet)
+			suite.Require().NoError(err)
+			return proof, proofHeight + 1
+		}, false},
+		{"proof of another packet", func(packet channeltypes.Packet) (testutil.MockProof, uint64) {
+			packet.Sequence++
+			proof, proofHeight, err := mockClient.PacketCommitmentProof(prefix, packet)
+			suite.Require().NoError(err)
+			return proof, proofHeight
+		}, false},
+	}
+
+	received := int64(0)
+	for i, tc := range testCases {
+		packet := builder.Build(builder.VoucherData(testCoins, testAddr1.String(), receiver.String()))
+		proof, proofHeight := tc.malleate(packet)
+
+		// NOTE: the packet proof is verified by the ante handler
+		_, err := channelKeeper.RecvPacket(ctx, packet, proof, proofHeight)
+		if !tc.expPass {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			continue
+		}
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		_, err = handler(ctx, channeltypes.NewMsgPacket(packet, proof, proofHeight, testAddr1))
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		received += testCoins[0].Amount.Int64()
+	}
+
+	voucher := types.ParseDenomTrace(testPrefixedCoins2[0].Denom).IBCDenom()
+	suite.Require().Equal(received, suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, voucher).Amount.Int64())
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -172,6 +1278,7 @@ func (chain *TestChain) CreateClient(client *TestChain) error {
 	validators := []staking.Validator{validator}
 	histInfo := staking.HistoricalInfo{
 		Header: abci.Header{
+			Time:    client.Header.Time,
 			AppHash: commitID.Hash,
 		},
 		Valset: validators,
@@ -234,6 +1341,7 @@ func (chain *TestChain) updateClient(client *TestChain) {
 	validators := []staking.Validator{validator}
 	histInfo := staking.HistoricalInfo{
 		Header: abci.Header{
+			Time:    client.Header.Time,
 			AppHash: commitID.Hash,
 		},
 		Valset: validators,
@@ -297,6 +1405,93 @@ func (chain *TestChain) createChannel(
 	return channel
 }
 
+// openMockChannel opens an unordered channel between the given channel ends, on
+// a connection to the mock counterparty chain of the client, which is created.
+// The transfer module claims the capability of the channel. It returns the
+// commitment prefix of the counterparty chain.
+func (chain *TestChain) openMockChannel(
+	mockClient *testutil.MockClient, portID, channelID, counterpartyPortID, counterpartyChannelID string,
+) commitmentexported.Prefix {
+	ctx := chain.GetContext()
+	if err := mockClient.Create(ctx, chain.App.IBCKeeper.ClientKeeper); err != nil {
+		panic(err)
+	}
+
+	connectionID := "connection" + mockClient.ClientID
+	connection := chain.createConnection(connectionID, connectionID, mockClient.ClientID, chain.ClientID, connectionexported.OPEN)
+	chain.createChannel(portID, channelID, counterpartyPortID, counterpartyChannelID, channelexported.OPEN, channelexported.UNORDERED, connectionID)
+	chain.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, portID, channelID, 1)
+	chain.App.IBCKeeper.ChannelKeeper.SetNextSequenceRecv(ctx, portID, channelID, 1)
+
+	capName := ibctypes.ChannelCapabilityPath(portID, channelID)
+	cap, err := chain.App.ScopedIBCKeeper.NewCapability(ctx, capName)
+	if err != nil {
+		panic(err)
+	}
+	if err := chain.App.ScopedTransferKeeper.ClaimCapability(ctx, cap, capName); err != nil {
+		panic(err)
+	}
+
+	return connection.GetCounterparty().GetPrefix()
+}
+
+// deliverMsg delivers the IBC message like a transaction would: the message is
+// validated, its proof is verified by the ante handler and it's then handled.
+// It returns the events of the handler result.
+func (chain *TestChain) deliverMsg(msg sdk.Msg) (sdk.Events, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := chain.GetContext()
+	anteHandler := sdk.ChainAnteDecorators(ante.NewProofVerificationDecorator(
+		chain.App.IBCKeeper.ClientKeeper, chain.App.IBCKeeper.ChannelKeeper,
+	))
+	if _, err := anteHandler(ctx, authtypes.StdTx{Msgs: []sdk.Msg{msg}}, false); err != nil {
+		return nil, err
+	}
+
+	res, err := ibc.NewHandler(*chain.App.IBCKeeper)(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(sdk.Events, len(res.Events))
+	for i, event := range res.Events {
+		events[i] = sdk.Event(event)
+	}
+	return events, nil
+}
+
+func queryProof(chain *TestChain, key []byte) (commitmenttypes.MerkleProof, uint64) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", ibctypes.StoreKey),
+		Height: chain.App.LastBlockHeight(),
+		Data:   key,
+		Prove:  true,
+	})
+
+	proof := commitmenttypes.MerkleProof{
+		Proof: res.Proof,
+	}
+
+	return proof, uint64(res.Height)
+}
+
+func prefixedClientKey(clientID string, key []byte) []byte {
+	return append([]byte("clients/"+clientID+"/"), key...)
+}
+
+// bindPort binds the given port and claims its capability for the transfer
+// module.
+func (chain *TestChain) bindPort(portID string) {
+	ctx := chain.GetContext()
+	cap := chain.App.IBCKeeper.PortKeeper.BindPort(ctx, portID)
+	if err := chain.App.TransferKeeper.ClaimCapability(ctx, cap, porttypes.PortPath(portID)); err != nil {
+		panic(err)
+	}
+}
+
 func nextHeader(chain *TestChain) ibctmtypes.Header {
 	return ibctmtypes.CreateTestHeader(chain.Header.ChainID, chain.Header.Height+1,
 		chain.Header.Time.Add(time.Minute), chain.Vals, chain.Signers)
@@ -0,0 +1,179 @@
+package transfer_test
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	transfer "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// relayer signs the handshake and packet messages relayed between the test
+// chains
+var relayer = sdk.AccAddress(crypto.AddressHash([]byte("relayer")))
+
+// setupTransferChannel creates the clients of chain A and chain B on each other,
+// then runs the connection and channel handshakes through the IBC handlers of
+// both chains, with the proofs of the committed state of their counterparty. It
+// returns the open transfer channel ends of chain A and chain B, which are bound
+// to the transfer port of each chain.
+func (suite *HandlerTestSuite) setupTransferChannel(
+	connectionID, channelIDA, channelIDB string, order channelexported.Order,
+) (channeltypes.IdentifiedChannel, channeltypes.IdentifiedChannel) {
+	suite.Require().NoError(suite.chainA.CreateClient(suite.chainB))
+	suite.Require().NoError(suite.chainB.CreateClient(suite.chainA))
+
+	suite.openConnection(connectionID)
+	return suite.openTransferChannel(connectionID, channelIDA, channelIDB, order)
+}
+
+// openConnection runs the connection handshake between chain A and chain B,
+// which use the same connection identifier. The clients of the chains must
+// exist.
+func (suite *HandlerTestSuite) openConnection(connectionID string) {
+	handlerA := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	handlerB := ibc.NewHandler(*suite.chainB.App.IBCKeeper)
+	prefixA := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix()
+	prefixB := suite.chainB.App.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix()
+
+	msgInit := connectiontypes.NewMsgConnectionOpenInit(
+		connectionID, testClientIDB, connectionID, testClientIDA, prefixB, relayer,
+	)
+	_, err := handlerA(suite.chainA.GetContext(), msgInit)
+	suite.Require().NoError(err, "connection open init failed")
+
+	// the consensus state of each chain is proven at the latest height stored by
+	// the client of its counterparty
+	suite.chainA.updateClient(suite.chainB)
+	suite.chainB.updateClient(suite.chainA)
+	proofInit, proofHeight := queryProof(suite.chainA, ibctypes.KeyConnection(connectionID))
+	consensusHeight := uint64(suite.chainB.Header.Height)
+	proofConsensus, _ := queryProof(suite.chainA, prefixedClientKey(testClientIDB, ibctypes.KeyConsensusState(consensusHeight)))
+	msgTry := connectiontypes.NewMsgConnectionOpenTry(
+		connectionID, testClientIDA, connectionID, testClientIDB, prefixA, connectiontypes.GetCompatibleVersions(),
+		proofInit, proofConsensus, proofHeight+1, consensusHeight, relayer,
+	)
+	_, err = handlerB(suite.chainB.GetContext(), msgTry)
+	suite.Require().NoError(err, "connection open try failed")
+
+	suite.chainB.updateClient(suite.chainA)
+	suite.chainA.updateClient(suite.chainB)
+	proofTry, proofHeight := queryProof(suite.chainB, ibctypes.KeyConnection(connectionID))
+	consensusHeight = uint64(suite.chainA.Header.Height)
+	proofConsensus, _ = queryProof(suite.chainB, prefixedClientKey(testClientIDA, ibctypes.KeyConsensusState(consensusHeight)))
+	msgAck := connectiontypes.NewMsgConnectionOpenAck(
+		connectionID, proofTry, proofConsensus, proofHeight+1, consensusHeight,
+		connectiontypes.GetCompatibleVersions()[0], relayer,
+	)
+	_, err = handlerA(suite.chainA.GetContext(), msgAck)
+	suite.Require().NoError(err, "connection open ack failed")
+
+	suite.chainB.updateClient(suite.chainA)
+	proofAck, proofHeight := queryProof(suite.chainA, ibctypes.KeyConnection(connectionID))
+	msgConfirm := connectiontypes.NewMsgConnectionOpenConfirm(connectionID, proofAck, proofHeight+1, relayer)
+	_, err = handlerB(suite.chainB.GetContext(), msgConfirm)
+	suite.Require().NoError(err, "connection open confirm failed")
+}
+
+// openTransferChannel runs the handshake of a channel between the transfer ports
+// of chain A and chain B over the given open connection, and returns the channel
+// ends of both chains.
+func (suite *HandlerTestSuite) openTransferChannel(
+	connectionID, channelIDA, channelIDB string, order channelexported.Order,
+) (channeltypes.IdentifiedChannel, channeltypes.IdentifiedChannel) {
+	handlerA := ibc.NewHandler(*suite.chainA.App.IBCKeeper)
+	handlerB := ibc.NewHandler(*suite.chainB.App.IBCKeeper)
+	portIDA := suite.chainA.App.TransferKeeper.GetPort(suite.chainA.GetContext())
+	portIDB := suite.chainB.App.TransferKeeper.GetPort(suite.chainB.GetContext())
+
+	msgInit := channeltypes.NewMsgChannelOpenInit(
+		portIDA, channelIDA, types.Version, order, []string{connectionID}, portIDB, channelIDB, relayer,
+	)
+	_, err := handlerA(suite.chainA.GetContext(), msgInit)
+	suite.Require().NoError(err, "channel open init failed")
+
+	suite.chainB.updateClient(suite.chainA)
+	proof, proofHeight := queryProof(suite.chainA, ibctypes.KeyChannel(portIDA, channelIDA))
+	msgTry := channeltypes.NewMsgChannelOpenTry(
+		portIDB, channelIDB, types.Version, order, []string{connectionID},
+		portIDA, channelIDA, types.Version, proof, proofHeight+1, relayer,
+	)
+	_, err = handlerB(suite.chainB.GetContext(), msgTry)
+	suite.Require().NoError(err, "channel open try failed")
+
+	suite.chainA.updateClient(suite.chainB)
+	proof, proofHeight = queryProof(suite.chainB, ibctypes.KeyChannel(portIDB, channelIDB))
+	msgAck := channeltypes.NewMsgChannelOpenAck(portIDA, channelIDA, types.Version, proof, proofHeight+1, relayer)
+	_, err = handlerA(suite.chainA.GetContext(), msgAck)
+	suite.Require().NoError(err, "channel open ack failed")
+
+	suite.chainB.updateClient(suite.chainA)
+	proof, proofHeight = queryProof(suite.chainA, ibctypes.KeyChannel(portIDA, channelIDA))
+	msgConfirm := channeltypes.NewMsgChannelOpenConfirm(portIDB, channelIDB, proof, proofHeight+1, relayer)
+	_, err = handlerB(suite.chainB.GetContext(), msgConfirm)
+	suite.Require().NoError(err, "channel open confirm failed")
+
+	channelA, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), portIDA, channelIDA)
+	suite.Require().True(found)
+	channelB, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetChannel(suite.chainB.GetContext(), portIDB, channelIDB)
+	suite.Require().True(found)
+
+	return channeltypes.IdentifiedChannel{Channel: channelA, PortIdentifier: portIDA, ChannelIdentifier: channelIDA},
+		channeltypes.IdentifiedChannel{Channel: channelB, PortIdentifier: portIDB, ChannelIdentifier: channelIDB}
+}
+
+// relayPacket relays a packet sent on chain A to chain B, along with the proof
+// of its commitment on chain A.
+func (suite *HandlerTestSuite) relayPacket(packet channeltypes.Packet) (*sdk.Result, error) {
+	suite.chainB.updateClient(suite.chainA)
+	proof, proofHeight := queryProof(
+		suite.chainA, ibctypes.KeyPacketCommitment(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()),
+	)
+
+	// NOTE: the packet proof is verified by the ante handler
+	ctx := suite.chainB.GetContext()
+	if _, err := suite.chainB.App.IBCKeeper.ChannelKeeper.RecvPacket(ctx, packet, proof, proofHeight+1); err != nil {
+		return nil, err
+	}
+
+	handler := ibc.NewHandler(*suite.chainB.App.IBCKeeper)
+	return handler(ctx, channeltypes.NewMsgPacket(packet, proof, proofHeight+1, relayer))
+}
+
+func (suite *HandlerTestSuite) TestTransferOverHandshakeChannel() {
+	channelA, channelB := suite.setupTransferChannel(testConnection, testChannel1, testChannel2, channelexported.UNORDERED)
+	suite.Require().Equal(channelexported.OPEN, channelA.Channel.State)
+	suite.Require().Equal(channelexported.OPEN, channelB.Channel.State)
+
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	ctxA := suite.chainA.GetContext()
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctxA, testAddr1, testCoins)
+	msg := transfer.NewMsgTransfer(
+		channelA.PortIdentifier, channelA.ChannelIdentifier, testCoins, testAddr1, receiver.String(), testTimeoutHeight, 0, "",
+	)
+	_, err := transfer.NewHandler(suite.chainA.App.TransferKeeper)(ctxA, msg)
+	suite.Require().NoError(err)
+
+	// the tokens are sent with the prefix of the channel end of chain B
+	prefix := types.GetDenomPrefix(channelB.PortIdentifier, channelB.ChannelIdentifier)
+	amount := sdk.NewCoins(sdk.NewCoin(prefix+testCoins[0].Denom, testCoins[0].Amount))
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacketWithTimeoutHeight(
+		data.GetBytes(), 1, channelA.PortIdentifier, channelA.ChannelIdentifier,
+		channelB.PortIdentifier, channelB.ChannelIdentifier, testTimeoutHeight, 0,
+	)
+	commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctxA, packet.SourcePort, packet.SourceChannel, 1)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+
+	_, err = suite.relayPacket(packet)
+	suite.Require().NoError(err)
+
+	voucherDenom := types.ParseDenomTrace(amount[0].Denom).IBCDenom()
+	balance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+	suite.Require().Equal(testCoins[0].Amount, balance.Amount)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// CancelTransfer cancels a transfer that wasn't received on the destination
+// chain and refunds its tokens, as it would be on a timeout. Only the sender of
+// the transfer can cancel it.
+//
+// The proof must show that the packet wasn't received on the destination chain
+// at the proof height, at which the packet must have timed out. Otherwise, the
+// packet could still be received with a proof of its commitment queried before
+// the cancellation, and the tokens would be both refunded and received. The
+// packet commitment is deleted before the refund, so that the packet can't be
+// acknowledged, timed out or canceled again afterwards.
+func (k Keeper) CancelTransfer(
+	ctx sdk.Context,
+	packet channel.Packet,
+	proof commitmentexported.Proof,
+	proofHeight,
+	nextSequenceRecv uint64,
+	sender sdk.AccAddress,
+) error {
+	data, err := k.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	if data.Sender != sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of the transfer", sender)
+	}
+
+	// verifies that the packet was sent through the channel, that it timed out
+	// and that it wasn't received at the proof height
+	if _, err := k.channelKeeper.TimeoutPacket(ctx, packet, proof, proofHeight, nextSequenceRecv); err != nil {
+		return sdkerrors.Wrap(err, "cannot cancel the transfer")
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, ibctypes.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel()))
+	if !ok {
+		return sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := k.channelKeeper.TimeoutExecuted(ctx, channelCap, packet); err != nil {
+		return err
+	}
+	k.DeletePacketSendHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	// nobody relayed the timeout, so the timeout fees are refunded as well
+	if err := k.DistributePacketFeesOnTimeout(ctx, packet, nil); err != nil {
+		return err
+	}
+
+	if err := k.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySourcePort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.GetRefundAddress()),
+			sdk.NewAttribute(types.AttributeKeyRefundValue, data.Amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestCancelTransfer() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	amount := sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
+	data := types.NewFungibleTokenPacketData(amount, sender.String(), testAddr2.String(), "")
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+	ackKey := ibctypes.KeyPacketAcknowledgement(testPort2, testChannel2, 1)
+
+	var (
+		timeoutHeight uint64
+		signer        sdk.AccAddress
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"packet already received", func() {
+			suite.chainB.App.IBCKeeper.ChannelKeeper.SetPacketAcknowledgement(
+				suite.chainB.GetContext(), testPort2, testChannel2, 1, channeltypes.CommitAcknowledgement([]byte("ack")),
+			)
+		}, false},
+		{"timeout height not reached", func() {
+			timeoutHeight = 1000
+		}, false},
+		{"signer is not the sender", func() {
+			signer = sdk.AccAddress(crypto.AddressHash([]byte("other")))
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			timeoutHeight = 1
+			signer = sender
+
+			suite.chainA.CreateClient(suite.chainB)
+			suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+			capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+			cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+			suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, timeoutHeight, 0)
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 1, channeltypes.CommitPacket(packet))
+			_ = suite.chainA.App.BankKeeper.SetBalances(ctx, escrow, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+			// proof of the acknowledgement absence on the destination chain
+			suite.chainA.updateClient(suite.chainB)
+			res := suite.chainB.App.Query(abci.RequestQuery{
+				Path:   fmt.Sprintf("store/%s/key", ibctypes.StoreKey),
+				Height: suite.chainB.App.LastBlockHeight(),
+				Data:   ackKey,
+				Prove:  true,
+			})
+			proof := commitmenttypes.MerkleProof{Proof: res.Proof}
+			proofHeight := uint64(res.Height) + 1
+
+			err = suite.chainA.App.TransferKeeper.CancelTransfer(ctx, packet, proof, proofHeight, 0, signer)
+			if !tc.expPass {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+				suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "atom").IsZero())
+				suite.Require().NotEmpty(suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1))
+				return
+			}
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "atom").Amount)
+			suite.Require().Empty(suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1))
+
+			events := ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeCancel, events[len(events)-1].Type)
+
+			// the transfer can't be canceled twice
+			suite.Require().Error(suite.chainA.App.TransferKeeper.CancelTransfer(ctx, packet, proof, proofHeight, 0, signer))
+			suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "atom").Amount)
+		})
+	}
+}
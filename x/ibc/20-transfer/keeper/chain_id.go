@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connection "github.com/cosmos/cosmos-sdk/x/ibc/03-connection"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// WithChainIDDenomTraces returns a copy of the keeper that includes the chain ID
+// of the counterparty chain in the denomination traces of the vouchers it mints.
+// The chain ID is resolved from the client of the channel the vouchers are
+// received on, so the connection and client keepers are required.
+//
+// The voucher denomination (i.e ibc/{hash}) is derived from the chain ID and the
+// trace path, so that vouchers of the same path received from different chains
+// are never fungible. The tradeoff is that tokens which are fungible on their
+// source chain are not fungible here if they were received over channels of
+// different clients of that chain, and that the voucher denominations differ
+// from the ones of chains using the default scheme.
+//
+// NOTE: the packet data isn't affected, since the chain ID is only part of the
+// local trace. Traces stored before the scheme was enabled can be migrated with
+// MigrateDenomTraces.
+func (k Keeper) WithChainIDDenomTraces(connectionKeeper types.ConnectionKeeper, clientKeeper types.ClientKeeper) Keeper {
+	k = k.WithClientKeepers(connectionKeeper, clientKeeper)
+	k.chainIDDenomTraces = true
+	return k
+}
+
+// WithClientKeepers returns a copy of the keeper with the connection and client
+// keepers used to resolve the client of a channel, which is required to refund
+// stuck packets (see RefundStuckPacket).
+func (k Keeper) WithClientKeepers(connectionKeeper types.ConnectionKeeper, clientKeeper types.ClientKeeper) Keeper {
+	k.connectionKeeper = connectionKeeper
+	k.clientKeeper = clientKeeper
+	return k
+}
+
+// GetCounterpartyChainID returns the chain ID of the client of the given channel.
+func (k Keeper) GetCounterpartyChainID(ctx sdk.Context, portID, channelID string) (string, error) {
+	clientState, err := k.getChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+	return clientState.GetChainID(), nil
+}
+
+// GetChannelClientState returns the client backing the given channel, i.e the
+// client of its first connection hop, along with the connection hops of the
+// channel. It returns a not found error if the channel, its connection or its
+// client doesn't exist.
+//
+// CONTRACT: the connection and client keepers must be set (see WithClientKeepers).
+func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (types.ChannelClientState, error) {
+	if k.connectionKeeper == nil || k.clientKeeper == nil {
+		return types.ChannelClientState{}, types.ErrClientKeepersNotSet
+	}
+
+	channelEnd, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.ChannelClientState{}, sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channelEnd.ConnectionHops[0])
+	if !found {
+		return types.ChannelClientState{}, sdkerrors.Wrap(connection.ErrConnectionNotFound, channelEnd.ConnectionHops[0])
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+	if !found {
+		return types.ChannelClientState{}, sdkerrors.Wrap(clienttypes.ErrClientNotFound, connectionEnd.GetClientID())
+	}
+
+	return types.NewChannelClientState(portID, channelID, channelEnd.ConnectionHops, clientState), nil
+}
+
+// getChannelClientState returns the state of the client of the given channel
+// (see GetChannelClientState).
+func (k Keeper) getChannelClientState(ctx sdk.Context, portID, channelID string) (clientexported.ClientState, error) {
+	channelClientState, err := k.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+	return channelClientState.ClientState, nil
+}
+
+// voucherTrace returns the denomination trace of the given full denomination
+// path held on this chain, with the chain ID of the first channel of its path
+// if the chain ID scheme is enabled.
+func (k Keeper) voucherTrace(ctx sdk.Context, fullDenomPath string) (types.DenomTrace, error) {
+	denomTrace := types.ParseDenomTrace(fullDenomPath)
+	if !k.chainIDDenomTraces || denomTrace.Path == "" {
+		return denomTrace, nil
+	}
+
+	pathSplit := strings.SplitN(denomTrace.Path, "/", 3)
+	if len(pathSplit) < 2 {
+		return types.DenomTrace{}, sdkerrors.Wrapf(types.ErrInvalidDenomTrace, "invalid path %s", denomTrace.Path)
+	}
+
+	chainID, err := k.GetCounterpartyChainID(ctx, pathSplit[0], pathSplit[1])
+	if err != nil {
+		return types.DenomTrace{}, sdkerrors.Wrapf(err, "failed to resolve the chain ID of %s", fullDenomPath)
+	}
+
+	return denomTrace.WithChainID(chainID), nil
+}
+
+// voucherDenom returns the denomination under which the given full denomination
+// path is held on this chain (see voucherTrace).
+func (k Keeper) voucherDenom(ctx sdk.Context, fullDenomPath string) (string, error) {
+	denomTrace, err := k.voucherTrace(ctx, fullDenomPath)
+	if err != nil {
+		return "", err
+	}
+	return k.traceDenom(denomTrace), nil
+}
+
+// escrowedDenom returns the denomination under which the given full denomination
+// path is escrowed by a channel. Vouchers escrowed before the chain ID scheme was
+// enabled keep their legacy denomination, which is returned if the channel
+// escrow doesn't hold enough of the chain ID aware one.
+func (k Keeper) escrowedDenom(ctx sdk.Context, portID, channelID, fullDenomPath string, amount sdk.Int) (string, error) {
+	denom, err := k.voucherDenom(ctx, fullDenomPath)
+	if err != nil {
+		return "", err
+	}
+
+	legacyDenom := k.traceDenom(types.ParseDenomTrace(fullDenomPath))
+	if denom == legacyDenom {
+		return denom, nil
+	}
+
+	escrowAddress := k.GetEscrowAddress(ctx, portID, channelID)
+	if k.bankKeeper.GetBalance(ctx, escrowAddress, denom).Amount.LT(amount) &&
+		k.bankKeeper.GetBalance(ctx, escrowAddress, legacyDenom).Amount.GTE(amount) {
+		return legacyDenom, nil
+	}
+	return denom, nil
+}
+
+// MigrateDenomTraces stores the chain ID aware denomination trace of each of the
+// stored traces without a chain ID. It returns the number of migrated traces.
+//
+// The legacy traces are kept, so that the vouchers minted with their denomination
+// can still be sent back to their source chain. Those vouchers are not fungible
+// with the ones minted after the migration though, and refunds are minted with
+// the chain ID aware denomination.
+//
+// CONTRACT: the chain ID scheme must be enabled (see WithChainIDDenomTraces).
+func (k Keeper) MigrateDenomTraces(ctx sdk.Context) (int, error) {
+	if !k.chainIDDenomTraces {
+		return 0, sdkerrors.Wrap(types.ErrInvalidDenomTrace, "the chain ID denomination trace scheme is not enabled")
+	}
+
+	migrated := 0
+	for _, denomTrace := range k.GetAllDenomTraces(ctx) {
+		if denomTrace.ChainID != "" {
+			continue
+		}
+
+		chainTrace, err := k.voucherTrace(ctx, denomTrace.GetFullDenomPath())
+		if err != nil {
+			return migrated, err
+		}
+
+		if !k.HasDenomTrace(ctx, k.DenomTraceHash(chainTrace)) {
+			k.SetDenomTrace(ctx, chainTrace)
+			migrated++
+		}
+	}
+
+	return migrated, nil
+}
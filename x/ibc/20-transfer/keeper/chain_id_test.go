@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// chainIDTransferKeeper returns the transfer keeper of chainA with the chain ID
+// denomination trace scheme enabled, along with a channel to chainB.
+func (suite *KeeperTestSuite) chainIDTransferKeeper() keeper.Keeper {
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	return suite.chainA.App.TransferKeeper.WithChainIDDenomTraces(
+		suite.chainA.App.IBCKeeper.ConnectionKeeper, suite.chainA.App.IBCKeeper.ClientKeeper,
+	)
+}
+
+func (suite *KeeperTestSuite) TestGetCounterpartyChainID() {
+	transferKeeper := suite.chainIDTransferKeeper()
+	ctx := suite.chainA.GetContext()
+
+	chainID, err := transferKeeper.GetCounterpartyChainID(ctx, testPort2, testChannel2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.Header.ChainID, chainID)
+
+	_, err = transferKeeper.GetCounterpartyChainID(ctx, testPort2, "unknownchannel")
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketChainIDDenomTraces() {
+	transferKeeper := suite.chainIDTransferKeeper()
+	ctx := suite.chainA.GetContext()
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	suite.Require().NoError(transferKeeper.OnRecvPacket(ctx, packet, data))
+
+	// the vouchers are minted with the chain ID aware denomination
+	legacyTrace := types.ParseDenomTrace("testportid/secondchannel/atom")
+	expTrace := legacyTrace.WithChainID(suite.chainB.Header.ChainID)
+	suite.Require().NotEqual(legacyTrace.IBCDenom(), expTrace.IBCDenom())
+	suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, expTrace.IBCDenom()).Amount)
+	suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, legacyTrace.IBCDenom()).IsZero())
+
+	denomTrace, found := transferKeeper.GetDenomTrace(ctx, expTrace.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(expTrace, denomTrace)
+
+	// the full path is resolved from the hashed denomination on send
+	fullDenomPath, err := transferKeeper.DenomPathFromHash(ctx, expTrace.IBCDenom())
+	suite.Require().NoError(err)
+	suite.Require().Equal("testportid/secondchannel/atom", fullDenomPath)
+}
+
+func (suite *KeeperTestSuite) TestMigrateDenomTraces() {
+	ctx := suite.chainA.GetContext()
+	legacyTrace := types.ParseDenomTrace("testportid/secondchannel/atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, legacyTrace)
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, types.ParseDenomTrace("atom"))
+
+	// the migration requires the chain ID scheme
+	_, err := suite.chainA.App.TransferKeeper.MigrateDenomTraces(ctx)
+	suite.Require().Error(err)
+
+	transferKeeper := suite.chainIDTransferKeeper()
+	ctx = suite.chainA.GetContext()
+
+	migrated, err := transferKeeper.MigrateDenomTraces(ctx)
+	suite.Require().NoError(err)
+	// native denominations don't have a chain ID
+	suite.Require().Equal(1, migrated)
+
+	// the legacy trace is kept along with the chain ID aware one
+	suite.Require().True(transferKeeper.HasDenomTrace(ctx, legacyTrace.Hash()))
+	suite.Require().True(transferKeeper.HasDenomTrace(ctx, legacyTrace.WithChainID(suite.chainB.Header.ChainID).Hash()))
+
+	// migrating twice is a no-op
+	migrated, err = transferKeeper.MigrateDenomTraces(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, migrated)
+}
+
+func (suite *KeeperTestSuite) TestGetDenomTraceByPathChainIDDenomTraces() {
+	transferKeeper := suite.chainIDTransferKeeper()
+	ctx := suite.chainA.GetContext()
+
+	// the legacy trace is returned until the chain ID aware one is stored
+	legacyTrace := types.ParseDenomTrace("testportid/secondchannel/transfer/channeltoc/atom")
+	transferKeeper.SetDenomTrace(ctx, legacyTrace)
+
+	denomTrace, found := transferKeeper.GetDenomTraceByPath(ctx, legacyTrace.Path, legacyTrace.BaseDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(legacyTrace, denomTrace)
+
+	expTrace := legacyTrace.WithChainID(suite.chainB.Header.ChainID)
+	transferKeeper.SetDenomTrace(ctx, expTrace)
+
+	denomTrace, found = transferKeeper.GetDenomTraceByPath(ctx, legacyTrace.Path, legacyTrace.BaseDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(expTrace, denomTrace)
+
+	// the chain ID of a path starting with an unknown channel can't be resolved
+	_, found = transferKeeper.GetDenomTraceByPath(ctx, "testportid/unknownchannel", "atom")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketLegacyEscrowedDenom() {
+	transferKeeper := suite.chainIDTransferKeeper()
+	ctx := suite.chainA.GetContext()
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+
+	// vouchers escrowed before the chain ID scheme was enabled
+	legacyTrace := types.ParseDenomTrace("testportid/otherchannel/atom")
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, escrow, sdk.NewCoins(sdk.NewCoin(legacyTrace.IBCDenom(), sdk.NewInt(100))))
+	suite.chainA.createChannel(testPort2, "otherchannel", testPort1, "othercpchannel", channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	amount := sdk.NewCoins(sdk.NewCoin("bank/firstchannel/testportid/otherchannel/atom", sdk.NewInt(100)))
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	suite.Require().NoError(transferKeeper.OnRecvPacket(ctx, packet, data))
+
+	suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, legacyTrace.IBCDenom()).Amount)
+}
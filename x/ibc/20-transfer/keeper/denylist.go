@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// WithSendDenylist returns a copy of the keeper that rejects the transfers of
+// the given denominations out of the chain, e.g to prevent the staking token
+// from being bridged. The denominations are the ones held on chain, i.e the
+// hashed denomination for IBC vouchers. The denylist is empty by default.
+func (k Keeper) WithSendDenylist(denoms ...string) Keeper {
+	denylist := make([]string, len(denoms))
+	copy(denylist, denoms)
+	sort.Strings(denylist)
+
+	k.sendDenylist = denylist
+	return k
+}
+
+// GetSendDenylist returns the denominations that can't be transferred out of the
+// chain, sorted alphabetically.
+func (k Keeper) GetSendDenylist() []string {
+	denylist := make([]string, len(k.sendDenylist))
+	copy(denylist, k.sendDenylist)
+	return denylist
+}
+
+// IsSendDenied returns true if the denomination can't be transferred out of the
+// chain.
+func (k Keeper) IsSendDenied(denom string) bool {
+	i := sort.SearchStrings(k.sendDenylist, denom)
+	return i < len(k.sendDenylist) && k.sendDenylist[i] == denom
+}
+
+// checkSendDenylist returns an error if any of the coins sent can't be
+// transferred out of the chain.
+func (k Keeper) checkSendDenylist(coins sdk.Coins) error {
+	for _, coin := range coins {
+		if k.IsSendDenied(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrDenomNotTransferable, "%s is on the send denylist", coin.Denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestSendDenylist() {
+	transferKeeper := suite.chainA.App.TransferKeeper
+	suite.Require().Empty(transferKeeper.GetSendDenylist())
+	suite.Require().False(transferKeeper.IsSendDenied("stake"))
+
+	transferKeeper = transferKeeper.WithSendDenylist("stake", "atom")
+	suite.Require().Equal([]string{"atom", "stake"}, transferKeeper.GetSendDenylist())
+	suite.Require().True(transferKeeper.IsSendDenied("stake"))
+	suite.Require().False(transferKeeper.IsSendDenied("photon"))
+
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.setupTransferChannel(testPort1, testChannel1, testPort2, testChannel2)
+
+	ctx := suite.chainA.GetContext()
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("photon", 100)))
+
+	err := transferKeeper.SendTransfer(ctx, testPort1, testChannel1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sender, testAddr2.String(), testTimeoutHeight, 0, "")
+	suite.Require().True(types.ErrDenomNotTransferable.Is(err), "denied denomination was sent: %v", err)
+	suite.Require().Contains(err.Error(), "stake")
+
+	// the prefixed denomination is resolved before it is checked
+	err = transferKeeper.SendTransfer(ctx, testPort1, testChannel1, sdk.NewCoins(sdk.NewInt64Coin("testportid/secondchannel/stake", 10)), sender, testAddr2.String(), testTimeoutHeight, 0, "")
+	suite.Require().True(types.ErrDenomNotTransferable.Is(err), "denied denomination was sent: %v", err)
+	suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "stake").Amount)
+
+	err = transferKeeper.SendTransfer(ctx, testPort1, testChannel1, sdk.NewCoins(sdk.NewInt64Coin("testportid/secondchannel/photon", 10)), sender, testAddr2.String(), testTimeoutHeight, 0, "")
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerySendDenylist() {
+	ctx := suite.chainA.GetContext()
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QuerySendDenylist}, "/"),
+	}
+
+	testCases := []struct {
+		msg         string
+		denylist    []string
+		expDenylist []string
+	}{
+		{"empty denylist", nil, nil},
+		{"denylist", []string{"stake", "atom"}, []string{"atom", "stake"}},
+	}
+
+	for i, tc := range testCases {
+		querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper.WithSendDenylist(tc.denylist...))
+
+		bz, err := querier(ctx, []string{types.QuerySendDenylist}, query)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var denylist []string
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &denylist))
+		suite.Require().Equal(tc.expDenylist, denylist, "valid test case %d failed: %s", i, tc.msg)
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// WithProtoPacketEncoding returns a copy of the keeper that supports the
+// protobuf packet data encoding. Channels opened with the VersionProto version
+// encode their fungible token packet data with protobuf, while the other
+// channels fall back to JSON.
+func (k Keeper) WithProtoPacketEncoding() Keeper {
+	k.protoPacketEncoding = true
+	return k
+}
+
+// GetPacketEncoding returns the packet data encoding negotiated on the channel
+// handshake. It defaults to JSON if the channel is not found.
+func (k Keeper) GetPacketEncoding(ctx sdk.Context, portID, channelID string) types.PacketEncoding {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.PacketEncodingJSON
+	}
+	return types.GetPacketEncoding(channel.Version)
+}
+
+// UnmarshalPacketData decodes the fungible token packet data sent over the given
+// channel end with its negotiated encoding.
+func (k Keeper) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (types.FungibleTokenPacketData, error) {
+	return types.DecodePacketData(bz, k.GetPacketEncoding(ctx, portID, channelID))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// GetEscrowAddressVersion returns the escrow address version recorded for a
+// channel.
+func (k Keeper) GetEscrowAddressVersion(ctx sdk.Context, portID, channelID string) (types.EscrowAddressVersion, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEscrowAddressVersion(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return types.EscrowAddressVersion(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetEscrowAddressVersion records the escrow address version of a channel.
+func (k Keeper) SetEscrowAddressVersion(ctx sdk.Context, portID, channelID string, version types.EscrowAddressVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyEscrowAddressVersion(portID, channelID), sdk.Uint64ToBigEndian(uint64(version)))
+}
+
+// GetEscrowAddress returns the escrow address of a channel, derived with the
+// version recorded when the channel was opened. The channels without a recorded
+// version use the legacy escrow address, so that the funds escrowed before the
+// escrow addresses were versioned are never lost.
+func (k Keeper) GetEscrowAddress(ctx sdk.Context, portID, channelID string) sdk.AccAddress {
+	version, found := k.GetEscrowAddressVersion(ctx, portID, channelID)
+	if !found {
+		version = types.EscrowAddressVersionLegacy
+	}
+
+	return types.GetVersionedEscrowAddress(version, portID, channelID)
+}
+
+// GetAllEscrowAddressVersions returns the escrow address versions recorded for
+// all the channels.
+func (k Keeper) GetAllEscrowAddressVersions(ctx sdk.Context) []types.ChannelEscrowAddressVersion {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EscrowAddressVersionKeyPrefix)
+	defer iterator.Close()
+
+	escrowVersions := []types.ChannelEscrowAddressVersion{}
+	for ; iterator.Valid(); iterator.Next() {
+		// the identifiers can't contain the "/" separator
+		channel := strings.SplitN(string(iterator.Key()[len(types.EscrowAddressVersionKeyPrefix):]), "/", 2)
+		version := types.EscrowAddressVersion(sdk.BigEndianToUint64(iterator.Value()))
+		escrowVersions = append(escrowVersions, types.NewChannelEscrowAddressVersion(channel[0], channel[1], version))
+	}
+
+	return escrowVersions
+}
+
+// MigrateEscrowAddressVersions records the legacy escrow address version for
+// each of the channels of the transfer port that were opened before the
+// escrow addresses were versioned. It returns the number of migrated channels.
+func (k Keeper) MigrateEscrowAddressVersions(ctx sdk.Context) int {
+	migrated := 0
+	for _, ch := range k.GetTransferChannels(ctx) {
+		if _, found := k.GetEscrowAddressVersion(ctx, ch.PortIdentifier, ch.ChannelIdentifier); found {
+			continue
+		}
+
+		k.SetEscrowAddressVersion(ctx, ch.PortIdentifier, ch.ChannelIdentifier, types.EscrowAddressVersionLegacy)
+		migrated++
+	}
+
+	return migrated
+}
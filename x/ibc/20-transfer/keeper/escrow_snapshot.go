@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// GetEscrowSnapshot returns the latest snapshot of the escrow balances taken at
+// or before the given height.
+func (k Keeper) GetEscrowSnapshot(ctx sdk.Context, height uint64) (types.EscrowSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := types.KeyEscrowSnapshot(height + 1)
+	if height == ^uint64(0) {
+		end = sdk.PrefixEndBytes(types.EscrowSnapshotKeyPrefix)
+	}
+
+	iterator := store.ReverseIterator(types.EscrowSnapshotKeyPrefix, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.EscrowSnapshot{}, false
+	}
+
+	var snapshot types.EscrowSnapshot
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// SetEscrowSnapshot stores a snapshot of the escrow balances under its height.
+func (k Keeper) SetEscrowSnapshot(ctx sdk.Context, snapshot types.EscrowSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(snapshot)
+	store.Set(types.KeyEscrowSnapshot(snapshot.Height), bz)
+}
+
+// SnapshotEscrowBalances records the balances held by the escrow accounts of all
+// the transfer channels at the current height, and returns the snapshot.
+func (k Keeper) SnapshotEscrowBalances(ctx sdk.Context) types.EscrowSnapshot {
+	channels := []types.ChannelEscrowBalance{}
+	for _, ch := range k.GetTransferChannels(ctx) {
+		escrowAddress := k.GetEscrowAddress(ctx, ch.PortIdentifier, ch.ChannelIdentifier)
+		balances := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+		channels = append(channels, types.NewChannelEscrowBalance(ch.PortIdentifier, ch.ChannelIdentifier, escrowAddress, balances))
+	}
+
+	snapshot := types.NewEscrowSnapshot(uint64(ctx.BlockHeight()), channels)
+	k.SetEscrowSnapshot(ctx, snapshot)
+	return snapshot
+}
+
+// PruneEscrowSnapshots deletes the snapshots of the escrow balances taken at or
+// before the given height.
+func (k Keeper) PruneEscrowSnapshots(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the keys are collected before they are deleted, as the store can't be
+	// written while it's iterated
+	var keys [][]byte
+	iterator := store.Iterator(types.EscrowSnapshotKeyPrefix, types.KeyEscrowSnapshot(height+1))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
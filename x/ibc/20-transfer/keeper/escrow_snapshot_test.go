@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestSnapshotEscrowBalances() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	// the escrow accounts of the channels of the other ports are not recorded
+	escrowed := map[string]sdk.Coins{
+		"firstchannel":  sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("btc", 5)),
+		"secondchannel": sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+		"otherchannel":  sdk.NewCoins(sdk.NewInt64Coin("atom", 30)),
+	}
+	for _, channelID := range []string{"firstchannel", "secondchannel", "otherchannel"} {
+		portID := testPort1
+		if channelID == "otherchannel" {
+			portID = testPort2
+		}
+		suite.chainA.createChannel(portID, channelID, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+		_, err := suite.chainA.App.BankKeeper.AddCoins(ctx, types.GetEscrowAddress(portID, channelID), escrowed[channelID])
+		suite.Require().NoError(err)
+
+		if channelID == "otherchannel" {
+			continue
+		}
+
+		capName := ibctypes.ChannelCapabilityPath(testPort1, channelID)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(ctx, capName)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(ctx, cap, capName))
+	}
+
+	_, found := transferKeeper.GetEscrowSnapshot(ctx, uint64(ctx.BlockHeight()))
+	suite.Require().False(found)
+
+	snapshot := transferKeeper.SnapshotEscrowBalances(ctx.WithBlockHeight(10))
+	expSnapshot := types.NewEscrowSnapshot(10, []types.ChannelEscrowBalance{
+		types.NewChannelEscrowBalance(testPort1, "firstchannel", types.GetEscrowAddress(testPort1, "firstchannel"), escrowed["firstchannel"]),
+		types.NewChannelEscrowBalance(testPort1, "secondchannel", types.GetEscrowAddress(testPort1, "secondchannel"), escrowed["secondchannel"]),
+	})
+	suite.Require().Equal(expSnapshot, snapshot)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 30), sdk.NewInt64Coin("btc", 5)), snapshot.Total)
+
+	_, err := suite.chainA.App.BankKeeper.AddCoins(ctx, types.GetEscrowAddress(testPort1, "secondchannel"), sdk.NewCoins(sdk.NewInt64Coin("atom", 5)))
+	suite.Require().NoError(err)
+	laterSnapshot := transferKeeper.SnapshotEscrowBalances(ctx.WithBlockHeight(20))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 35), sdk.NewInt64Coin("btc", 5)), laterSnapshot.Total)
+
+	testCases := []struct {
+		msg         string
+		height      uint64
+		found       bool
+		expSnapshot types.EscrowSnapshot
+	}{
+		{"before the first snapshot", 9, false, types.EscrowSnapshot{}},
+		{"height of a snapshot", 10, true, expSnapshot},
+		{"between two snapshots", 19, true, expSnapshot},
+		{"height of the latest snapshot", 20, true, laterSnapshot},
+		{"after the latest snapshot", 1000, true, laterSnapshot},
+		{"maximum height", ^uint64(0), true, laterSnapshot},
+	}
+
+	for i, tc := range testCases {
+		snapshot, found := transferKeeper.GetEscrowSnapshot(ctx, tc.height)
+		suite.Require().Equal(tc.found, found, "test case %d: %s", i, tc.msg)
+		suite.Require().Equal(tc.expSnapshot, snapshot, "test case %d: %s", i, tc.msg)
+	}
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowAddressVersion() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	// the channels without a recorded version use the legacy escrow address
+	_, found := transferKeeper.GetEscrowAddressVersion(ctx, testPort1, testChannel1)
+	suite.Require().False(found)
+	suite.Require().Equal(types.GetEscrowAddress(testPort1, testChannel1), transferKeeper.GetEscrowAddress(ctx, testPort1, testChannel1))
+
+	transferKeeper.SetEscrowAddressVersion(ctx, testPort1, testChannel1, types.EscrowAddressVersionADR028)
+	version, found := transferKeeper.GetEscrowAddressVersion(ctx, testPort1, testChannel1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.EscrowAddressVersionADR028, version)
+
+	escrowAddress := transferKeeper.GetEscrowAddress(ctx, testPort1, testChannel1)
+	suite.Require().Equal(types.GetVersionedEscrowAddress(types.EscrowAddressVersionADR028, testPort1, testChannel1), escrowAddress)
+	suite.Require().NotEqual(types.GetEscrowAddress(testPort1, testChannel1), escrowAddress)
+
+	transferKeeper.SetEscrowAddressVersion(ctx, testPort2, testChannel2, types.EscrowAddressVersionLegacy)
+	expVersions := []types.ChannelEscrowAddressVersion{
+		types.NewChannelEscrowAddressVersion(testPort1, testChannel1, types.EscrowAddressVersionADR028),
+		types.NewChannelEscrowAddressVersion(testPort2, testChannel2, types.EscrowAddressVersionLegacy),
+	}
+	suite.Require().Equal(expVersions, transferKeeper.GetAllEscrowAddressVersions(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateEscrowAddressVersions() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	// the channels of the other ports are not migrated
+	channels := []string{"firstchannel", "secondchannel", "otherchannel"}
+	for _, channelID := range channels {
+		portID := testPort1
+		if channelID == "otherchannel" {
+			portID = testPort2
+		}
+		suite.chainA.createChannel(portID, channelID, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+		if channelID == "otherchannel" {
+			continue
+		}
+
+		capName := ibctypes.ChannelCapabilityPath(testPort1, channelID)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(ctx, capName)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(ctx, cap, capName))
+	}
+
+	// the recorded versions are kept
+	transferKeeper.SetEscrowAddressVersion(ctx, testPort1, "secondchannel", types.EscrowAddressVersionADR028)
+
+	suite.Require().Equal(1, transferKeeper.MigrateEscrowAddressVersions(ctx))
+	suite.Require().Equal(0, transferKeeper.MigrateEscrowAddressVersions(ctx))
+
+	expVersions := []types.ChannelEscrowAddressVersion{
+		types.NewChannelEscrowAddressVersion(testPort1, "firstchannel", types.EscrowAddressVersionLegacy),
+		types.NewChannelEscrowAddressVersion(testPort1, "secondchannel", types.EscrowAddressVersionADR028),
+	}
+	suite.Require().Equal(expVersions, transferKeeper.GetAllEscrowAddressVersions(ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowAddress() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	suite.chainA.App.TransferKeeper.SetEscrowAddressVersion(ctx, testPort1, testChannel2, types.EscrowAddressVersionADR028)
+
+	testCases := []struct {
+		msg         string
+		channelID   string
+		expResponse types.EscrowAddressResponse
+	}{
+		{"version not recorded", testChannel1, types.NewEscrowAddressResponse(testPort1, testChannel1, types.EscrowAddressVersionLegacy)},
+		{"version recorded", testChannel2, types.NewEscrowAddressResponse(testPort1, testChannel2, types.EscrowAddressVersionADR028)},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryEscrowAddress}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryEscrowAddressParams(testPort1, tc.channelID)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryEscrowAddress}, query)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var res types.EscrowAddressResponse
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+		suite.Require().Equal(tc.expResponse, res, "test case %d: %s", i, tc.msg)
+		suite.Require().Equal(suite.chainA.App.TransferKeeper.GetEscrowAddress(ctx, testPort1, tc.channelID), res.Address)
+	}
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// GetPacketFees returns the relayer fees escrowed for the packet sent with the
+// given sequence on a channel.
+func (k Keeper) GetPacketFees(ctx sdk.Context, portID, channelID string, sequence uint64) (types.IdentifiedPacketFees, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPacketFees(portID, channelID, sequence))
+	if bz == nil {
+		return types.IdentifiedPacketFees{}, false
+	}
+
+	var fees types.IdentifiedPacketFees
+	k.cdc.MustUnmarshalBinaryBare(bz, &fees)
+	return fees, true
+}
+
+// SetPacketFees stores the relayer fees escrowed for a packet.
+func (k Keeper) SetPacketFees(ctx sdk.Context, fees types.IdentifiedPacketFees) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(fees)
+	store.Set(types.KeyPacketFees(fees.PortID, fees.ChannelID, fees.Sequence), bz)
+}
+
+// DeletePacketFees deletes the relayer fees escrowed for the packet sent with the
+// given sequence on a channel.
+func (k Keeper) DeletePacketFees(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPacketFees(portID, channelID, sequence))
+}
+
+// GetChannelPacketFees returns the relayer fees escrowed for all the packets in
+// flight of a channel.
+func (k Keeper) GetChannelPacketFees(ctx sdk.Context, portID, channelID string) []types.IdentifiedPacketFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyChannelPacketFees(portID, channelID))
+	defer iterator.Close()
+
+	packetFees := []types.IdentifiedPacketFees{}
+	for ; iterator.Valid(); iterator.Next() {
+		var fees types.IdentifiedPacketFees
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fees)
+		packetFees = append(packetFees, fees)
+	}
+
+	return packetFees
+}
+
+// PayPacketFee escrows the relayer fees of a packet sent through a channel. The
+// packet must still be in flight, i.e it must not have been acknowledged or
+// timed out yet.
+func (k Keeper) PayPacketFee(
+	ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64, fee types.Fee, payer sdk.AccAddress,
+) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel); !found {
+		return sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if len(k.channelKeeper.GetPacketCommitment(ctx, sourcePort, sourceChannel, sequence)) == 0 {
+		return sdkerrors.Wrapf(types.ErrPacketNotInFlight, "%s/%s/%d", sourcePort, sourceChannel, sequence)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, payer, types.GetFeeEscrowAddress(sourcePort, sourceChannel), fee.Total()); err != nil {
+		return err
+	}
+
+	fees, found := k.GetPacketFees(ctx, sourcePort, sourceChannel, sequence)
+	if !found {
+		fees = types.NewIdentifiedPacketFees(sourcePort, sourceChannel, sequence, nil)
+	}
+	fees.PacketFees = append(fees.PacketFees, types.NewPacketFee(fee, payer))
+	k.SetPacketFees(ctx, fees)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayPacketFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, payer.String()),
+			sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, sourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, fee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, fee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, fee.TimeoutFee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DistributePacketFeesOnAcknowledgement pays the escrowed fees of an acknowledged
+// packet: the recv fees to the relayer that submitted the packet to the
+// destination chain, as written on the acknowledgement, and the ack fees to the
+// relayer that submitted the acknowledgement. The timeout fees are refunded.
+//
+// NOTE: the recv relayer is an address of the destination chain, so the recv
+// fees are refunded as well if it isn't a valid address on this chain.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(
+	ctx sdk.Context, packet channelexported.PacketI, recvRelayer string, ackRelayer sdk.AccAddress,
+) error {
+	fees, found := k.GetPacketFees(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeletePacketFees(ctx, fees.PortID, fees.ChannelID, fees.Sequence)
+
+	recvRelayerAddr, err := sdk.AccAddressFromBech32(recvRelayer)
+	if err != nil {
+		recvRelayerAddr = nil
+	}
+
+	escrowAddress := types.GetFeeEscrowAddress(fees.PortID, fees.ChannelID)
+	for _, packetFee := range fees.PacketFees {
+		if err := k.distributeFee(ctx, escrowAddress, recvRelayerAddr, packetFee.RefundAddress, packetFee.Fee.RecvFee); err != nil {
+			return err
+		}
+		if err := k.distributeFee(ctx, escrowAddress, ackRelayer, packetFee.RefundAddress, packetFee.Fee.AckFee); err != nil {
+			return err
+		}
+		if err := k.distributeFee(ctx, escrowAddress, packetFee.RefundAddress, packetFee.RefundAddress, packetFee.Fee.TimeoutFee); err != nil {
+			return err
+		}
+	}
+
+	k.emitDistributeFeeEvent(ctx, fees, ackRelayer)
+	return nil
+}
+
+// DistributePacketFeesOnTimeout pays the escrowed timeout fees of a timed out
+// packet to the relayer that submitted the timeout. The recv and ack fees are
+// refunded.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, packet channelexported.PacketI, timeoutRelayer sdk.AccAddress) error {
+	fees, found := k.GetPacketFees(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeletePacketFees(ctx, fees.PortID, fees.ChannelID, fees.Sequence)
+
+	escrowAddress := types.GetFeeEscrowAddress(fees.PortID, fees.ChannelID)
+	for _, packetFee := range fees.PacketFees {
+		refund := packetFee.Fee.RecvFee.Add(packetFee.Fee.AckFee...)
+		if err := k.distributeFee(ctx, escrowAddress, packetFee.RefundAddress, packetFee.RefundAddress, refund); err != nil {
+			return err
+		}
+		if err := k.distributeFee(ctx, escrowAddress, timeoutRelayer, packetFee.RefundAddress, packetFee.Fee.TimeoutFee); err != nil {
+			return err
+		}
+	}
+
+	k.emitDistributeFeeEvent(ctx, fees, timeoutRelayer)
+	return nil
+}
+
+// distributeFee sends a fee from the fee escrow account to the relayer, or to
+// the refund address if the relayer is unknown.
+func (k Keeper) distributeFee(ctx sdk.Context, escrowAddress, relayer, refundAddress sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	receiver := relayer
+	if receiver.Empty() {
+		receiver = refundAddress
+	}
+
+	return k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, fee)
+}
+
+func (k Keeper) emitDistributeFeeEvent(ctx sdk.Context, fees types.IdentifiedPacketFees, relayer sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeySourcePort, fees.PortID),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, fees.ChannelID),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", fees.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyAmount, fees.Total().String()),
+		),
+	)
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+var (
+	feePayer       = sdk.AccAddress(crypto.AddressHash([]byte("payer")))
+	recvRelayer    = sdk.AccAddress(crypto.AddressHash([]byte("recvrelayer")))
+	ackRelayer     = sdk.AccAddress(crypto.AddressHash([]byte("ackrelayer")))
+	timeoutRelayer = sdk.AccAddress(crypto.AddressHash([]byte("timeoutrelayer")))
+
+	testFee = types.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	)
+)
+
+// setupPacketFee opens a transfer channel with a packet in flight and funds the
+// fee payer.
+func (suite *KeeperTestSuite) setupPacketFee() {
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.setupTransferChannel(testPort1, testChannel1, testPort2, testChannel2)
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 1, []byte("commitment"))
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, feePayer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+}
+
+func (suite *KeeperTestSuite) stakeBalance(addr sdk.AccAddress) sdk.Int {
+	return suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), addr, "stake").Amount
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFee() {
+	testCases := []struct {
+		msg      string
+		channel  string
+		sequence uint64
+		fee      types.Fee
+		expPass  bool
+	}{
+		{"success", testChannel1, 1, testFee, true},
+		{"channel not found", "unknownchannel", 1, testFee, false},
+		{"packet not in flight", testChannel1, 2, testFee, false},
+		{"insufficient funds", testChannel1, 1, types.NewFee(sdk.NewCoins(sdk.NewInt64Coin("stake", 201)), nil, nil), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.setupPacketFee()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.TransferKeeper.PayPacketFee(ctx, testPort1, tc.channel, tc.sequence, tc.fee, feePayer)
+			if !tc.expPass {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+				_, found := suite.chainA.App.TransferKeeper.GetPacketFees(ctx, testPort1, testChannel1, 1)
+				suite.Require().False(found)
+				suite.Require().Equal(sdk.NewInt(200), suite.stakeBalance(feePayer))
+				return
+			}
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			// fees paid twice for the same packet are escrowed separately
+			err = suite.chainA.App.TransferKeeper.PayPacketFee(ctx, testPort1, tc.channel, tc.sequence, tc.fee, feePayer)
+			suite.Require().NoError(err)
+
+			fees, found := suite.chainA.App.TransferKeeper.GetPacketFees(ctx, testPort1, testChannel1, 1)
+			suite.Require().True(found)
+			packetFee := types.NewPacketFee(tc.fee, feePayer)
+			suite.Require().Equal(types.NewIdentifiedPacketFees(testPort1, testChannel1, 1, []types.PacketFee{packetFee, packetFee}), fees)
+
+			suite.Require().Equal(sdk.NewInt(120), suite.stakeBalance(types.GetFeeEscrowAddress(testPort1, testChannel1)))
+			suite.Require().Equal(sdk.NewInt(80), suite.stakeBalance(feePayer))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFees() {
+	packet := channeltypes.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	testCases := []struct {
+		msg               string
+		distribute        func(sdk.Context) error
+		expPayer          int64
+		expRecvRelayer    int64
+		expAckRelayer     int64
+		expTimeoutRelayer int64
+	}{
+		{
+			"acknowledgement",
+			func(ctx sdk.Context) error {
+				return suite.chainA.App.TransferKeeper.DistributePacketFeesOnAcknowledgement(ctx, packet, recvRelayer.String(), ackRelayer)
+			},
+			170, 10, 20, 0,
+		},
+		{
+			"acknowledgement with an unknown recv relayer",
+			func(ctx sdk.Context) error {
+				return suite.chainA.App.TransferKeeper.DistributePacketFeesOnAcknowledgement(ctx, packet, "cosmos1invalid", ackRelayer)
+			},
+			180, 0, 20, 0,
+		},
+		{
+			"timeout",
+			func(ctx sdk.Context) error {
+				return suite.chainA.App.TransferKeeper.DistributePacketFeesOnTimeout(ctx, packet, timeoutRelayer)
+			},
+			170, 0, 0, 30,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.setupPacketFee()
+
+			ctx := suite.chainA.GetContext()
+			suite.Require().NoError(suite.chainA.App.TransferKeeper.PayPacketFee(ctx, testPort1, testChannel1, 1, testFee, feePayer))
+
+			suite.Require().NoError(tc.distribute(ctx), "valid test case %d failed: %s", i, tc.msg)
+
+			_, found := suite.chainA.App.TransferKeeper.GetPacketFees(ctx, testPort1, testChannel1, 1)
+			suite.Require().False(found)
+			suite.Require().True(suite.stakeBalance(types.GetFeeEscrowAddress(testPort1, testChannel1)).IsZero())
+
+			suite.Require().Equal(sdk.NewInt(tc.expPayer), suite.stakeBalance(feePayer))
+			suite.Require().Equal(sdk.NewInt(tc.expRecvRelayer), suite.stakeBalance(recvRelayer))
+			suite.Require().Equal(sdk.NewInt(tc.expAckRelayer), suite.stakeBalance(ackRelayer))
+			suite.Require().Equal(sdk.NewInt(tc.expTimeoutRelayer), suite.stakeBalance(timeoutRelayer))
+
+			events := ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeDistributeFee, events[len(events)-1].Type)
+
+			// the fees of a packet are only distributed once
+			suite.Require().NoError(tc.distribute(ctx))
+			suite.Require().Equal(sdk.NewInt(tc.expPayer), suite.stakeBalance(feePayer))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketFees() {
+	suite.setupPacketFee()
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	expFees := []types.IdentifiedPacketFees{}
+	for seq := uint64(1); seq <= 3; seq++ {
+		suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, seq, []byte("commitment"))
+		suite.Require().NoError(suite.chainA.App.TransferKeeper.PayPacketFee(ctx, testPort1, testChannel1, seq, testFee, feePayer))
+		expFees = append(expFees, types.NewIdentifiedPacketFees(testPort1, testChannel1, seq, []types.PacketFee{types.NewPacketFee(testFee, feePayer)}))
+	}
+
+	testCases := []struct {
+		msg     string
+		channel string
+		page    int
+		limit   int
+		expFees []types.IdentifiedPacketFees
+	}{
+		{"all packets", testChannel1, 1, 100, expFees},
+		{"second page", testChannel1, 2, 2, expFees[2:]},
+		{"no packet fees", testChannel2, 1, 100, nil},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryPacketFees}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryPacketFeesParams(testPort1, tc.channel, tc.page, tc.limit)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryPacketFees}, query)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var packetFees []types.IdentifiedPacketFees
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &packetFees))
+		suite.Require().Equal(tc.expFees, packetFees, "valid test case %d failed: %s", i, tc.msg)
+	}
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// GetForwardRecord returns the reverse path of the transfer forwarded with the
+// given packet.
+func (k Keeper) GetForwardRecord(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ForwardRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForwardRecord(portID, channelID, sequence))
+	if bz == nil {
+		return types.ForwardRecord{}, false
+	}
+
+	var record types.ForwardRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+// SetForwardRecord stores the reverse path of the transfer forwarded with the
+// given packet.
+func (k Keeper) SetForwardRecord(ctx sdk.Context, portID, channelID string, sequence uint64, record types.ForwardRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(record)
+	store.Set(types.KeyForwardRecord(portID, channelID, sequence), bz)
+}
+
+// DeleteForwardRecord deletes the reverse path of the transfer forwarded with
+// the given packet.
+func (k Keeper) DeleteForwardRecord(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForwardRecord(portID, channelID, sequence))
+}
+
+// ForwardTransfer forwards the tokens of a received packet to the next chain
+// defined by the packet data memo, if any (see ForwardMetadata). The tokens
+// credited to the receiver are sent to the forward receiver along with the memo
+// of the next hop. The reverse path is stored so that the tokens can be refunded
+// to the sender if the forwarded packet fails.
+//
+// NOTE: the tokens must have been credited to the receiver already (see
+// OnRecvPacket). An error fails the receive, so the sender is refunded as well.
+func (k Keeper) ForwardTransfer(ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData) error {
+	forward, found, err := types.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	// the tokens are forwarded with the denomination they were credited with
+	amount := make(sdk.Coins, len(data.Amount))
+	for i, coin := range data.Amount {
+		denom, err := k.creditedDenom(ctx, packet, coin.Denom)
+		if err != nil {
+			return err
+		}
+		amount[i] = sdk.NewCoin(denom, coin.Amount)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(forward.GetTimeout()).UnixNano())
+	forwardPacket, err := k.sendLocalCoins(ctx, forward.Port, forward.Channel, amount, receiver, forward.Receiver, timeoutTimestamp, forward.GetNextMemo())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to forward transfer to %s/%s", forward.Port, forward.Channel)
+	}
+
+	k.SetForwardRecord(
+		ctx, forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence(),
+		types.NewForwardRecord(packet.GetDestPort(), packet.GetDestChannel(), data.GetRefundAddress(), amount),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Receiver),
+			sdk.NewAttribute(types.AttributeKeyReceiver, forward.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeySourcePort, forward.Port),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, forward.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", forwardPacket.GetSequence())),
+		),
+	)
+
+	return nil
+}
+
+// refundForward refunds the tokens of a failed forwarded packet to the refund
+// address (i.e the sender by default) of the incoming transfer, through the
+// channel they were received on. The tokens must have been refunded to the
+// forwarding account already (see refundPacketAmount).
+//
+// NOTE: a failed refund fails the acknowledgement or timeout of the forwarded
+// packet, so that it can be retried. The forwarded packets have no timeout
+// height and can't be refunded as stuck packets (see RefundStuckPacket).
+func (k Keeper) refundForward(ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData) error {
+	record, found := k.GetForwardRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	refundPacket, err := k.refundForwardAmount(ctx, record, data.GetRefundAddress())
+	if err != nil {
+		return sdkerrors.Wrapf(
+			err, "failed to refund forwarded transfer %s/%s/%d to %s",
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), record.RefundReceiver,
+		)
+	}
+	k.DeleteForwardRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRefund,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, record.RefundReceiver),
+			sdk.NewAttribute(types.AttributeKeyRefundValue, record.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySourcePort, record.RefundPort),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, record.RefundChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", refundPacket.GetSequence())),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) refundForwardAmount(ctx sdk.Context, record types.ForwardRecord, forwarder string) (channel.Packet, error) {
+	sender, err := sdk.AccAddressFromBech32(forwarder)
+	if err != nil {
+		return channel.Packet{}, err
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(types.DefaultForwardTimeout).UnixNano())
+	return k.sendLocalCoins(ctx, record.RefundPort, record.RefundChannel, record.Amount, sender, record.RefundReceiver, timeoutTimestamp, "")
+}
+
+// sendLocalCoins sends coins held with their denomination on this chain (i.e the
+// hashed voucher denomination or the native denomination) through a channel. The
+// coins are burned if they return to their source chain through the channel and
+// escrowed otherwise.
+func (k Keeper) sendLocalCoins(
+	ctx sdk.Context, sourcePort, sourceChannel string, amount sdk.Coins, sender sdk.AccAddress, receiver string,
+	timeoutTimestamp uint64, memo string,
+) (channel.Packet, error) {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return channel.Packet{}, sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	// escrowed coins are sent with the destination prefix (see createOutgoingPacket)
+	destPrefix := types.GetDenomPrefix(sourceChannelEnd.Counterparty.PortID, sourceChannelEnd.Counterparty.ChannelID)
+	sourcePrefix := types.GetDenomPrefix(sourcePort, sourceChannel)
+
+	coins := make(sdk.Coins, len(amount))
+	for i, coin := range amount {
+		fullDenomPath, err := k.DenomPathFromHash(ctx, coin.Denom)
+		if err != nil {
+			return channel.Packet{}, err
+		}
+
+		if strings.HasPrefix(fullDenomPath, sourcePrefix) {
+			coins[i] = coin
+		} else {
+			coins[i] = sdk.Coin{Denom: destPrefix + coin.Denom, Amount: coin.Amount}
+		}
+	}
+
+	packet, data, err := k.sendTransfer(ctx, sourcePort, sourceChannel, coins, sender, receiver, ibctypes.Height{}, timeoutTimestamp, memo, nil, sdk.Coin{})
+	if err != nil {
+		return channel.Packet{}, err
+	}
+
+	k.recordSend(sourcePort, sourceChannel, data.Amount)
+	return packet, nil
+}
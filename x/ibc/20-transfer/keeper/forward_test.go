@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+const (
+	forwardChannel   = "forwardchannel"
+	forwardCPChannel = "forwardcpchannel"
+)
+
+// setupTransferChannel opens a transfer channel on chainA, owned by the transfer
+// module along with its port, with the next send sequence set.
+func (suite *KeeperTestSuite) setupTransferChannel(portID, channelID, counterpartyPortID, counterpartyChannelID string) {
+	if !suite.chainA.App.TransferKeeper.IsBound(suite.chainA.GetContext(), portID) {
+		suite.chainA.bindPort(portID)
+	}
+
+	capName := ibctypes.ChannelCapabilityPath(portID, channelID)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.createChannel(portID, channelID, counterpartyPortID, counterpartyChannelID, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), portID, channelID, 1)
+}
+
+func (suite *KeeperTestSuite) TestForwardTransfer() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	forwarder := sdk.AccAddress(crypto.AddressHash([]byte("forwarder")))
+	voucher := types.ParseDenomTrace("testportid/secondchannel/atom")
+	nextMemo := `{"forward":{"receiver":"finalreceiver","port":"transfer","channel":"finalchannel"}}`
+
+	testCases := []struct {
+		msg     string
+		memo    string
+		forward bool
+		expPass bool
+	}{
+		{"no forward", "thanks for the fish", false, true},
+		{"forward to the next hop", fmt.Sprintf(`{"forward":{"receiver":"nextreceiver","port":"testportid","channel":"%s","next":%s}}`, forwardChannel, nextMemo), true, true},
+		{"forward channel not found", `{"forward":{"receiver":"nextreceiver","port":"testportid","channel":"unknownchannel"}}`, false, false},
+		{"invalid forward", `{"forward":{"port":"testportid","channel":"forwardchannel"}}`, false, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.CreateClient(suite.chainB)
+			suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+			suite.setupTransferChannel(testPort2, forwardChannel, testPort1, forwardCPChannel)
+
+			amount := sdk.NewCoins(sdk.NewCoin(voucher.GetFullDenomPath(), sdk.NewInt(100)))
+			data := types.NewFungibleTokenPacketData(amount, sender.String(), forwarder.String(), tc.memo)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+			ctx := suite.chainA.GetContext()
+			suite.Require().NoError(suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data))
+
+			err := suite.chainA.App.TransferKeeper.ForwardTransfer(ctx, packet, data)
+			if !tc.expPass {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+				return
+			}
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			balance := suite.chainA.App.BankKeeper.GetBalance(ctx, forwarder, voucher.IBCDenom())
+			record, found := suite.chainA.App.TransferKeeper.GetForwardRecord(ctx, testPort2, forwardChannel, 1)
+			commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort2, forwardChannel, 1)
+
+			if !tc.forward {
+				suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+				suite.Require().False(found)
+				suite.Require().Nil(commitment)
+				return
+			}
+
+			// the vouchers are escrowed and sent with the memo of the next hop
+			suite.Require().True(balance.IsZero())
+			escrowed := suite.chainA.App.BankKeeper.GetBalance(ctx, types.GetEscrowAddress(testPort2, forwardChannel), voucher.IBCDenom())
+			suite.Require().Equal(sdk.NewInt(100), escrowed.Amount)
+
+			forwardData := types.NewFungibleTokenPacketData(
+				sdk.NewCoins(sdk.NewCoin("bank/forwardcpchannel/testportid/secondchannel/atom", sdk.NewInt(100))),
+				forwarder.String(), "nextreceiver", nextMemo,
+			)
+			timeoutTimestamp := uint64(ctx.BlockTime().Add(types.DefaultForwardTimeout).UnixNano())
+			forwardPacket := channeltypes.NewPacket(forwardData.GetBytes(), 1, testPort2, forwardChannel, testPort1, forwardCPChannel, 0, timeoutTimestamp)
+			suite.Require().Equal(channeltypes.CommitPacket(forwardPacket), commitment)
+
+			suite.Require().True(found)
+			expRecord := types.NewForwardRecord(testPort2, testChannel2, sender.String(), sdk.NewCoins(sdk.NewCoin(voucher.IBCDenom(), sdk.NewInt(100))))
+			suite.Require().Equal(expRecord, record)
+
+			events := ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeForward, events[len(events)-1].Type)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForwardTransferTooManyHops() {
+	memo := ""
+	for i := 0; i <= types.MaxForwardHops; i++ {
+		next := ""
+		if memo != "" {
+			next = fmt.Sprintf(`,"next":%s`, memo)
+		}
+		memo = fmt.Sprintf(`{"forward":{"receiver":"nextreceiver","port":"testportid","channel":"%s"%s}}`, forwardChannel, next)
+	}
+
+	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String(), memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	err := suite.chainA.App.TransferKeeper.ForwardTransfer(suite.chainA.GetContext(), packet, data)
+	suite.Require().True(types.ErrTooManyForwardHops.Is(err))
+}
+
+// TestForwardRefund tests that the tokens of a failed forwarded packet are sent
+// back to the sender of the incoming transfer.
+func (suite *KeeperTestSuite) TestForwardRefund() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	forwarder := sdk.AccAddress(crypto.AddressHash([]byte("forwarder")))
+
+	// the forwarded vouchers were received through testportid/secondchannel, so
+	// they are burned when refunded on their way back to their source chain
+	voucher := types.ParseDenomTrace("testportid/secondchannel/atom")
+	data := types.NewFungibleTokenPacketData(
+		sdk.NewCoins(sdk.NewCoin(voucher.GetFullDenomPath(), sdk.NewInt(100))), forwarder.String(), "nextreceiver", "",
+	)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	record := types.NewForwardRecord(testPort2, testChannel2, sender.String(), sdk.NewCoins(sdk.NewCoin(voucher.IBCDenom(), sdk.NewInt(100))))
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		onTimeout bool
+		expRefund bool
+	}{
+		{"refund on timeout", func() {
+			suite.setupTransferChannel(testPort2, testChannel2, testPort1, testChannel1)
+		}, true, true},
+		{"refund on error acknowledgement", func() {
+			suite.setupTransferChannel(testPort2, testChannel2, testPort1, testChannel1)
+		}, false, true},
+		{"refund channel not found", func() {}, true, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.CreateClient(suite.chainB)
+			suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, voucher)
+			suite.chainA.App.TransferKeeper.SetForwardRecord(ctx, testPort1, testChannel1, 1, record)
+
+			var err error
+			if tc.onTimeout {
+				err = suite.chainA.App.TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+			} else {
+				ack := types.FungibleTokenPacketAcknowledgement{Success: false, Error: "failed"}
+				err = suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+			}
+			_, found := suite.chainA.App.TransferKeeper.GetForwardRecord(ctx, testPort1, testChannel1, 1)
+			commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort2, testChannel2, 1)
+			if tc.expRefund {
+				suite.Require().NoError(err, "test case %d failed: %s", i, tc.msg)
+				suite.Require().False(found, "forward record not deleted on case %s", tc.msg)
+
+				balance := suite.chainA.App.BankKeeper.GetBalance(ctx, forwarder, voucher.IBCDenom())
+				suite.Require().True(balance.IsZero(), "vouchers not refunded on case %s", tc.msg)
+				suite.Require().NotNil(commitment)
+
+				events := ctx.EventManager().Events()
+				suite.Require().Equal(types.EventTypeForwardRefund, events[len(events)-1].Type)
+			} else {
+				// the failed refund fails the timeout, so that it can be retried
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+				suite.Require().True(found, "forward record deleted on case %s", tc.msg)
+				suite.Require().Nil(commitment)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForwardRecordDeletedOnAcknowledgement() {
+	ctx := suite.chainA.GetContext()
+	record := types.NewForwardRecord(testPort2, testChannel2, testAddr1.String(), testCoins)
+	suite.chainA.App.TransferKeeper.SetForwardRecord(ctx, testPort1, testChannel1, 1, record)
+
+	stored, found := suite.chainA.App.TransferKeeper.GetForwardRecord(ctx, testPort1, testChannel1, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(record, stored)
+
+	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	ack := types.FungibleTokenPacketAcknowledgement{Success: true}
+	suite.Require().NoError(suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, ack))
+
+	_, found = suite.chainA.App.TransferKeeper.GetForwardRecord(ctx, testPort1, testChannel1, 1)
+	suite.Require().False(found)
+}
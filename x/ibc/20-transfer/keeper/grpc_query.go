@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DenomTrace implements the Query/DenomTrace gRPC method
+func (k Keeper) DenomTrace(c context.Context, req *types.QueryDenomTraceRequest) (*types.QueryDenomTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := k.parseTraceHash(strings.TrimPrefix(req.Hash, types.DenomPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denomination trace %s not found", req.Hash)
+	}
+
+	return &types.QueryDenomTraceResponse{DenomTrace: denomTrace}, nil
+}
+
+// DenomTraces implements the Query/DenomTraces gRPC method
+func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesRequest) (*types.QueryDenomTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	page := int(req.Page)
+	if page == 0 {
+		page = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTraces, err := k.getAllDenomTracesWithContext(c, ctx)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	total := uint64(len(denomTraces))
+
+	start, end := client.Paginate(len(denomTraces), page, int(req.Limit), 100)
+	if start < 0 || end < 0 {
+		denomTraces = []types.DenomTrace{}
+	} else {
+		denomTraces = denomTraces[start:end]
+	}
+
+	return &types.QueryDenomTracesResponse{DenomTraces: denomTraces, Total: total}, nil
+}
+
+// getAllDenomTracesWithContext returns the same traces as GetAllDenomTraces, but
+// stops iterating as soon as the request context is done (e.g the request was
+// canceled), returning the context error.
+func (k Keeper) getAllDenomTracesWithContext(c context.Context, ctx sdk.Context) ([]types.DenomTrace, error) {
+	var err error
+	denomTraces := []types.DenomTrace{}
+	k.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		if err = c.Err(); err != nil {
+			return true
+		}
+
+		denomTraces = append(denomTraces, denomTrace)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return denomTraces, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
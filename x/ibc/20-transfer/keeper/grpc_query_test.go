@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryDenomTrace() {
+	ctx := suite.chainA.GetContext()
+	denomTrace := prefixTrace
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryDenomTraceRequest
+		expCode codes.Code
+	}{
+		{"hash", &types.QueryDenomTraceRequest{Hash: denomTrace.Hash().String()}, codes.OK},
+		{"voucher denomination", &types.QueryDenomTraceRequest{Hash: denomTrace.IBCDenom()}, codes.OK},
+		{"empty request", nil, codes.InvalidArgument},
+		{"invalid hash", &types.QueryDenomTraceRequest{Hash: "invalid"}, codes.InvalidArgument},
+		{"trace not found", &types.QueryDenomTraceRequest{Hash: types.ParseDenomTrace("bank/firstchannel/uatom").Hash().String()}, codes.NotFound},
+	}
+
+	for _, tc := range testCases {
+		res, err := suite.chainA.App.TransferKeeper.DenomTrace(sdk.WrapSDKContext(ctx), tc.req)
+		suite.Require().Equal(tc.expCode, status.Code(err), tc.msg)
+		if tc.expCode == codes.OK {
+			suite.Require().Equal(denomTrace, res.DenomTrace, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryDenomTraces() {
+	ctx := suite.chainA.GetContext()
+	for i := 0; i < 5; i++ {
+		suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, types.ParseDenomTrace(fmt.Sprintf("bank/firstchannel/denom%d", i)))
+	}
+
+	testCases := []struct {
+		msg         string
+		page, limit uint64
+		expLen      int
+	}{
+		{"default page and limit", 0, 0, 5},
+		{"first page", 1, 2, 2},
+		{"last page", 3, 2, 1},
+		{"out of range page", 4, 2, 0},
+	}
+
+	for _, tc := range testCases {
+		res, err := suite.chainA.App.TransferKeeper.DenomTraces(
+			sdk.WrapSDKContext(ctx), &types.QueryDenomTracesRequest{Page: tc.page, Limit: tc.limit},
+		)
+		suite.Require().NoError(err, tc.msg)
+		suite.Require().Len(res.DenomTraces, tc.expLen, tc.msg)
+		suite.Require().Equal(uint64(5), res.Total, tc.msg)
+	}
+
+	_, err := suite.chainA.App.TransferKeeper.DenomTraces(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// the iteration stops once the request is canceled or timed out
+	canceledCtx, cancel := context.WithCancel(sdk.WrapSDKContext(ctx))
+	cancel()
+	_, err = suite.chainA.App.TransferKeeper.DenomTraces(canceledCtx, &types.QueryDenomTracesRequest{})
+	suite.Require().Equal(codes.Canceled, status.Code(err))
+
+	expiredCtx, cancel := context.WithDeadline(sdk.WrapSDKContext(ctx), time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = suite.chainA.App.TransferKeeper.DenomTraces(expiredCtx, &types.QueryDenomTracesRequest{})
+	suite.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryParams() {
+	ctx := suite.chainA.GetContext()
+	params := types.NewParams(false, true, []types.DenomEnabled{types.NewDenomEnabled("atom", true)}, nil, true, 0, false, 0, 0)
+	suite.chainA.App.TransferKeeper.SetParams(ctx, params)
+
+	res, err := suite.chainA.App.TransferKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"hash"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// WithDenomTraceHasher returns a copy of the keeper that hashes the denomination
+// traces with the given hash function instead of SHA256 (see
+// types.DefaultDenomTraceHasher). It panics if the hashes don't fit in a voucher
+// denomination (see types.ValidateDenomTraceHasher).
+//
+// The voucher denominations (i.e ibc/{hash}) and the keys of the stored traces
+// are derived from the hash, so changing the hasher of a running chain changes
+// the denomination of all its vouchers. The traces and balances stored with the
+// previous hasher must be migrated with MigrateDenomTraceHashes in the same
+// upgrade.
+//
+// NOTE: the counterparty chains are assumed to use the default hasher when
+// estimating the denominations they credit (see SimulateTransfer).
+func (k Keeper) WithDenomTraceHasher(newHash func() hash.Hash) Keeper {
+	if err := types.ValidateDenomTraceHasher(newHash); err != nil {
+		panic(err)
+	}
+
+	k.denomTraceHasher = newHash
+	return k
+}
+
+// DenomTraceHash returns the hash of the denomination trace computed with the
+// hasher of the keeper, i.e the one it is stored under.
+func (k Keeper) DenomTraceHash(denomTrace types.DenomTrace) tmbytes.HexBytes {
+	return denomTrace.HashWith(k.denomTraceHasher)
+}
+
+// traceDenom returns the voucher denomination of the trace hashed with the
+// hasher of the keeper.
+func (k Keeper) traceDenom(denomTrace types.DenomTrace) string {
+	return denomTrace.IBCDenomWith(k.denomTraceHasher)
+}
+
+// parseTraceHash parses a hex hash of a denomination trace, which must have the
+// size of the hashes of the keeper hasher.
+func (k Keeper) parseTraceHash(hexHash string) (tmbytes.HexBytes, error) {
+	return types.ParseHexHashWithSize(hexHash, k.denomTraceHasher().Size())
+}
+
+// MigrateDenomTraceHashes moves the denomination traces stored under their hash
+// computed with the given previous hasher to the one computed with the hasher
+// of the keeper, and converts the balances held on their previous voucher
+// denominations to the new ones. The total supply is updated accordingly, and
+// the coins recorded for the refund of the packets in flight, the rate limits
+// and the reported escrow balances are converted too. It returns the number of
+// migrated traces.
+//
+// The migration is atomic: no trace or balance is changed if it fails. Running
+// it again is a no-op.
+//
+// NOTE: the parameters registered for the previous voucher denominations (e.g
+// DenomSendEnabled) are not migrated, so they must be set again for the new
+// denominations.
+func (k Keeper) MigrateDenomTraceHashes(ctx sdk.Context, prevHash func() hash.Hash) (int, error) {
+	if err := types.ValidateDenomTraceHasher(prevHash); err != nil {
+		return 0, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := cacheCtx.KVStore(k.storeKey)
+
+	// previous voucher denomination -> new voucher denomination
+	denoms := make(map[string]string)
+	for _, denomTrace := range k.GetAllDenomTraces(cacheCtx) {
+		prevKey := types.KeyDenomTrace(denomTrace.HashWith(prevHash))
+		if !store.Has(prevKey) || denomTrace.IBCDenomWith(prevHash) == k.traceDenom(denomTrace) {
+			continue
+		}
+
+		store.Delete(prevKey)
+		k.SetDenomTrace(cacheCtx, denomTrace)
+		denoms[denomTrace.IBCDenomWith(prevHash)] = k.traceDenom(denomTrace)
+	}
+
+	if len(denoms) == 0 {
+		return 0, nil
+	}
+
+	// the balances are collected before they are changed, as the store can't be
+	// written while it's iterated
+	var balances []voucherBalance
+	k.bankKeeper.IterateAllBalances(cacheCtx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if _, ok := denoms[coin.Denom]; ok && coin.IsPositive() {
+			balances = append(balances, voucherBalance{address: address, coin: coin})
+		}
+		return false
+	})
+
+	if err := k.convertBalances(cacheCtx, balances, denoms); err != nil {
+		return 0, err
+	}
+
+	k.convertSentCoins(cacheCtx, denoms)
+	k.convertRateLimits(cacheCtx, denoms)
+	k.recordConvertedEscrowBalances(cacheCtx, balances, denoms)
+
+	writeCache()
+	return len(denoms), nil
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"crypto/sha1" // nolint: gosec
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestWithDenomTraceHasher() {
+	suite.Require().Panics(func() { suite.chainA.App.TransferKeeper.WithDenomTraceHasher(nil) })
+
+	transferKeeper := suite.chainA.App.TransferKeeper.WithDenomTraceHasher(sha1.New)
+	ctx := suite.chainA.GetContext()
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	suite.chainA.createChannel(testPort2, testChannel2, testPort1, testChannel1, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+	suite.Require().NoError(transferKeeper.OnRecvPacket(ctx, packet, data))
+
+	// the vouchers are minted with the denomination of the configured hasher
+	expTrace := types.ParseDenomTrace("testportid/secondchannel/atom")
+	voucherDenom := expTrace.IBCDenomWith(sha1.New)
+	suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, voucherDenom).Amount)
+	suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, expTrace.IBCDenom()).IsZero())
+
+	denomTrace, found := transferKeeper.GetDenomTrace(ctx, expTrace.HashWith(sha1.New))
+	suite.Require().True(found)
+	suite.Require().Equal(expTrace, denomTrace)
+	suite.Require().False(transferKeeper.HasDenomTrace(ctx, expTrace.Hash()))
+
+	fullDenomPath, err := transferKeeper.DenomPathFromHash(ctx, voucherDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal("testportid/secondchannel/atom", fullDenomPath)
+
+	// the SHA256 voucher denominations are not recognized anymore
+	_, err = transferKeeper.DenomPathFromHash(ctx, expTrace.IBCDenom())
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateDenomTraceHashes() {
+	ctx := suite.chainA.GetContext()
+	bankKeeper := suite.chainA.App.BankKeeper
+	holder := sdk.AccAddress(crypto.AddressHash([]byte("holder")))
+
+	voucherTrace := types.ParseDenomTrace("bank/firstchannel/atom")
+	multiHopTrace := types.ParseDenomTrace("bank/firstchannel/transfer/otherchannel/atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, voucherTrace)
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, multiHopTrace)
+
+	balances := sdk.NewCoins(
+		sdk.NewInt64Coin("stake", 100),
+		sdk.NewInt64Coin(voucherTrace.IBCDenom(), 10),
+		sdk.NewInt64Coin(multiHopTrace.IBCDenom(), 20),
+	)
+	suite.Require().NoError(bankKeeper.SetBalances(ctx, holder, balances))
+
+	supply := suite.chainA.App.SupplyKeeper.GetSupply(ctx)
+	initialTotal := supply.GetTotal()
+	supply.SetTotal(initialTotal.Add(balances...))
+	suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply)
+
+	transferKeeper := suite.chainA.App.TransferKeeper.WithDenomTraceHasher(sha1.New)
+
+	_, err := transferKeeper.MigrateDenomTraceHashes(ctx, nil)
+	suite.Require().Error(err)
+
+	migrated, err := transferKeeper.MigrateDenomTraceHashes(ctx, types.DefaultDenomTraceHasher)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, migrated)
+
+	expBalances := sdk.NewCoins(
+		sdk.NewInt64Coin("stake", 100),
+		sdk.NewInt64Coin(voucherTrace.IBCDenomWith(sha1.New), 10),
+		sdk.NewInt64Coin(multiHopTrace.IBCDenomWith(sha1.New), 20),
+	)
+	suite.Require().Equal(expBalances, bankKeeper.GetAllBalances(ctx, holder))
+	suite.Require().Equal(initialTotal.Add(expBalances...), suite.chainA.App.SupplyKeeper.GetSupply(ctx).GetTotal())
+
+	for _, denomTrace := range []types.DenomTrace{voucherTrace, multiHopTrace} {
+		trace, found := transferKeeper.GetDenomTrace(ctx, denomTrace.HashWith(sha1.New))
+		suite.Require().True(found, "trace %s was not migrated", denomTrace)
+		suite.Require().Equal(denomTrace, trace)
+		suite.Require().False(transferKeeper.HasDenomTrace(ctx, denomTrace.Hash()), "previous trace %s was not deleted", denomTrace)
+	}
+	suite.Require().Len(transferKeeper.GetAllDenomTraces(ctx), 2)
+
+	// the migration is a no-op once the traces are migrated
+	migrated, err = transferKeeper.MigrateDenomTraceHashes(ctx, types.DefaultDenomTraceHasher)
+	suite.Require().NoError(err)
+	suite.Require().Zero(migrated)
+	suite.Require().Equal(expBalances, bankKeeper.GetAllBalances(ctx, holder))
+}
+
+func (suite *KeeperTestSuite) TestMigrateDenomTraceHashesEscrowState() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	ctx := suite.chainA.GetContext()
+	voucherTrace := types.ParseDenomTrace("bank/firstchannel/atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, voucherTrace)
+
+	escrow := suite.chainA.App.TransferKeeper.GetEscrowAddress(ctx, testPort1, testChannel1)
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(voucherTrace.IBCDenom(), 10))
+	suite.Require().NoError(suite.chainA.App.BankKeeper.SetBalances(ctx, escrow, escrowed))
+	supply := suite.chainA.App.SupplyKeeper.GetSupply(ctx)
+	supply.SetTotal(supply.GetTotal().Add(escrowed...))
+	suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply)
+
+	err = suite.chainA.App.TransferKeeper.RegisterRateLimit(ctx, testPort1, testChannel1, voucherTrace.IBCDenom(), sdk.NewInt(100), time.Hour)
+	suite.Require().NoError(err)
+	rateLimit, _ := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, testPort1, testChannel1, voucherTrace.IBCDenom())
+	rateLimit.Outflow = sdk.NewInt(40)
+	suite.chainA.App.TransferKeeper.SetRateLimit(ctx, rateLimit)
+
+	escrowBalance := labeledValues{}
+	transferKeeper := suite.chainA.App.TransferKeeper.WithDenomTraceHasher(sha1.New).WithMetrics(&keeper.Metrics{
+		SendTransfers: testCounter{values: labeledValues{}},
+		SendAmount:    testCounter{values: labeledValues{}},
+		RecvTransfers: testCounter{values: labeledValues{}},
+		RecvAmount:    testCounter{values: labeledValues{}},
+		EscrowBalance: testGauge{values: escrowBalance},
+	})
+
+	migrated, err := transferKeeper.MigrateDenomTraceHashes(ctx, types.DefaultDenomTraceHasher)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, migrated)
+
+	// the rate limit is moved to the new denomination with its outflow
+	newDenom := voucherTrace.IBCDenomWith(sha1.New)
+	_, found := transferKeeper.GetRateLimit(ctx, testPort1, testChannel1, voucherTrace.IBCDenom())
+	suite.Require().False(found)
+	rateLimit.Denom = newDenom
+	migratedRateLimit, found := transferKeeper.GetRateLimit(ctx, testPort1, testChannel1, newDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, migratedRateLimit)
+
+	// the escrow balance is reported under the new denomination only
+	suite.Require().Equal(labeledValues{
+		labelValues(testPort1, testChannel1, voucherTrace.IBCDenom()): 0,
+		labelValues(testPort1, testChannel1, newDenom):                10,
+	}, escrowBalance)
+}
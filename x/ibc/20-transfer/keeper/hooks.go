@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// SetPostReceiveHook sets the callback executed after the tokens of a received
+// packet are minted or unescrowed. It panics if a hook has already been set.
+func (k *Keeper) SetPostReceiveHook(hook types.PostReceiveHook) *Keeper {
+	if k.postReceiveHook != nil {
+		panic("cannot set the post receive hook twice")
+	}
+	k.postReceiveHook = hook
+	return k
+}
+
+// afterReceive executes the post receive hook, if any, for each of the coins
+// credited to the receiver.
+//
+// NOTE: the hook is executed before the receive events are emitted, so these
+// are only emitted for the receives accepted by the hook. The tokens are already
+// credited when the hook fails, the caller is responsible for discarding the
+// state changes (see the module OnRecvPacket callback).
+func (k Keeper) afterReceive(ctx sdk.Context, packet channel.Packet, coins sdk.Coins, receiver sdk.AccAddress) error {
+	if k.postReceiveHook == nil {
+		return nil
+	}
+
+	for _, coin := range coins {
+		if err := k.postReceiveHook(ctx, packet, coin.Denom, coin.Amount, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
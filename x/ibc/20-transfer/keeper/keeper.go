@@ -2,18 +2,24 @@ package keeper
 
 import (
 	"fmt"
+	"hash"
+	"strings"
 
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
 	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
@@ -24,19 +30,36 @@ const (
 
 // Keeper defines the IBC transfer keeper
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      *codec.Codec
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace paramtypes.Subspace
 
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
 	supplyKeeper  types.SupplyKeeper
 	scopedKeeper  capability.ScopedKeeper
+	nftKeeper     types.NFTKeeper
+
+	// connection and client keepers used to resolve the client of a channel (see
+	// WithClientKeepers and WithChainIDDenomTraces)
+	connectionKeeper types.ConnectionKeeper
+	clientKeeper     types.ClientKeeper
+
+	// address allowed to update the parameters (see UpdateParams)
+	authority sdk.AccAddress
+
+	metrics             *Metrics
+	sendDenylist        []string
+	postReceiveHook     types.PostReceiveHook
+	protoPacketEncoding bool
+	chainIDDenomTraces  bool
+	denomTraceHasher    func() hash.Hash
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey,
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
 	bankKeeper types.BankKeeper, supplyKeeper types.SupplyKeeper,
 	scopedKeeper capability.ScopedKeeper,
@@ -47,14 +70,22 @@ func NewKeeper(
 		panic("the IBC transfer module account has not been set")
 	}
 
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		bankKeeper:    bankKeeper,
-		supplyKeeper:  supplyKeeper,
-		scopedKeeper:  scopedKeeper,
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		bankKeeper:       bankKeeper,
+		supplyKeeper:     supplyKeeper,
+		scopedKeeper:     scopedKeeper,
+		authority:        supply.NewModuleAddress(govtypes.ModuleName),
+		metrics:          NopMetrics(),
+		denomTraceHasher: types.DefaultDenomTraceHasher,
 	}
 }
 
@@ -94,21 +125,171 @@ func (k Keeper) ChanCloseInit(ctx sdk.Context, portID, channelID string) error {
 // order to expose it to module's InitGenesis function
 func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
 	// Set the portID into our store so we can retrieve it later
-	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(types.PortKey), []byte(portID))
+	k.SetPort(ctx, portID)
 
 	cap := k.portKeeper.BindPort(ctx, portID)
 	return k.ClaimCapability(ctx, cap, porttypes.PortPath(portID))
 }
 
+// IsBound checks if the transfer module already owns the capability of the
+// given port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, porttypes.PortPath(portID))
+	return ok
+}
+
 // GetPort returns the portID for the transfer module. Used in ExportGenesis
 func (k Keeper) GetPort(ctx sdk.Context) string {
 	store := ctx.KVStore(k.storeKey)
 	return string(store.Get([]byte(types.PortKey)))
 }
 
+// SetPort sets the portID for the transfer module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.PortKey), []byte(portID))
+}
+
+// GetTransferChannels returns all the channels of the port bound by the transfer
+// module.
+func (k Keeper) GetTransferChannels(ctx sdk.Context) []channel.IdentifiedChannel {
+	channels := []channel.IdentifiedChannel{}
+	k.channelKeeper.IteratePortChannels(ctx, k.GetPort(ctx), func(ch channel.IdentifiedChannel) bool {
+		channels = append(channels, ch)
+		return false
+	})
+	return channels
+}
+
 // ClaimCapability allows the transfer module that can claim a capability that IBC module
 // passes to it
 func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capability.Capability, name string) error {
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
+
+// GetDenomTrace retrieves the full identifiers trace and base denomination from the store.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyDenomTrace(denomTraceHash))
+	if bz == nil {
+		return types.DenomTrace{}, false
+	}
+
+	var denomTrace types.DenomTrace
+	k.cdc.MustUnmarshalBinaryBare(bz, &denomTrace)
+	return denomTrace, true
+}
+
+// HasDenomTrace checks if a the key with the given denomination trace hash exists on the store.
+func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyDenomTrace(denomTraceHash))
+}
+
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(denomTrace)
+	store.Set(types.KeyDenomTrace(k.DenomTraceHash(denomTrace)), bz)
+}
+
+// GetDenomTraceByPath returns the denomination trace stored for the given path
+// and base denomination. If the chain ID scheme is enabled, the chain ID aware
+// trace of the path is returned, or the legacy trace stored before the scheme
+// was enabled if there is none.
+func (k Keeper) GetDenomTraceByPath(ctx sdk.Context, path, baseDenom string) (types.DenomTrace, bool) {
+	legacyTrace := types.NewDenomTrace(path, baseDenom)
+	if denomTrace, err := k.voucherTrace(ctx, legacyTrace.GetFullDenomPath()); err == nil {
+		if denomTrace, found := k.GetDenomTrace(ctx, k.DenomTraceHash(denomTrace)); found {
+			return denomTrace, true
+		}
+	}
+
+	return k.GetDenomTrace(ctx, k.DenomTraceHash(legacyTrace))
+}
+
+// IterateDenomTraces iterates over the denomination traces in the store, sorted
+// by their hash, without loading them all in memory. For each trace, cb will be
+// called. If the cb returns true, the iterator will close and stop.
+//
+// NOTE: the store can't be written by cb, as it's iterated.
+func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.DenomTrace) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomTraceKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var denomTrace types.DenomTrace
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &denomTrace)
+
+		if cb(denomTrace) {
+			break
+		}
+	}
+}
+
+// GetAllDenomTraces returns all the denomination traces from the store, sorted
+// by their hash.
+func (k Keeper) GetAllDenomTraces(ctx sdk.Context) []types.DenomTrace {
+	denomTraces := []types.DenomTrace{}
+	k.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		denomTraces = append(denomTraces, denomTrace)
+		return false
+	})
+	return denomTraces
+}
+
+// DenomPathFromHash returns the full denomination path prefix from an ibc denom
+// with a hash component (i.e ibc/{hash}). Denominations without the IBC voucher
+// format are returned unchanged.
+func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
+	if !types.IsIBCDenom(denom) {
+		return denom, nil
+	}
+
+	hash, err := k.parseTraceHash(strings.TrimPrefix(denom, types.DenomPrefix+"/"))
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hash.String())
+	}
+	return denomTrace.GetFullDenomPath(), nil
+}
+
+// resolveDenomTrace returns the denomination trace of the given denom. Hashed
+// voucher denominations (i.e ibc/{hash}) are resolved from the trace store,
+// while the other denominations are parsed from their prefixes.
+func (k Keeper) resolveDenomTrace(ctx sdk.Context, denom string) (types.DenomTrace, bool) {
+	if !types.IsIBCDenom(denom) {
+		return types.ParseDenomTrace(denom), true
+	}
+
+	hash, err := k.parseTraceHash(strings.TrimPrefix(denom, types.DenomPrefix+"/"))
+	if err != nil {
+		return types.DenomTrace{}, false
+	}
+	return k.GetDenomTrace(ctx, hash)
+}
+
+// IsVoucherDenom checks if the given denomination is an IBC voucher (i.e a token
+// whose source is another chain), either hashed or with its full trace path.
+// Hashed denominations without a stored trace are not considered vouchers.
+func (k Keeper) IsVoucherDenom(ctx sdk.Context, denom string) bool {
+	denomTrace, found := k.resolveDenomTrace(ctx, denom)
+	return found && denomTrace.Path != ""
+}
+
+// GetNativeDenom returns the base denomination of the given denomination on its
+// source chain (i.e without the trace path). Native denominations are returned
+// unchanged. It returns false if the denomination is hashed and its trace is
+// unknown.
+func (k Keeper) GetNativeDenom(ctx sdk.Context, denom string) (string, bool) {
+	denomTrace, found := k.resolveDenomTrace(ctx, denom)
+	if !found {
+		return "", false
+	}
+	return denomTrace.BaseDenom, true
+}
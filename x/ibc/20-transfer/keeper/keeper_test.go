@@ -17,7 +17,9 @@ import (
 	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
@@ -47,6 +49,9 @@ var (
 	testCoins, _ = sdk.ParseCoins("100atom")
 	prefixCoins  = sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
 	prefixCoins2 = sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	prefixTrace  = types.ParseDenomTrace("bank/firstchannel/atom")
+
+	testTimeoutHeight = ibctypes.NewHeight(0, 110)
 )
 
 type KeeperTestSuite struct {
@@ -62,6 +67,11 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.chainA = NewTestChain(testClientIDA)
 	suite.chainB = NewTestChain(testClientIDB)
 
+	// the tests send tokens through the bank port, which is bound as the transfer
+	// port instead of the one bound on genesis
+	suite.chainA.bindPort(testPort1)
+	suite.chainA.App.TransferKeeper.SetPort(suite.chainA.GetContext(), testPort1)
+
 	suite.cdc = suite.chainA.App.Codec()
 }
 
@@ -92,6 +102,97 @@ func (suite *KeeperTestSuite) TestGetTransferAccount() {
 	suite.Equal(expectedMaccAddr, macc.GetAddress())
 }
 
+func (suite *KeeperTestSuite) TestDenomTrace() {
+	ctx := suite.chainA.GetContext()
+
+	_, found := suite.chainA.App.TransferKeeper.GetDenomTrace(ctx, prefixTrace.Hash())
+	suite.Require().False(found)
+	suite.Require().False(suite.chainA.App.TransferKeeper.HasDenomTrace(ctx, prefixTrace.Hash()))
+
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, prefixTrace)
+
+	trace, found := suite.chainA.App.TransferKeeper.GetDenomTrace(ctx, prefixTrace.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(prefixTrace, trace)
+	suite.Require().True(suite.chainA.App.TransferKeeper.HasDenomTrace(ctx, prefixTrace.Hash()))
+
+	fullDenomPath, err := suite.chainA.App.TransferKeeper.DenomPathFromHash(ctx, prefixTrace.IBCDenom())
+	suite.Require().NoError(err)
+	suite.Require().Equal("bank/firstchannel/atom", fullDenomPath)
+
+	fullDenomPath, err = suite.chainA.App.TransferKeeper.DenomPathFromHash(ctx, "atom")
+	suite.Require().NoError(err)
+	suite.Require().Equal("atom", fullDenomPath)
+
+	_, err = suite.chainA.App.TransferKeeper.DenomPathFromHash(ctx, types.ParseDenomTrace("bank/secondchannel/atom").IBCDenom())
+	suite.Require().Error(err, "denom trace not found")
+
+	_, err = suite.chainA.App.TransferKeeper.DenomPathFromHash(ctx, "ibc/invalidhash")
+	suite.Require().Error(err, "invalid hash")
+}
+
+func (suite *KeeperTestSuite) TestIterateDenomTraces() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	count := 0
+	transferKeeper.IterateDenomTraces(ctx, func(types.DenomTrace) bool {
+		count++
+		return false
+	})
+	suite.Require().Zero(count)
+
+	for i := 0; i < 5; i++ {
+		transferKeeper.SetDenomTrace(ctx, types.ParseDenomTrace(fmt.Sprintf("bank/firstchannel/denom%d", i)))
+	}
+
+	// every trace is visited once, in the order of GetAllDenomTraces
+	var denomTraces []types.DenomTrace
+	transferKeeper.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		denomTraces = append(denomTraces, denomTrace)
+		return false
+	})
+	suite.Require().Len(denomTraces, 5)
+	suite.Require().Equal(transferKeeper.GetAllDenomTraces(ctx), denomTraces)
+
+	// the iteration stops once the callback returns true
+	count = 0
+	transferKeeper.IterateDenomTraces(ctx, func(types.DenomTrace) bool {
+		count++
+		return count == 2
+	})
+	suite.Require().Equal(2, count)
+}
+
+func (suite *KeeperTestSuite) TestVoucherDenom() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, prefixTrace)
+
+	unknownTrace := types.ParseDenomTrace("bank/secondchannel/atom")
+
+	testCases := []struct {
+		msg        string
+		denom      string
+		expVoucher bool
+		expNative  string
+		expFound   bool
+	}{
+		{"native denom", "atom", false, "atom", true},
+		{"prefixed denom", "bank/firstchannel/atom", true, "atom", true},
+		{"hashed denom", prefixTrace.IBCDenom(), true, "atom", true},
+		{"unknown hashed denom", unknownTrace.IBCDenom(), false, "", false},
+		{"invalid hash", "ibc/invalidhash", false, "", false},
+	}
+
+	for i, tc := range testCases {
+		suite.Require().Equal(tc.expVoucher, suite.chainA.App.TransferKeeper.IsVoucherDenom(ctx, tc.denom), "test case %d failed: %s", i, tc.msg)
+
+		nativeDenom, found := suite.chainA.App.TransferKeeper.GetNativeDenom(ctx, tc.denom)
+		suite.Require().Equal(tc.expFound, found, "test case %d failed: %s", i, tc.msg)
+		suite.Require().Equal(tc.expNative, nativeDenom, "test case %d failed: %s", i, tc.msg)
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -269,6 +370,28 @@ func (chain *TestChain) createChannel(
 	return channel
 }
 
+// bindPort binds the given port and claims its capability for the transfer
+// module.
+func (chain *TestChain) bindPort(portID string) {
+	ctx := chain.GetContext()
+	cap := chain.App.IBCKeeper.PortKeeper.BindPort(ctx, portID)
+	if err := chain.App.TransferKeeper.ClaimCapability(ctx, cap, porttypes.PortPath(portID)); err != nil {
+		panic(err)
+	}
+}
+
+// newTransferKeeper returns a transfer keeper of the chain's stores like the app
+// one, but without the connection and client keepers of the app.
+func (chain *TestChain) newTransferKeeper() keeper.Keeper {
+	app := chain.App
+	return keeper.NewKeeper(
+		app.Codec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.BankKeeper, app.SupplyKeeper,
+		app.ScopedTransferKeeper,
+	)
+}
+
 func nextHeader(chain *TestChain) ibctmtypes.Header {
 	return ibctmtypes.CreateTestHeader(chain.Header.ChainID, chain.Header.Height+1,
 		chain.Header.Time.Add(time.Minute), chain.Vals, chain.Signers)
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// GetSelfLoopbackCheck returns true if the transfers sent to the sender account
+// over a loopback channel are rejected.
+func (k Keeper) GetSelfLoopbackCheck(ctx sdk.Context) bool {
+	enabled := types.DefaultSelfLoopbackCheck
+	k.paramSpace.GetIfExists(ctx, types.KeySelfLoopbackCheck, &enabled)
+	return enabled
+}
+
+// SetSelfLoopbackCheck enables or disables the check of the transfers sent to
+// the sender account over a loopback channel.
+func (k Keeper) SetSelfLoopbackCheck(ctx sdk.Context, enabled bool) {
+	k.paramSpace.Set(ctx, types.KeySelfLoopbackCheck, enabled)
+}
+
+// isLoopbackChannel returns true if the counterparty of the given channel end is
+// a channel end of the same port on this chain, whose counterparty is the given
+// channel.
+func (k Keeper) isLoopbackChannel(ctx sdk.Context, portID, channelID string, channelEnd channel.Channel) bool {
+	if channelEnd.Counterparty.PortID != portID {
+		return false
+	}
+
+	counterpartyEnd, found := k.channelKeeper.GetChannel(ctx, channelEnd.Counterparty.PortID, channelEnd.Counterparty.ChannelID)
+	if !found {
+		return false
+	}
+
+	return counterpartyEnd.Counterparty.PortID == portID && counterpartyEnd.Counterparty.ChannelID == channelID
+}
+
+// checkSelfLoopback returns an error if the self loopback check is enabled and
+// the tokens are sent to the sender account over a loopback channel. Such a
+// transfer is valid, but it returns the tokens to the sender and usually
+// indicates a misconfigured relayer.
+func (k Keeper) checkSelfLoopback(
+	ctx sdk.Context, portID, channelID string, channelEnd channel.Channel, sender sdk.AccAddress, receiver string,
+) error {
+	if !k.GetSelfLoopbackCheck(ctx) {
+		return nil
+	}
+
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil || !receiverAddr.Equals(sender) {
+		return nil
+	}
+
+	if !k.isLoopbackChannel(ctx, portID, channelID, channelEnd) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(
+		types.ErrSelfLoopbackTransfer,
+		"%s is both the sender and the receiver of a transfer over the loopback channel %s/%s", receiver, portID, channelID,
+	)
+}
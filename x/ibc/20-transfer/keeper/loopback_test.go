@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestSelfLoopbackCheck() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+
+	testCases := []struct {
+		msg      string
+		enabled  bool
+		loopback bool
+		receiver string
+		expPass  bool
+	}{
+		{"check disabled", false, true, sender.String(), true},
+		{"loopback to the sender", true, true, sender.String(), false},
+		{"loopback to another account", true, true, testAddr2.String(), true},
+		{"channel to another chain", true, false, sender.String(), true},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		suite.chainA.CreateClient(suite.chainB)
+		suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+		ctx := suite.chainA.GetContext()
+
+		suite.Require().False(suite.chainA.App.TransferKeeper.GetSelfLoopbackCheck(ctx))
+		suite.chainA.App.TransferKeeper.SetSelfLoopbackCheck(ctx, tc.enabled)
+		suite.Require().Equal(tc.enabled, suite.chainA.App.TransferKeeper.GetSelfLoopbackCheck(ctx))
+
+		counterpartyPort := testPort2
+		if tc.loopback {
+			// both ends of the channel are on chainA
+			counterpartyPort = testPort1
+			suite.setupTransferChannel(testPort1, testChannel2, testPort1, testChannel1)
+		}
+		suite.setupTransferChannel(testPort1, testChannel1, counterpartyPort, testChannel2)
+
+		amount := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(counterpartyPort, testChannel2)+"atom", 10))
+
+		_ = suite.chainA.App.BankKeeper.SetBalances(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+		err := suite.chainA.App.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, amount, sender, tc.receiver, testTimeoutHeight, 0, "")
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrSelfLoopbackTransfer.Is(err), "invalid test case %d passed: %s: %v", i, tc.msg, err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "ibc_transfer"
+
+	labelPort    = "port"
+	labelChannel = "channel"
+	labelDenom   = "denom"
+)
+
+// Metrics contains metrics exposed by the transfer keeper. The transfer
+// metrics are labeled by port, channel and denomination.
+type Metrics struct {
+	// Number of transfers sent.
+	SendTransfers metrics.Counter
+	// Amount of tokens sent.
+	SendAmount metrics.Counter
+	// Number of transfers received.
+	RecvTransfers metrics.Counter
+	// Amount of tokens received.
+	RecvAmount metrics.Counter
+	// Amount of tokens held on the escrow account of a channel.
+	EscrowBalance metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	labels = append(labels, labelPort, labelChannel, labelDenom)
+
+	return &Metrics{
+		SendTransfers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "send_transfers",
+			Help:      "Number of fungible token transfers sent.",
+		}, labels).With(labelsAndValues...),
+		SendAmount: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "send_amount",
+			Help:      "Amount of tokens sent.",
+		}, labels).With(labelsAndValues...),
+		RecvTransfers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "recv_transfers",
+			Help:      "Number of fungible token transfers received.",
+		}, labels).With(labelsAndValues...),
+		RecvAmount: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "recv_amount",
+			Help:      "Amount of tokens received.",
+		}, labels).With(labelsAndValues...),
+		EscrowBalance: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "escrow_balance",
+			Help:      "Amount of tokens held on the escrow account of a channel.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SendTransfers: discard.NewCounter(),
+		SendAmount:    discard.NewCounter(),
+		RecvTransfers: discard.NewCounter(),
+		RecvAmount:    discard.NewCounter(),
+		EscrowBalance: discard.NewGauge(),
+	}
+}
+
+// WithMetrics returns a copy of the keeper that reports to the given metrics.
+func (k Keeper) WithMetrics(metrics *Metrics) Keeper {
+	k.metrics = metrics
+	return k
+}
+
+// recordSend reports the coins sent through the given channel.
+func (k Keeper) recordSend(portID, channelID string, coins sdk.Coins) {
+	for _, coin := range coins {
+		labels := []string{labelPort, portID, labelChannel, channelID, labelDenom, coin.Denom}
+		k.metrics.SendTransfers.With(labels...).Add(1)
+		k.metrics.SendAmount.With(labels...).Add(amountToFloat(coin.Amount))
+	}
+}
+
+// recordRecv reports the coins received through the given channel.
+func (k Keeper) recordRecv(portID, channelID string, coins sdk.Coins) {
+	for _, coin := range coins {
+		labels := []string{labelPort, portID, labelChannel, channelID, labelDenom, coin.Denom}
+		k.metrics.RecvTransfers.With(labels...).Add(1)
+		k.metrics.RecvAmount.With(labels...).Add(amountToFloat(coin.Amount))
+	}
+}
+
+// recordEscrowBalance reports the escrow account balances of the given
+// denominations after they changed.
+//
+// NOTE: the balances are read with an infinite gas meter so that the gas
+// consumed by a transaction doesn't depend on whether a node has metrics enabled.
+func (k Keeper) recordEscrowBalance(ctx sdk.Context, portID, channelID string, coins sdk.Coins) {
+	// skip the balance reads if the metrics are disabled
+	if k.metrics.EscrowBalance == discard.NewGauge() {
+		return
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	escrowAddress := k.GetEscrowAddress(ctx, portID, channelID)
+	for _, coin := range coins {
+		balance := k.bankKeeper.GetBalance(ctx, escrowAddress, coin.Denom)
+		k.metrics.EscrowBalance.With(
+			labelPort, portID, labelChannel, channelID, labelDenom, coin.Denom,
+		).Set(amountToFloat(balance.Amount))
+	}
+}
+
+// recordConvertedEscrowBalances reports the escrow account balances converted to
+// new denominations, under both their previous and new denominations.
+func (k Keeper) recordConvertedEscrowBalances(ctx sdk.Context, balances []voucherBalance, denoms map[string]string) {
+	// skip the channel iteration if the metrics are disabled
+	if k.metrics.EscrowBalance == discard.NewGauge() {
+		return
+	}
+
+	for _, ch := range k.GetTransferChannels(ctx) {
+		escrowAddress := k.GetEscrowAddress(ctx, ch.PortIdentifier, ch.ChannelIdentifier)
+
+		var coins sdk.Coins
+		for _, balance := range balances {
+			if balance.address.Equals(escrowAddress) {
+				coins = append(coins, balance.coin, sdk.NewCoin(denoms[balance.coin.Denom], balance.coin.Amount))
+			}
+		}
+
+		if len(coins) > 0 {
+			k.recordEscrowBalance(ctx, ch.PortIdentifier, ch.ChannelIdentifier, coins)
+		}
+	}
+}
+
+// amountToFloat converts a token amount to a float64 metric value. Precision is
+// lost for amounts greater than 2^53.
+func amountToFloat(amount sdk.Int) float64 {
+	f, _ := amount.BigInt().Float64()
+	return f
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"github.com/go-kit/kit/metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// labeledValues records metric values by their label values
+type labeledValues map[string]float64
+
+type testCounter struct {
+	values labeledValues
+	labels []string
+}
+
+func (c testCounter) With(labelValues ...string) metrics.Counter {
+	return testCounter{values: c.values, labels: append(c.labels, labelValues...)}
+}
+
+func (c testCounter) Add(delta float64) {
+	c.values[strings.Join(c.labels, ",")] += delta
+}
+
+type testGauge struct {
+	values labeledValues
+	labels []string
+}
+
+func (g testGauge) With(labelValues ...string) metrics.Gauge {
+	return testGauge{values: g.values, labels: append(g.labels, labelValues...)}
+}
+
+func (g testGauge) Set(value float64) {
+	g.values[strings.Join(g.labels, ",")] = value
+}
+
+func (g testGauge) Add(delta float64) {
+	g.values[strings.Join(g.labels, ",")] += delta
+}
+
+func labelValues(portID, channelID, denom string) string {
+	return strings.Join([]string{"port", portID, "channel", channelID, "denom", denom}, ",")
+}
+
+func (suite *KeeperTestSuite) TestTransferMetrics() {
+	sendTransfers, sendAmount := labeledValues{}, labeledValues{}
+	recvTransfers, recvAmount := labeledValues{}, labeledValues{}
+	escrowBalance := labeledValues{}
+
+	transferKeeper := suite.chainA.App.TransferKeeper.WithMetrics(&keeper.Metrics{
+		SendTransfers: testCounter{values: sendTransfers},
+		SendAmount:    testCounter{values: sendAmount},
+		RecvTransfers: testCounter{values: recvTransfers},
+		RecvAmount:    testCounter{values: recvAmount},
+		EscrowBalance: testGauge{values: escrowBalance},
+	})
+
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	// send native tokens, which are escrowed
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	err = transferKeeper.SendTransfer(suite.chainA.GetContext(), testPort1, testChannel1, amount, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
+	suite.Require().NoError(err)
+
+	sendLabels := labelValues(testPort1, testChannel1, "testportid/secondchannel/atom")
+	suite.Require().Equal(labeledValues{sendLabels: 1}, sendTransfers)
+	suite.Require().Equal(labeledValues{sendLabels: 100}, sendAmount)
+	suite.Require().Equal(labeledValues{labelValues(testPort1, testChannel1, "atom"): 100}, escrowBalance)
+
+	// receive a part of the escrowed tokens back
+	received := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(40)))
+	data := types.NewFungibleTokenPacketData(received, testAddr2.String(), testAddr1.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort2, testChannel2, testPort1, testChannel1, 100, 0)
+
+	err = transferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+	suite.Require().NoError(err)
+
+	recvLabels := labelValues(testPort1, testChannel1, "testportid/secondchannel/atom")
+	suite.Require().Equal(labeledValues{recvLabels: 1}, recvTransfers)
+	suite.Require().Equal(labeledValues{recvLabels: 40}, recvAmount)
+	suite.Require().Equal(labeledValues{labelValues(testPort1, testChannel1, "atom"): 60}, escrowBalance)
+}
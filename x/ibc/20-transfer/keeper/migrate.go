@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// voucherBalance is a balance held on a voucher denomination that must be
+// migrated to its hashed denomination
+type voucherBalance struct {
+	address sdk.AccAddress
+	coin    sdk.Coin
+}
+
+// MigrateVoucherBalances converts the balances held on full voucher denomination
+// paths (i.e {portID}/{channelID}/{baseDenom}), minted before the vouchers were
+// hashed, to their ibc/{hash} denomination. The trace of each of the migrated
+// denominations is stored and the total supply is updated accordingly. It
+// returns the number of migrated balances.
+//
+// A denomination is only migrated if its path is a valid trace whose first
+// channel exists on this chain, so that the balances of the other denominations
+// are left unchanged. The migration is atomic: no balance is changed if it
+// fails.
+//
+// NOTE: the vesting schedules of the vesting accounts are not migrated, so the
+// vouchers locked by a vesting account should be vested before the migration.
+func (k Keeper) MigrateVoucherBalances(ctx sdk.Context) (int, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// the balances are collected before they are changed, as the store can't be
+	// written while it's iterated
+	var balances []voucherBalance
+	k.bankKeeper.IterateAllBalances(cacheCtx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if k.isFullVoucherDenom(cacheCtx, coin.Denom) && coin.IsPositive() {
+			balances = append(balances, voucherBalance{address: address, coin: coin})
+		}
+		return false
+	})
+
+	if len(balances) == 0 {
+		return 0, nil
+	}
+
+	denoms := make(map[string]string)
+	for _, balance := range balances {
+		denomTrace, err := k.voucherTrace(cacheCtx, balance.coin.Denom)
+		if err != nil {
+			return 0, err
+		}
+		if !k.HasDenomTrace(cacheCtx, k.DenomTraceHash(denomTrace)) {
+			k.SetDenomTrace(cacheCtx, denomTrace)
+		}
+		denoms[balance.coin.Denom] = k.traceDenom(denomTrace)
+	}
+
+	if err := k.convertBalances(cacheCtx, balances, denoms); err != nil {
+		return 0, err
+	}
+
+	writeCache()
+	return len(balances), nil
+}
+
+// convertBalances moves each of the given balances to the denomination it is
+// mapped to and updates the total supply accordingly.
+func (k Keeper) convertBalances(ctx sdk.Context, balances []voucherBalance, denoms map[string]string) error {
+	supply := k.supplyKeeper.GetSupply(ctx)
+	total := supply.GetTotal()
+
+	for _, balance := range balances {
+		converted := sdk.NewCoin(denoms[balance.coin.Denom], balance.coin.Amount)
+		if err := k.bankKeeper.SetBalance(ctx, balance.address, sdk.NewCoin(balance.coin.Denom, sdk.ZeroInt())); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SetBalance(
+			ctx, balance.address, k.bankKeeper.GetBalance(ctx, balance.address, converted.Denom).Add(converted),
+		); err != nil {
+			return err
+		}
+
+		var hasNeg bool
+		total, hasNeg = total.SafeSub(sdk.NewCoins(balance.coin))
+		if hasNeg {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "total supply of %s is lower than the migrated balances", balance.coin.Denom,
+			)
+		}
+		total = total.Add(converted)
+	}
+
+	supply.SetTotal(total)
+	k.supplyKeeper.SetSupply(ctx, supply)
+	return nil
+}
+
+// isFullVoucherDenom returns true if the denomination is a full voucher
+// denomination path received over a channel of this chain.
+func (k Keeper) isFullVoucherDenom(ctx sdk.Context, denom string) bool {
+	if types.IsIBCDenom(denom) {
+		return false
+	}
+
+	denomTrace := types.ParseDenomTrace(denom)
+	if denomTrace.Path == "" || denomTrace.Validate() != nil {
+		return false
+	}
+
+	pathSplit := strings.SplitN(denomTrace.Path, "/", 3)
+	_, found := k.channelKeeper.GetChannel(ctx, pathSplit[0], pathSplit[1])
+	return found
+}
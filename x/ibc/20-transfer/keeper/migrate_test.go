@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrateVoucherBalances() {
+	ctx := suite.chainA.GetContext()
+	bankKeeper := suite.chainA.App.BankKeeper
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	holder1 := sdk.AccAddress(crypto.AddressHash([]byte("holderone")))
+	holder2 := sdk.AccAddress(crypto.AddressHash([]byte("holdertwo")))
+
+	voucherTrace := types.ParseDenomTrace("bank/firstchannel/atom")
+	multiHopTrace := types.ParseDenomTrace("bank/firstchannel/transfer/otherchannel/atom")
+	// the channel of the denomination path doesn't exist on this chain
+	unknownDenom := "transfer/unknownchannel/atom"
+
+	// the hashed vouchers received after the upgrade are merged with the migrated ones
+	balances1 := sdk.NewCoins(
+		sdk.NewInt64Coin("stake", 100),
+		sdk.NewInt64Coin(voucherTrace.GetFullDenomPath(), 10),
+		sdk.NewInt64Coin(voucherTrace.IBCDenom(), 5),
+		sdk.NewInt64Coin(unknownDenom, 20),
+	)
+	balances2 := sdk.NewCoins(
+		sdk.NewInt64Coin(voucherTrace.GetFullDenomPath(), 30),
+		sdk.NewInt64Coin(multiHopTrace.GetFullDenomPath(), 40),
+	)
+	suite.Require().NoError(bankKeeper.SetBalances(ctx, holder1, balances1))
+	suite.Require().NoError(bankKeeper.SetBalances(ctx, holder2, balances2))
+
+	supply := suite.chainA.App.SupplyKeeper.GetSupply(ctx)
+	initialTotal := supply.GetTotal()
+	supply.SetTotal(initialTotal.Add(balances1...).Add(balances2...))
+	suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply)
+
+	migrated, err := transferKeeper.MigrateVoucherBalances(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, migrated)
+
+	expBalances1 := sdk.NewCoins(
+		sdk.NewInt64Coin("stake", 100),
+		sdk.NewInt64Coin(voucherTrace.IBCDenom(), 15),
+		sdk.NewInt64Coin(unknownDenom, 20),
+	)
+	expBalances2 := sdk.NewCoins(
+		sdk.NewInt64Coin(voucherTrace.IBCDenom(), 30),
+		sdk.NewInt64Coin(multiHopTrace.IBCDenom(), 40),
+	)
+	suite.Require().Equal(expBalances1, bankKeeper.GetAllBalances(ctx, holder1))
+	suite.Require().Equal(expBalances2, bankKeeper.GetAllBalances(ctx, holder2))
+
+	for _, denomTrace := range []types.DenomTrace{voucherTrace, multiHopTrace} {
+		trace, found := transferKeeper.GetDenomTrace(ctx, denomTrace.Hash())
+		suite.Require().True(found, "trace %s was not stored", denomTrace)
+		suite.Require().Equal(denomTrace, trace)
+	}
+	_, found := transferKeeper.GetDenomTrace(ctx, types.ParseDenomTrace(unknownDenom).Hash())
+	suite.Require().False(found)
+
+	expTotal := initialTotal.Add(expBalances1...).Add(expBalances2...)
+	suite.Require().Equal(expTotal, suite.chainA.App.SupplyKeeper.GetSupply(ctx).GetTotal())
+
+	// the migration is a no-op once the balances are migrated
+	migrated, err = transferKeeper.MigrateVoucherBalances(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Zero(migrated)
+	suite.Require().Equal(expBalances1, bankKeeper.GetAllBalances(ctx, holder1))
+}
+
+func (suite *KeeperTestSuite) TestMigrateVoucherBalancesAtomic() {
+	ctx := suite.chainA.GetContext()
+	bankKeeper := suite.chainA.App.BankKeeper
+
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	holder := sdk.AccAddress(crypto.AddressHash([]byte("holder")))
+	balances := sdk.NewCoins(sdk.NewInt64Coin("bank/firstchannel/atom", 10))
+	suite.Require().NoError(bankKeeper.SetBalances(ctx, holder, balances))
+
+	// the vouchers are not tracked by the total supply
+	_, err := suite.chainA.App.TransferKeeper.MigrateVoucherBalances(ctx)
+	suite.Require().Error(err)
+	suite.Require().Equal(balances, bankKeeper.GetAllBalances(ctx, holder))
+	suite.Require().Empty(suite.chainA.App.TransferKeeper.GetAllDenomTraces(ctx))
+}
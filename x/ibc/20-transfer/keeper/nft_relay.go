@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// WithNFTKeeper returns a copy of the keeper that relays the non-fungible
+// tokens held by the given keeper. The non-fungible token transfers are disabled
+// if no keeper is set.
+func (k Keeper) WithNFTKeeper(nftKeeper types.NFTKeeper) Keeper {
+	k.nftKeeper = nftKeeper
+	return k
+}
+
+// SendNFTTransfer handles the non-fungible token transfer sending logic, which
+// mirrors the fungible token one (see SendTransfer):
+//
+// 1. Sender chain is the source chain of the tokens: the class ID is prefixed
+// with the destination port and channel IDs and the tokens are transferred to
+// the escrow account of the channel.
+//
+// 2. Tokens are vouchers from another chain: the class ID is prefixed with the
+// source port and channel IDs and the tokens are burned.
+func (k Keeper) SendNFTTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) error {
+	if k.nftKeeper == nil {
+		return types.ErrNFTTransfersDisabled
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if sourceChannelEnd.State != channelexported.OPEN {
+		return sdkerrors.Wrapf(
+			channel.ErrInvalidChannelState,
+			"channel %s/%s is not OPEN (got %s)", sourcePort, sourceChannel, sourceChannelEnd.State.String(),
+		)
+	}
+
+	destinationPort := sourceChannelEnd.Counterparty.PortID
+	destinationChannel := sourceChannelEnd.Counterparty.ChannelID
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return channel.ErrSequenceSendNotFound
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, ibctypes.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	prefix := types.GetDenomPrefix(destinationPort, destinationChannel)
+	source := strings.HasPrefix(classID, prefix)
+
+	if source {
+		// escrow the tokens under the class ID cleared from the prefix
+		escrowAddress := k.GetEscrowAddress(ctx, sourcePort, sourceChannel)
+		for _, tokenID := range tokenIDs {
+			if err := k.transferOwnedNFT(ctx, classID[len(prefix):], tokenID, sender, escrowAddress); err != nil {
+				return err
+			}
+		}
+	} else {
+		prefix = types.GetDenomPrefix(sourcePort, sourceChannel)
+		if !strings.HasPrefix(classID, prefix) {
+			return sdkerrors.Wrapf(types.ErrInvalidClassID, "class ID was: %s", classID)
+		}
+
+		// burn the vouchers if the source is from another chain
+		for _, tokenID := range tokenIDs {
+			if err := k.checkNFTOwner(ctx, classID, tokenID, sender); err != nil {
+				return err
+			}
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return err
+			}
+		}
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(classID, tokenIDs, sender.String(), receiver)
+
+	packet := channel.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return err
+	}
+
+	k.recordPacketSent(ctx, packet)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNFTTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(tokenIDs, ",")),
+			sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, sourceChannel),
+		),
+	)
+
+	return nil
+}
+
+// OnRecvNFTPacket mints the vouchers of the received tokens, or unescrows them
+// if they are returning to their source chain.
+func (k Keeper) OnRecvNFTPacket(ctx sdk.Context, packet channel.Packet, data types.NonFungibleTokenPacketData) error {
+	if k.nftKeeper == nil {
+		return types.ErrNFTTransfersDisabled
+	}
+
+	prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	source := strings.HasPrefix(data.ClassID, prefix)
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	if types.IsEscrowAddress(receiver, packet.GetDestPort(), packet.GetDestChannel()) {
+		return sdkerrors.Wrapf(
+			types.ErrEscrowReceiver, "%s is the escrow account of channel %s/%s", data.Receiver, packet.GetDestPort(), packet.GetDestChannel(),
+		)
+	}
+
+	if source {
+		// mint the vouchers under the prefixed class ID
+		for _, tokenID := range data.TokenIDs {
+			if err := k.nftKeeper.Mint(ctx, data.ClassID, tokenID, receiver); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	prefix = types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	if !strings.HasPrefix(data.ClassID, prefix) {
+		return sdkerrors.Wrapf(types.ErrInvalidClassID, "%s doesn't contain the prefix '%s'", data.ClassID, prefix)
+	}
+
+	// unescrow the tokens
+	escrowAddress := k.GetEscrowAddress(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	for _, tokenID := range data.TokenIDs {
+		if err := k.transferOwnedNFT(ctx, data.ClassID[len(prefix):], tokenID, escrowAddress, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnAcknowledgementNFTPacket refunds the sender if the packet failed on the
+// receiving chain.
+func (k Keeper) OnAcknowledgementNFTPacket(
+	ctx sdk.Context, packet channel.Packet, data types.NonFungibleTokenPacketData, ack types.FungibleTokenPacketAcknowledgement,
+) error {
+	if !ack.Success {
+		return k.refundNFTPacket(ctx, packet, data)
+	}
+	return nil
+}
+
+// OnTimeoutNFTPacket refunds the sender of a packet that timed out.
+func (k Keeper) OnTimeoutNFTPacket(ctx sdk.Context, packet channel.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundNFTPacket(ctx, packet, data)
+}
+
+// refundNFTPacket reverts the send: the escrowed tokens are returned to the
+// sender and the burned vouchers are minted again.
+func (k Keeper) refundNFTPacket(ctx sdk.Context, packet channel.Packet, data types.NonFungibleTokenPacketData) error {
+	if k.nftKeeper == nil {
+		return types.ErrNFTTransfersDisabled
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	if strings.HasPrefix(data.ClassID, prefix) {
+		escrowAddress := k.GetEscrowAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		for _, tokenID := range data.TokenIDs {
+			if err := k.transferOwnedNFT(ctx, data.ClassID[len(prefix):], tokenID, escrowAddress, sender); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, tokenID := range data.TokenIDs {
+		if err := k.nftKeeper.Mint(ctx, data.ClassID, tokenID, sender); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNFTOwner fails if the token is not owned by the given address.
+func (k Keeper) checkNFTOwner(ctx sdk.Context, classID, tokenID string, owner sdk.AccAddress) error {
+	tokenOwner, found := k.nftKeeper.GetOwner(ctx, classID, tokenID)
+	if !found || !tokenOwner.Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of token %s/%s", owner, classID, tokenID)
+	}
+	return nil
+}
+
+// transferOwnedNFT transfers a token after checking it is owned by the sender.
+func (k Keeper) transferOwnedNFT(ctx sdk.Context, classID, tokenID string, from, to sdk.AccAddress) error {
+	if err := k.checkNFTOwner(ctx, classID, tokenID, from); err != nil {
+		return err
+	}
+	return k.nftKeeper.Transfer(ctx, classID, tokenID, to)
+}
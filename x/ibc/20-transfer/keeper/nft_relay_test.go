@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+var (
+	nftSender   = sdk.AccAddress(crypto.AddressHash([]byte("nftsender")))
+	nftReceiver = sdk.AccAddress(crypto.AddressHash([]byte("nftreceiver")))
+)
+
+var _ types.NFTKeeper = mockNFTKeeper{}
+
+// mockNFTKeeper records the owners of the tokens by class and token ID
+type mockNFTKeeper map[string]sdk.AccAddress
+
+func (nk mockNFTKeeper) GetOwner(_ sdk.Context, classID, tokenID string) (sdk.AccAddress, bool) {
+	owner, found := nk[classID+":"+tokenID]
+	return owner, found
+}
+
+func (nk mockNFTKeeper) Transfer(_ sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	if _, found := nk[classID+":"+tokenID]; !found {
+		return errors.New("token not found")
+	}
+	nk[classID+":"+tokenID] = receiver
+	return nil
+}
+
+func (nk mockNFTKeeper) Mint(_ sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	if _, found := nk[classID+":"+tokenID]; found {
+		return errors.New("token already exists")
+	}
+	nk[classID+":"+tokenID] = receiver
+	return nil
+}
+
+func (nk mockNFTKeeper) Burn(_ sdk.Context, classID, tokenID string) error {
+	delete(nk, classID+":"+tokenID)
+	return nil
+}
+
+func (suite *KeeperTestSuite) setupNFTTransfer() (keeper.Keeper, mockNFTKeeper) {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	nftKeeper := mockNFTKeeper{}
+	return suite.chainA.App.TransferKeeper.WithNFTKeeper(nftKeeper), nftKeeper
+}
+
+func (suite *KeeperTestSuite) TestSendNFTTransfer() {
+	escrow := types.GetEscrowAddress(testPort1, testChannel1)
+
+	testCases := []struct {
+		msg       string
+		classID   string
+		malleate  func(mockNFTKeeper)
+		expOwners map[string]sdk.AccAddress
+		expPass   bool
+	}{
+		{"successful escrow of native tokens", "testportid/secondchannel/kitties",
+			func(nk mockNFTKeeper) { nk["kitties:1"], nk["kitties:2"] = nftSender, nftSender },
+			map[string]sdk.AccAddress{"kitties:1": escrow, "kitties:2": escrow}, true},
+		{"successful burn of vouchers", "bank/firstchannel/kitties",
+			func(nk mockNFTKeeper) {
+				nk["bank/firstchannel/kitties:1"], nk["bank/firstchannel/kitties:2"] = nftSender, nftSender
+			},
+			map[string]sdk.AccAddress{}, true},
+		{"sender is not the owner", "testportid/secondchannel/kitties",
+			func(nk mockNFTKeeper) { nk["kitties:1"], nk["kitties:2"] = nftSender, nftReceiver }, nil, false},
+		{"voucher not found", "bank/firstchannel/kitties",
+			func(nk mockNFTKeeper) { nk["bank/firstchannel/kitties:1"] = nftSender }, nil, false},
+		{"class ID without the channel prefix", "kitties",
+			func(nk mockNFTKeeper) { nk["kitties:1"], nk["kitties:2"] = nftSender, nftSender }, nil, false},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		transferKeeper, nftKeeper := suite.setupNFTTransfer()
+		tc.malleate(nftKeeper)
+
+		ctx := suite.chainA.GetContext()
+		err := transferKeeper.SendNFTTransfer(ctx, testPort1, testChannel1, tc.classID, []string{"1", "2"}, nftSender, nftReceiver.String(), 110, 0)
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			suite.Require().Equal(mockNFTKeeper(tc.expOwners), nftKeeper, "test case %d: %s", i, tc.msg)
+
+			commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1)
+			suite.Require().NotNil(commitment)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendNFTTransferDisabled() {
+	err := suite.chainA.App.TransferKeeper.SendNFTTransfer(
+		suite.chainA.GetContext(), testPort1, testChannel1, "kitties", []string{"1"}, nftSender, nftReceiver.String(), 110, 0,
+	)
+	suite.Require().True(types.ErrNFTTransfersDisabled.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvNFTPacket() {
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+
+	testCases := []struct {
+		msg       string
+		classID   string
+		malleate  func(mockNFTKeeper)
+		expOwners map[string]sdk.AccAddress
+		expPass   bool
+	}{
+		{"vouchers minted", "testportid/secondchannel/kitties", func(mockNFTKeeper) {},
+			map[string]sdk.AccAddress{"testportid/secondchannel/kitties:1": nftReceiver}, true},
+		{"native tokens unescrowed", "bank/firstchannel/kitties",
+			func(nk mockNFTKeeper) { nk["kitties:1"] = escrow },
+			map[string]sdk.AccAddress{"kitties:1": nftReceiver}, true},
+		{"token not escrowed", "bank/firstchannel/kitties",
+			func(nk mockNFTKeeper) { nk["kitties:1"] = nftSender }, nil, false},
+		{"class ID without the channel prefix", "kitties", func(mockNFTKeeper) {}, nil, false},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		nftKeeper := mockNFTKeeper{}
+		transferKeeper := suite.chainA.App.TransferKeeper.WithNFTKeeper(nftKeeper)
+		tc.malleate(nftKeeper)
+
+		data := types.NewNonFungibleTokenPacketData(tc.classID, []string{"1"}, nftSender.String(), nftReceiver.String())
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		err := transferKeeper.OnRecvNFTPacket(suite.chainA.GetContext(), packet, data)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			suite.Require().Equal(mockNFTKeeper(tc.expOwners), nftKeeper, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutNFTPacket() {
+	escrow := types.GetEscrowAddress(testPort1, testChannel1)
+
+	testCases := []struct {
+		msg       string
+		classID   string
+		malleate  func(mockNFTKeeper)
+		expOwners map[string]sdk.AccAddress
+	}{
+		{"escrowed tokens returned", "testportid/secondchannel/kitties",
+			func(nk mockNFTKeeper) { nk["kitties:1"] = escrow },
+			map[string]sdk.AccAddress{"kitties:1": nftSender}},
+		{"burned vouchers minted back", "bank/firstchannel/kitties", func(mockNFTKeeper) {},
+			map[string]sdk.AccAddress{"bank/firstchannel/kitties:1": nftSender}},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		nftKeeper := mockNFTKeeper{}
+		transferKeeper := suite.chainA.App.TransferKeeper.WithNFTKeeper(nftKeeper)
+		tc.malleate(nftKeeper)
+
+		data := types.NewNonFungibleTokenPacketData(tc.classID, []string{"1"}, nftSender.String(), nftReceiver.String())
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		err := transferKeeper.OnTimeoutNFTPacket(suite.chainA.GetContext(), packet, data)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		suite.Require().Equal(mockNFTKeeper(tc.expOwners), nftKeeper, "test case %d: %s", i, tc.msg)
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// maxAmountBitLen is the maximum bit length of an sdk.Int amount. Operations on
+// amounts that exceed it panic.
+const maxAmountBitLen = 255
+
+// safeAdd adds two amounts, returning an error instead of panicking if the sum
+// overflows.
+func safeAdd(a, b sdk.Int) (sdk.Int, error) {
+	sum := new(big.Int).Add(a.BigInt(), b.BigInt())
+	if sum.BitLen() > maxAmountBitLen {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrAmountOverflow, "%s + %s exceeds %d bits", a, b, maxAmountBitLen)
+	}
+	return sdk.NewIntFromBigInt(sum), nil
+}
+
+// checkBalanceOverflow fails if crediting the coins to the given account
+// overflows any of its balances.
+func (k Keeper) checkBalanceOverflow(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		balance := k.bankKeeper.GetBalance(ctx, address, coin.Denom)
+		if _, err := safeAdd(balance.Amount, coin.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "balance of %s for %s", address, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// checkMintOverflow fails if minting the coins to the given account overflows
+// their total supply or the account balances.
+func (k Keeper) checkMintOverflow(ctx sdk.Context, receiver sdk.AccAddress, coins sdk.Coins) error {
+	supply := k.supplyKeeper.GetSupply(ctx).GetTotal()
+	for _, coin := range coins {
+		if _, err := safeAdd(supply.AmountOf(coin.Denom), coin.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "total supply of %s", coin.Denom)
+		}
+	}
+	return k.checkBalanceOverflow(ctx, receiver, coins)
+}
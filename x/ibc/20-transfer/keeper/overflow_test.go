@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+var (
+	// maxAmount is the largest amount an sdk.Int can hold
+	maxAmount = sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)))
+	// maxUint64Amount is the largest amount a uint64 can hold
+	maxUint64Amount = sdk.NewIntFromUint64(math.MaxUint64)
+)
+
+// TestOnRecvPacketAmountOverflow tests that the received amounts that would
+// overflow the balances or total supply are rejected with an error.
+func (suite *KeeperTestSuite) TestOnRecvPacketAmountOverflow() {
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	voucherDenom := types.ParseDenomTrace("testportid/secondchannel/atom").IBCDenom()
+
+	testCases := []struct {
+		msg      string
+		denom    string
+		amount   sdk.Int
+		malleate func(ctx sdk.Context)
+		expPass  bool
+		expTotal sdk.Int
+	}{
+		{"mint uint64 max amount twice", "testportid/secondchannel/atom", maxUint64Amount,
+			func(ctx sdk.Context) {
+				_ = suite.chainA.App.BankKeeper.SetBalances(ctx, receiver, sdk.NewCoins(sdk.NewCoin(voucherDenom, maxUint64Amount)))
+				suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin(voucherDenom, maxUint64Amount))))
+			}, true, maxUint64Amount.MulRaw(2)},
+		{"mint up to the max amount", "testportid/secondchannel/atom", sdk.OneInt(),
+			func(ctx sdk.Context) {
+				_ = suite.chainA.App.BankKeeper.SetBalances(ctx, receiver, sdk.NewCoins(sdk.NewCoin(voucherDenom, maxAmount.SubRaw(1))))
+				suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin(voucherDenom, maxAmount.SubRaw(1)))))
+			}, true, maxAmount},
+		{"mint overflows the total supply", "testportid/secondchannel/atom", sdk.OneInt(),
+			func(ctx sdk.Context) {
+				suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewCoin(voucherDenom, maxAmount))))
+			}, false, sdk.ZeroInt()},
+		{"mint overflows the receiver balance", "testportid/secondchannel/atom", sdk.OneInt(),
+			func(ctx sdk.Context) {
+				_ = suite.chainA.App.BankKeeper.SetBalances(ctx, receiver, sdk.NewCoins(sdk.NewCoin(voucherDenom, maxAmount)))
+			}, false, maxAmount},
+		{"unescrow overflows the receiver balance", "bank/firstchannel/atom", sdk.NewInt(100),
+			func(ctx sdk.Context) {
+				_ = suite.chainA.App.BankKeeper.SetBalances(ctx, types.GetEscrowAddress(testPort2, testChannel2), testCoins)
+				_ = suite.chainA.App.BankKeeper.SetBalances(ctx, receiver, sdk.NewCoins(sdk.NewCoin("atom", maxAmount)))
+			}, false, maxAmount},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		ctx := suite.chainA.GetContext()
+		tc.malleate(ctx)
+
+		data := types.NewFungibleTokenPacketData(sdk.NewCoins(sdk.NewCoin(tc.denom, tc.amount)), testAddr1.String(), receiver.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		var err error
+		suite.Require().NotPanics(func() {
+			err = suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+		}, "test case %d: %s", i, tc.msg)
+
+		denom := voucherDenom
+		if tc.denom == "bank/firstchannel/atom" {
+			denom = "atom"
+		}
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrAmountOverflow.Is(err), "invalid test case %d: %s: %v", i, tc.msg, err)
+		}
+		suite.Require().Equal(tc.expTotal, suite.chainA.App.BankKeeper.GetBalance(ctx, receiver, denom).Amount, "test case %d: %s", i, tc.msg)
+	}
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// GetPacketSendHeight returns the height at which the packet in flight with the
+// given sequence was sent on a channel.
+func (k Keeper) GetPacketSendHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPacketSendHeight(portID, channelID, sequence))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// recordPacketSent records the current height as the height at which the packet
+// was sent on its source channel end.
+func (k Keeper) recordPacketSent(ctx sdk.Context, packet channelexported.PacketI) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPacketSendHeight(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// DeletePacketSendHeight deletes the height at which the packet with the given
+// sequence was sent on a channel, once the packet is acknowledged, timed out or
+// refunded.
+func (k Keeper) DeletePacketSendHeight(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPacketSendHeight(portID, channelID, sequence))
+}
+
+// GetSentCoins returns the coins taken from the sender of the packet sent with
+// the given sequence on a channel.
+func (k Keeper) GetSentCoins(ctx sdk.Context, portID, channelID string, sequence uint64) (types.SentCoins, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeySentCoins(portID, channelID, sequence))
+	if bz == nil {
+		return types.SentCoins{}, false
+	}
+
+	var sentCoins types.SentCoins
+	k.cdc.MustUnmarshalBinaryBare(bz, &sentCoins)
+	return sentCoins, true
+}
+
+// SetSentCoins stores the coins taken from the sender of the packet sent with
+// the given sequence on a channel.
+func (k Keeper) SetSentCoins(ctx sdk.Context, portID, channelID string, sequence uint64, sentCoins types.SentCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(sentCoins)
+	store.Set(types.KeySentCoins(portID, channelID, sequence), bz)
+}
+
+// DeleteSentCoins deletes the coins taken from the sender of the packet sent
+// with the given sequence on a channel.
+func (k Keeper) DeleteSentCoins(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeySentCoins(portID, channelID, sequence))
+}
+
+// convertSentCoins converts the coins recorded for the refund of the packets in
+// flight to the denomination they are mapped to, so that the refunds return the
+// converted balances.
+func (k Keeper) convertSentCoins(ctx sdk.Context, denoms map[string]string) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the records are collected before they are changed, as the store can't be
+	// written while it's iterated
+	type sentCoinsRecord struct {
+		key       []byte
+		sentCoins types.SentCoins
+	}
+	var records []sentCoinsRecord
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SentCoinsKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var sentCoins types.SentCoins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sentCoins)
+		records = append(records, sentCoinsRecord{key: iterator.Key(), sentCoins: sentCoins})
+	}
+	iterator.Close()
+
+	for _, record := range records {
+		converted := false
+		coins := make([]sdk.Coin, len(record.sentCoins.Coins))
+		for i, coin := range record.sentCoins.Coins {
+			coins[i] = coin
+			if denom, ok := denoms[coin.Denom]; ok {
+				coins[i] = sdk.NewCoin(denom, coin.Amount)
+				converted = true
+			}
+		}
+
+		if converted {
+			sentCoins := types.NewSentCoins(sdk.NewCoins(coins...), record.sentCoins.Escrowed)
+			store.Set(record.key, k.cdc.MustMarshalBinaryBare(sentCoins))
+		}
+	}
+}
+
+// GetPacketState returns the lifecycle state of the packet with the given
+// sequence on a channel end, derived from the packet commitment, the receipt and
+// the acknowledgement of the channel.
+//
+// The sequences of the packets sent and received by a channel end are
+// independent, so the packet sent with the given sequence takes precedence over
+// the packet received with the same sequence. The state is unknown if the packet
+// isn't in flight and wasn't received.
+func (k Keeper) GetPacketState(ctx sdk.Context, portID, channelID string, sequence uint64) types.PacketStateResponse {
+	res := types.NewPacketStateResponse(portID, channelID, sequence)
+
+	// the packets sent before the send heights were recorded are in flight as
+	// long as their commitment is stored
+	if k.channelKeeper.GetPacketCommitment(ctx, portID, channelID, sequence) != nil {
+		res.State = types.PacketStateSent
+		res.SendHeight, _ = k.GetPacketSendHeight(ctx, portID, channelID, sequence)
+		return res
+	}
+
+	if k.isPacketReceived(ctx, portID, channelID, sequence) {
+		res.State = types.PacketStateReceived
+	}
+
+	return res
+}
+
+// isPacketReceived returns true if the packet with the given sequence was
+// received on the channel end. Packets of ordered channels are received if
+// their sequence is lower than the next receive sequence, while packets of
+// unordered channels are received if their acknowledgement is stored.
+func (k Keeper) isPacketReceived(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	channelEnd, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return false
+	}
+
+	if channelEnd.Ordering == channelexported.ORDERED {
+		nextSequenceRecv, found := k.channelKeeper.GetNextSequenceRecv(ctx, portID, channelID)
+		return found && sequence < nextSequenceRecv
+	}
+
+	_, found = k.channelKeeper.GetPacketAcknowledgement(ctx, portID, channelID, sequence)
+	return found
+}
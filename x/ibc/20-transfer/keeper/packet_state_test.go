@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestGetPacketState() {
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.setupTransferChannel(testPort1, testChannel1, testPort2, testChannel2)
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+	channelKeeper := suite.chainA.App.IBCKeeper.ChannelKeeper
+	querier := keeper.NewQuerier(transferKeeper)
+
+	// the packets 2 and 3 are sent, and the packet 2 is acknowledged
+	channelKeeper.SetNextSequenceSend(ctx, testPort1, testChannel1, 2)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(testPort2, testChannel2)+"atom", 10))
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 20)))
+	for i := 0; i < 2; i++ {
+		suite.Require().NoError(transferKeeper.SendTransfer(ctx, testPort1, testChannel1, amount, testAddr1, testAddr2.String(), testTimeoutHeight, 0, ""))
+	}
+	sendHeight := uint64(ctx.BlockHeight())
+
+	sentPacket := channel.NewPacket(nil, 2, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+	channelKeeper.DeletePacketCommitment(ctx, testPort1, testChannel1, 2)
+	transferKeeper.DeletePacketSendHeight(ctx, sentPacket.GetSourcePort(), sentPacket.GetSourceChannel(), sentPacket.GetSequence())
+
+	// the packet 4 was sent before the send heights were recorded
+	channelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 4, []byte("commitment"))
+
+	// the packet 1 is received
+	channelKeeper.SetNextSequenceRecv(ctx, testPort1, testChannel1, 1)
+	recvPacket := channel.NewPacket(nil, 1, testPort2, testChannel2, testPort1, testChannel1, 110, 0)
+	suite.Require().NoError(transferKeeper.PacketExecuted(ctx, recvPacket, []byte("ack")))
+
+	testCases := []struct {
+		msg        string
+		sequence   uint64
+		state      types.PacketState
+		sendHeight uint64
+	}{
+		{"received packet", 1, types.PacketStateReceived, 0},
+		{"acknowledged packet", 2, types.PacketStateUnknown, 0},
+		{"packet in flight", 3, types.PacketStateSent, sendHeight},
+		{"packet in flight sent before the send heights were recorded", 4, types.PacketStateSent, 0},
+		{"packet not sent nor received", 5, types.PacketStateUnknown, 0},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryPacketState}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryPacketStateParams(testPort1, testChannel1, tc.sequence)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryPacketState}, query)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var res types.PacketStateResponse
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+
+		expRes := types.NewPacketStateResponse(testPort1, testChannel1, tc.sequence)
+		expRes.State = tc.state
+		expRes.SendHeight = tc.sendHeight
+		suite.Require().Equal(expRes, res, "test case %d: %s", i, tc.msg)
+	}
+
+	// the send height of the acknowledged packet is deleted
+	_, found := transferKeeper.GetPacketSendHeight(ctx, testPort1, testChannel1, 2)
+	suite.Require().False(found)
+
+	// the state is unknown on a channel that doesn't exist
+	suite.Require().Equal(types.PacketStateUnknown, transferKeeper.GetPacketState(ctx, testPort1, "unknownchannel", 1).State)
+}
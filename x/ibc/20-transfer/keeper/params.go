@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// GetParams returns the parameters of the IBC transfer module. The parameters
+// that were never set, e.g on chains upgraded from a version without them, take
+// their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return params
+}
+
+// SetParams sets the parameters of the IBC transfer module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// WithAuthority returns a copy of the keeper whose parameters can only be
+// updated by the given address (see UpdateParams). The authority defaults to
+// the governance module account.
+func (k Keeper) WithAuthority(authority sdk.AccAddress) Keeper {
+	k.authority = authority
+	return k
+}
+
+// GetAuthority returns the address allowed to update the parameters of the IBC
+// transfer module.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+// UpdateParams validates and sets the parameters of the IBC transfer module on
+// behalf of the given authority, which must be the authority of the keeper.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !k.authority.Equals(authority) {
+		return sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// HandleUpdateParamsProposal is a handler for executing a passed transfer
+// parameters update proposal. The parameters are updated on behalf of the
+// governance module account, which must be the authority of the keeper.
+func HandleUpdateParamsProposal(ctx sdk.Context, k Keeper, p *types.UpdateParamsProposal) error {
+	if err := k.UpdateParams(ctx, supply.NewModuleAddress(govtypes.ModuleName), p.Params); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("updated transfer params")
+	return nil
+}
+
+// GetAllowOrderedChannels returns true if the transfer channels can be opened
+// with the ORDERED ordering.
+func (k Keeper) GetAllowOrderedChannels(ctx sdk.Context) bool {
+	allowed := types.DefaultAllowOrderedChannels
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowOrderedChannels, &allowed)
+	return allowed
+}
+
+// ValidateChannelOrdering returns an error if a transfer channel can't be opened
+// with the given ordering. The channels are UNORDERED, unless the ORDERED ones
+// are allowed by the AllowOrderedChannels parameter: a packet of an ORDERED
+// channel that can't be relayed blocks all the following ones.
+func (k Keeper) ValidateChannelOrdering(ctx sdk.Context, order channelexported.Order) error {
+	switch order {
+	case channelexported.UNORDERED:
+		return nil
+	case channelexported.ORDERED:
+		if k.GetAllowOrderedChannels(ctx) {
+			return nil
+		}
+		return sdkerrors.Wrapf(
+			channel.ErrInvalidChannelOrdering, "%s transfer channels are not allowed, expected %s", order, channelexported.UNORDERED,
+		)
+	default:
+		return sdkerrors.Wrapf(channel.ErrInvalidChannelOrdering, "invalid ordering %s", order)
+	}
+}
+
+// checkSendEnabled returns an error if the transfers of any of the coins out of
+// the chain are disabled.
+func (k Keeper) checkSendEnabled(ctx sdk.Context, coins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.IsSendEnabled(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are disabled", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// checkReceiveEnabled returns an error if the transfers of any of the coins into
+// the chain are disabled.
+func (k Keeper) checkReceiveEnabled(ctx sdk.Context, coins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.IsReceiveEnabled(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrReceiveDisabled, "%s transfers are disabled", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// checkDenomTraceDepth returns an error if the trace of a received voucher has
+// more hops than allowed by the MaxDenomTraceDepth parameter.
+func (k Keeper) checkDenomTraceDepth(ctx sdk.Context, denomTrace types.DenomTrace) error {
+	params := k.GetParams(ctx)
+	if params.ExceedsMaxDenomTraceDepth(denomTrace) {
+		return sdkerrors.Wrapf(
+			types.ErrDenomTraceTooDeep, "trace %s has %d hops, maximum is %d",
+			denomTrace.GetFullDenomPath(), denomTrace.Hops(), params.MaxDenomTraceDepth,
+		)
+	}
+	return nil
+}
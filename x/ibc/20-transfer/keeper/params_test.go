@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestParams() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper
+
+	suite.Require().Equal(types.DefaultParams(), transferKeeper.GetParams(ctx))
+
+	params := types.NewParams(
+		false, true, []types.DenomEnabled{types.NewDenomEnabled("atom", true)}, nil, true, 10, true, 4, 0,
+	)
+	transferKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, transferKeeper.GetParams(ctx))
+	suite.Require().True(transferKeeper.GetSelfLoopbackCheck(ctx))
+}
+
+func (suite *KeeperTestSuite) TestSendEnabled() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+
+	testCases := []struct {
+		msg     string
+		params  types.Params
+		expPass bool
+	}{
+		{"transfers enabled", types.DefaultParams(), true},
+		{"transfers disabled", types.NewParams(false, true, nil, nil, false, 0, false, 0, 0), false},
+		{"denom disabled", types.NewParams(true, true, []types.DenomEnabled{types.NewDenomEnabled("atom", false)}, nil, false, 0, false, 0, 0), false},
+		{"other denom disabled", types.NewParams(true, true, []types.DenomEnabled{types.NewDenomEnabled("stake", false)}, nil, false, 0, false, 0, 0), true},
+		{"denom enabled while transfers disabled", types.NewParams(false, true, []types.DenomEnabled{types.NewDenomEnabled("atom", true)}, nil, false, 0, false, 0, 0), true},
+		{"receive of the denom disabled", types.NewParams(true, true, nil, []types.DenomEnabled{types.NewDenomEnabled("atom", false)}, false, 0, false, 0, 0), true},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		suite.chainA.CreateClient(suite.chainB)
+		suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+		suite.setupTransferChannel(testPort1, testChannel1, testPort2, testChannel2)
+		ctx := suite.chainA.GetContext()
+
+		suite.chainA.App.TransferKeeper.SetParams(ctx, tc.params)
+
+		amount := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(testPort2, testChannel2)+"atom", 10))
+		_ = suite.chainA.App.BankKeeper.SetBalances(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+		err := suite.chainA.App.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, amount, sender, testAddr2.String(), testTimeoutHeight, 0, "")
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrSendDisabled.Is(err), "invalid test case %d passed: %s: %v", i, tc.msg, err)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestReceiveEnabled() {
+	// the vouchers are minted on this chain, while the native tokens are returned
+	// from the counterparty chain
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(testPort2, testChannel2)+"atom", 100))
+	nativeTokens := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(testPort1, testChannel1)+"atom", 100))
+	voucherDenom := types.ParseDenomTrace(vouchers[0].Denom).IBCDenom()
+
+	testCases := []struct {
+		msg     string
+		amount  sdk.Coins
+		params  types.Params
+		expPass bool
+	}{
+		{"vouchers received", vouchers, types.DefaultParams(), true},
+		{"vouchers received while transfers disabled", vouchers, types.NewParams(true, false, nil, nil, false, 0, false, 0, 0), false},
+		{"voucher denom disabled", vouchers, types.NewParams(true, true, nil, []types.DenomEnabled{types.NewDenomEnabled(voucherDenom, false)}, false, 0, false, 0, 0), false},
+		{"send of the voucher denom disabled", vouchers, types.NewParams(true, true, []types.DenomEnabled{types.NewDenomEnabled(voucherDenom, false)}, nil, false, 0, false, 0, 0), true},
+		{"native tokens received", nativeTokens, types.DefaultParams(), true},
+		{"native denom disabled", nativeTokens, types.NewParams(true, true, nil, []types.DenomEnabled{types.NewDenomEnabled("atom", false)}, false, 0, false, 0, 0), false},
+		{"native denom enabled while transfers disabled", nativeTokens, types.NewParams(true, false, nil, []types.DenomEnabled{types.NewDenomEnabled("atom", true)}, false, 0, false, 0, 0), true},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		ctx := suite.chainA.GetContext()
+
+		suite.chainA.App.TransferKeeper.SetParams(ctx, tc.params)
+
+		escrow := types.GetEscrowAddress(testPort2, testChannel2)
+		_, err := suite.chainA.App.BankKeeper.AddCoins(ctx, escrow, testCoins)
+		suite.Require().NoError(err)
+
+		data := types.NewFungibleTokenPacketData(tc.amount, testAddr1.String(), testAddr2.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		err = suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrReceiveDisabled.Is(err), "invalid test case %d passed: %s: %v", i, tc.msg, err)
+		}
+	}
+}
@@ -0,0 +1,479 @@
+package keeper
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// NewQuerier creates a querier for the IBC transfer module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryDenomTrace:
+			res, err = queryDenomTrace(ctx, req, k)
+
+		case types.QueryDenomTraces:
+			res, err = queryDenomTraces(ctx, req, k)
+
+		case types.QueryDenomTraceByPath:
+			res, err = queryDenomTraceByPath(ctx, req, k)
+
+		case types.QueryVerifyDenomTrace:
+			res, err = queryVerifyDenomTrace(req, k)
+
+		case types.QueryRateLimit:
+			res, err = queryRateLimit(ctx, req, k)
+
+		case types.QueryTotalEscrow:
+			res, err = queryTotalEscrow(ctx, req, k)
+
+		case types.QueryEscrowAddress:
+			res, err = queryEscrowAddress(ctx, req, k)
+
+		case types.QueryEscrowAddresses:
+			res, err = queryEscrowAddresses(ctx, req, k)
+		case types.QueryDenomEscrow:
+			res, err = queryDenomEscrow(ctx, req, k)
+
+		case types.QuerySimulateTransfer:
+			res, err = querySimulateTransfer(ctx, req, k)
+
+		case types.QueryPacketFees:
+			res, err = queryPacketFees(ctx, req, k)
+
+		case types.QueryTransferChannels:
+			res, err = queryTransferChannels(ctx, req, k)
+
+		case types.QuerySendDenylist:
+			res, err = querySendDenylist(k)
+
+		case types.QueryPacketState:
+			res, err = queryPacketState(ctx, req, k)
+
+		case types.QueryParams:
+			res, err = queryParams(ctx, k)
+
+		case types.QueryEscrowSnapshot:
+			res, err = queryEscrowSnapshot(ctx, req, k)
+
+		case types.QueryChannelClientState:
+			res, err = queryChannelClientState(ctx, req, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown IBC %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryDenomTrace(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomTraceParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	hash, err := k.parseTraceHash(strings.TrimPrefix(params.Hash, types.DenomPrefix+"/"))
+	if err != nil {
+		return nil, err
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrTraceNotFound, params.Hash)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, denomTrace)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDenomTraces(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomTracesParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	denomTraces := k.GetAllDenomTraces(ctx)
+
+	start, end := client.Paginate(len(denomTraces), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		denomTraces = []types.DenomTrace{}
+	} else {
+		denomTraces = denomTraces[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, denomTraces)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDenomTraceByPath(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomTraceByPathParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := types.NewDenomTrace(params.Path, params.BaseDenom).Validate(); err != nil {
+		return nil, err
+	}
+
+	denomTrace, found := k.GetDenomTraceByPath(ctx, params.Path, params.BaseDenom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTraceNotFound, "%s/%s", params.Path, params.BaseDenom)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewDenomTraceByPathResponse(denomTrace, k.DenomTraceHash(denomTrace)))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryVerifyDenomTrace doesn't read any state: the hash is recomputed from the
+// query parameters.
+func queryVerifyDenomTrace(req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryVerifyDenomTraceParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	valid, err := types.VerifyDenomTraceWith(params.Hash, params.Path, params.BaseDenom, k.denomTraceHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := k.DenomTraceHash(types.NewDenomTrace(params.Path, params.BaseDenom))
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewVerifyDenomTraceResponse(valid, hash))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRateLimit(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRateLimitParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, params.PortID, params.ChannelID, params.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s/%s/%s", params.PortID, params.ChannelID, params.Denom)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewRateLimitResponse(rateLimit.Rollover(ctx.BlockTime())))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTotalEscrow(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTotalEscrowParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	channels := k.GetTransferChannels(ctx)
+	totalChannels := len(channels)
+
+	// only the escrow accounts of the requested page are read
+	start, end := client.Paginate(totalChannels, params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		channels = []channel.IdentifiedChannel{}
+	} else {
+		channels = channels[start:end]
+	}
+
+	total := sdk.ZeroInt()
+	for _, ch := range channels {
+		escrowAddress := k.GetEscrowAddress(ctx, ch.PortIdentifier, ch.ChannelIdentifier)
+		total = total.Add(k.bankKeeper.GetBalance(ctx, escrowAddress, params.Denom).Amount)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewTotalEscrowResponse(params.Denom, total, len(channels), totalChannels))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryEscrowAddress(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryEscrowAddressParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	version, found := k.GetEscrowAddressVersion(ctx, params.PortID, params.ChannelID)
+	if !found {
+		version = types.EscrowAddressVersionLegacy
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewEscrowAddressResponse(params.PortID, params.ChannelID, version))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryEscrowAddresses(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryEscrowAddressesParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	channels := k.GetTransferChannels(ctx)
+	totalChannels := len(channels)
+
+	start, end := client.Paginate(totalChannels, params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		channels = []channel.IdentifiedChannel{}
+	} else {
+		channels = channels[start:end]
+	}
+
+	escrowAddresses := make([]types.ChannelEscrowAddress, len(channels))
+	for i, ch := range channels {
+		version, found := k.GetEscrowAddressVersion(ctx, ch.PortIdentifier, ch.ChannelIdentifier)
+		if !found {
+			version = types.EscrowAddressVersionLegacy
+		}
+		escrowAddresses[i] = types.NewChannelEscrowAddress(ch.PortIdentifier, ch.ChannelIdentifier, version)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewEscrowAddressesResponse(escrowAddresses, totalChannels))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDenomEscrow(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomTraceParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	hash, err := k.parseTraceHash(strings.TrimPrefix(params.Hash, types.DenomPrefix+"/"))
+	if err != nil {
+		// native denominations have no trace, so their escrow account can't be resolved
+		if !strings.HasPrefix(params.Hash, types.DenomPrefix+"/") && sdk.ValidateDenom(params.Hash) == nil {
+			return nil, sdkerrors.Wrapf(types.ErrTraceNotFound, "%s is a native denomination", params.Hash)
+		}
+		return nil, err
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrTraceNotFound, params.Hash)
+	}
+
+	portID, channelID, found := denomTrace.GetFirstHop()
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTraceNotFound, "%s is a native denomination", params.Hash)
+	}
+
+	// the base denomination is escrowed by the counterparty channel end, on the
+	// chain the vouchers were received from
+	channelEnd, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	counterparty := channelEnd.Counterparty
+	escrowAddress := types.GetEscrowAddress(counterparty.PortID, counterparty.ChannelID)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewDenomEscrowResponse(
+		denomTrace, portID, channelID, counterparty.PortID, counterparty.ChannelID, escrowAddress,
+	))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySimulateTransfer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySimulateTransferParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	simulation, err := k.SimulateTransfer(ctx, params.Transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, simulation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPacketFees(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPacketFeesParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	packetFees := k.GetChannelPacketFees(ctx, params.PortID, params.ChannelID)
+
+	start, end := client.Paginate(len(packetFees), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		packetFees = []types.IdentifiedPacketFees{}
+	} else {
+		packetFees = packetFees[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, packetFees)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTransferChannels(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTransferChannelsParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	channels := k.GetTransferChannels(ctx)
+
+	start, end := client.Paginate(len(channels), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		channels = []channel.IdentifiedChannel{}
+	} else {
+		channels = channels[start:end]
+	}
+
+	transferChannels := make([]types.TransferChannel, len(channels))
+	for i, ch := range channels {
+		transferChannels[i] = types.NewTransferChannel(ch)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, transferChannels)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySendDenylist(k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetSendDenylist())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPacketState(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPacketStateParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetPacketState(ctx, params.PortID, params.ChannelID, params.Sequence))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryEscrowSnapshot(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryEscrowSnapshotParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	snapshot, found := k.GetEscrowSnapshot(ctx, params.Height)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrEscrowSnapshotNotFound, "no snapshot at or before height %d", params.Height)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, snapshot)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryChannelClientState(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryChannelClientStateParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	channelClientState, err := k.GetChannelClientState(ctx, params.PortID, params.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, channelClientState)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
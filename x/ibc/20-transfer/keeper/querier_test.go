@@ -0,0 +1,481 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+const custom = "custom"
+
+func (suite *KeeperTestSuite) TestQueryDenomTrace() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, prefixTrace)
+
+	testCases := []struct {
+		msg     string
+		hash    string
+		expPass bool
+	}{
+		{"hex hash", prefixTrace.Hash().String(), true},
+		{"ibc denom", prefixTrace.IBCDenom(), true},
+		{"not found", types.ParseDenomTrace("bank/secondchannel/atom").Hash().String(), false},
+		{"invalid hash", "invalidhash", false},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDenomTrace}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryDenomTraceParams(tc.hash)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryDenomTrace}, query)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var denomTrace types.DenomTrace
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &denomTrace))
+			suite.Require().Equal(prefixTrace, denomTrace)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			suite.Require().Nil(bz)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTraces() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	expTraces := []types.DenomTrace{}
+	for i := 0; i < 5; i++ {
+		denomTrace := types.ParseDenomTrace(fmt.Sprintf("bank/firstchannel/denom%d", i))
+		suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+		expTraces = append(expTraces, denomTrace)
+	}
+
+	testCases := []struct {
+		msg         string
+		page, limit int
+		expLen      int
+	}{
+		{"all traces", 1, 100, 5},
+		{"first page", 1, 2, 2},
+		{"last page", 3, 2, 1},
+		{"out of range page", 4, 2, 0},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDenomTraces}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryDenomTracesParams(tc.page, tc.limit)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryDenomTraces}, query)
+		suite.Require().NoError(err, "test case %d failed: %s", i, tc.msg)
+
+		var denomTraces []types.DenomTrace
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &denomTraces))
+		suite.Require().Len(denomTraces, tc.expLen, "test case %d: %s", i, tc.msg)
+
+		for _, denomTrace := range denomTraces {
+			suite.Require().Contains(expTraces, denomTrace)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTraceByPath() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	singleHop := types.ParseDenomTrace("bank/firstchannel/atom")
+	multiHop := types.ParseDenomTrace("bank/firstchannel/transfer/channeltoc/transfer/channeltod/atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, singleHop)
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, multiHop)
+
+	testCases := []struct {
+		msg       string
+		path      string
+		baseDenom string
+		expTrace  types.DenomTrace
+		expPass   bool
+	}{
+		{"single hop", "bank/firstchannel", "atom", singleHop, true},
+		{"multiple hops", "bank/firstchannel/transfer/channeltoc/transfer/channeltod", "atom", multiHop, true},
+		{"path of a hop not registered", "bank/firstchannel/transfer/channeltoc", "atom", types.DenomTrace{}, false},
+		{"base denomination not registered", "bank/firstchannel", "uatom", types.DenomTrace{}, false},
+		{"native denomination", "", "atom", types.DenomTrace{}, false},
+		{"invalid path", "bank/firstchannel/transfer", "atom", types.DenomTrace{}, false},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDenomTraceByPath}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryDenomTraceByPathParams(tc.path, tc.baseDenom)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryDenomTraceByPath}, query)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.DenomTraceByPathResponse
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(tc.expTrace, res.DenomTrace, "test case %d: %s", i, tc.msg)
+			suite.Require().Equal(tc.expTrace.Hash(), res.Hash, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			suite.Require().Nil(bz)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyDenomTrace() {
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+	denomTrace := types.ParseDenomTrace("bank/firstchannel/atom")
+
+	testCases := []struct {
+		msg      string
+		path     string
+		expValid bool
+	}{
+		{"matching path", "bank/firstchannel", true},
+		{"mismatched path", "bank/secondchannel", false},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVerifyDenomTrace}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryVerifyDenomTraceParams(denomTrace.IBCDenom(), tc.path, "atom")),
+		}
+
+		// the denomination trace isn't stored, the verification is stateless
+		bz, err := querier(suite.chainA.GetContext(), []string{types.QueryVerifyDenomTrace}, query)
+		suite.Require().NoError(err, "test case %d failed: %s", i, tc.msg)
+
+		var res types.VerifyDenomTraceResponse
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+		suite.Require().Equal(tc.expValid, res.Valid, "test case %d: %s", i, tc.msg)
+		suite.Require().Equal(types.NewDenomTrace(tc.path, "atom").Hash(), res.Hash, "test case %d: %s", i, tc.msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnknownEndpoint() {
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	_, err := querier(suite.chainA.GetContext(), []string{"unknown"}, abci.RequestQuery{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryTotalEscrow() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	// the escrow accounts of the channels of the other ports are not summed
+	channels := []string{"firstchannel", "secondchannel", "thirdchannel", "otherchannel"}
+	for i, channelID := range channels {
+		portID := testPort1
+		if channelID == "otherchannel" {
+			portID = testPort2
+		}
+		suite.chainA.createChannel(portID, channelID, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+		escrow := types.GetEscrowAddress(portID, channelID)
+		_, err := suite.chainA.App.BankKeeper.AddCoins(ctx, escrow, sdk.NewCoins(sdk.NewInt64Coin("atom", int64(10*(i+1)))))
+		suite.Require().NoError(err)
+
+		if channelID == "otherchannel" {
+			continue
+		}
+
+		capName := ibctypes.ChannelCapabilityPath(testPort1, channelID)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(ctx, capName)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(ctx, cap, capName))
+	}
+
+	testCases := []struct {
+		msg         string
+		params      types.QueryTotalEscrowParams
+		expPass     bool
+		expResponse types.TotalEscrowResponse
+	}{
+		{"all channels", types.NewQueryTotalEscrowParams("atom", 1, 100), true, types.NewTotalEscrowResponse("atom", sdk.NewInt(60), 3, 3)},
+		{"first page", types.NewQueryTotalEscrowParams("atom", 1, 2), true, types.NewTotalEscrowResponse("atom", sdk.NewInt(30), 2, 3)},
+		{"last page", types.NewQueryTotalEscrowParams("atom", 2, 2), true, types.NewTotalEscrowResponse("atom", sdk.NewInt(30), 1, 3)},
+		{"page out of range", types.NewQueryTotalEscrowParams("atom", 3, 2), true, types.NewTotalEscrowResponse("atom", sdk.ZeroInt(), 0, 3)},
+		{"denom not escrowed", types.NewQueryTotalEscrowParams("btc", 1, 100), true, types.NewTotalEscrowResponse("btc", sdk.ZeroInt(), 3, 3)},
+		{"invalid denom", types.NewQueryTotalEscrowParams("", 1, 100), false, types.TotalEscrowResponse{}},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTotalEscrow}, "/"),
+			Data: suite.cdc.MustMarshalJSON(tc.params),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryTotalEscrow}, query)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.TotalEscrowResponse
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(tc.expResponse, res, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			suite.Require().Nil(bz)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowAddresses() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	// the channels of the other ports are not listed
+	channels := []string{"firstchannel", "secondchannel", "thirdchannel", "otherchannel"}
+	for _, channelID := range channels {
+		portID := testPort1
+		if channelID == "otherchannel" {
+			portID = testPort2
+		}
+		suite.chainA.createChannel(portID, channelID, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+		if channelID == "otherchannel" {
+			continue
+		}
+
+		capName := ibctypes.ChannelCapabilityPath(testPort1, channelID)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(ctx, capName)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(ctx, cap, capName))
+	}
+	suite.chainA.App.TransferKeeper.SetEscrowAddressVersion(ctx, testPort1, "secondchannel", types.EscrowAddressVersionADR028)
+
+	expAddresses := []types.ChannelEscrowAddress{
+		types.NewChannelEscrowAddress(testPort1, "firstchannel", types.EscrowAddressVersionLegacy),
+		types.NewChannelEscrowAddress(testPort1, "secondchannel", types.EscrowAddressVersionADR028),
+		types.NewChannelEscrowAddress(testPort1, "thirdchannel", types.EscrowAddressVersionLegacy),
+	}
+	suite.Require().Equal(types.GetVersionedEscrowAddress(types.EscrowAddressVersionADR028, testPort1, "secondchannel"), expAddresses[1].Address)
+	suite.Require().Equal(types.GetFeeEscrowAddress(testPort1, "secondchannel"), expAddresses[1].FeeAddress)
+
+	testCases := []struct {
+		msg          string
+		page, limit  int
+		expAddresses []types.ChannelEscrowAddress
+	}{
+		{"all channels", 1, 100, expAddresses},
+		{"first page", 1, 2, expAddresses[:2]},
+		{"last page", 2, 2, expAddresses[2:]},
+		{"page out of range", 3, 2, nil},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryEscrowAddresses}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryEscrowAddressesParams(tc.page, tc.limit)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryEscrowAddresses}, query)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var res types.EscrowAddressesResponse
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+		suite.Require().Equal(types.NewEscrowAddressesResponse(tc.expAddresses, 3), res, "test case %d: %s", i, tc.msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomEscrow() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, prefixTrace)
+	nativeTrace := types.ParseDenomTrace("atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, nativeTrace)
+	unknownChannelTrace := types.ParseDenomTrace("bank/otherchannel/atom")
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, unknownChannelTrace)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+
+	// the atoms are escrowed by the counterparty channel end
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+
+	testCases := []struct {
+		msg    string
+		hash   string
+		expErr error
+	}{
+		{"hex hash", prefixTrace.Hash().String(), nil},
+		{"ibc denom", prefixTrace.IBCDenom(), nil},
+		{"unknown hash", types.ParseDenomTrace("bank/secondchannel/atom").Hash().String(), types.ErrTraceNotFound},
+		{"native denom", "atom", types.ErrTraceNotFound},
+		{"trace without path", nativeTrace.Hash().String(), types.ErrTraceNotFound},
+		{"channel not found", unknownChannelTrace.Hash().String(), channeltypes.ErrChannelNotFound},
+		{"invalid hash", types.DenomPrefix + "/invalidhash", types.ErrInvalidDenomTrace},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDenomEscrow}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryDenomTraceParams(tc.hash)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryDenomEscrow}, query)
+		if tc.expErr == nil {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.DenomEscrowResponse
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(
+				types.NewDenomEscrowResponse(prefixTrace, testPort1, testChannel1, testPort2, testChannel2, escrow), res,
+				"test case %d: %s", i, tc.msg,
+			)
+		} else {
+			suite.Require().True(errors.Is(err, tc.expErr), "test case %d: %s: unexpected error %v", i, tc.msg, err)
+			suite.Require().Nil(bz)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferChannels() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+	portID := suite.chainA.App.TransferKeeper.GetPort(ctx)
+
+	suite.chainA.createChannel(portID, "firstchannel", testPort2, testChannel1, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.createChannel(portID, "secondchannel", testPort2, testChannel2, channelexported.INIT, channelexported.UNORDERED, testConnection)
+	// channels of other ports are not returned
+	suite.chainA.createChannel(testPort2, "thirdchannel", portID, testChannel1, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	expChannels := []types.TransferChannel{
+		{ChannelID: "firstchannel", State: channelexported.OPEN, Counterparty: channeltypes.NewCounterparty(testPort2, testChannel1)},
+		{ChannelID: "secondchannel", State: channelexported.INIT, Counterparty: channeltypes.NewCounterparty(testPort2, testChannel2)},
+	}
+
+	testCases := []struct {
+		msg         string
+		page        int
+		limit       int
+		expChannels []types.TransferChannel
+	}{
+		{"all channels", 1, 100, expChannels},
+		{"second page", 2, 1, expChannels[1:]},
+		{"page out of range", 3, 1, nil},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTransferChannels}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryTransferChannelsParams(tc.page, tc.limit)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryTransferChannels}, query)
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		var channels []types.TransferChannel
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &channels))
+		suite.Require().Equal(tc.expChannels, channels, "valid test case %d failed: %s", i, tc.msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowSnapshot() {
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	suite.chainA.App.TransferKeeper.SnapshotEscrowBalances(ctx.WithBlockHeight(10))
+	snapshot, found := suite.chainA.App.TransferKeeper.GetEscrowSnapshot(ctx, 10)
+	suite.Require().True(found)
+
+	testCases := []struct {
+		msg     string
+		height  uint64
+		expPass bool
+	}{
+		{"snapshot at the height", 10, true},
+		{"snapshot before the height", 15, true},
+		{"no snapshot before the height", 5, false},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryEscrowSnapshot}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryEscrowSnapshotParams(tc.height)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryEscrowSnapshot}, query)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.EscrowSnapshot
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(snapshot, res, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().True(errors.Is(err, types.ErrEscrowSnapshotNotFound), "test case %d: %s: unexpected error %v", i, tc.msg, err)
+			suite.Require().Nil(bz)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelClientState() {
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createConnection("connectionnoclient", testConnection, "nonexistentclient", testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.createChannel(testPort1, "channelnoconnection", testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, "nonexistentconnection")
+	suite.chainA.createChannel(testPort1, "channelnoclient", testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, "connectionnoclient")
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper.WithClientKeepers(
+		suite.chainA.App.IBCKeeper.ConnectionKeeper, suite.chainA.App.IBCKeeper.ClientKeeper,
+	)
+	clientState, found := suite.chainA.App.IBCKeeper.ClientKeeper.GetClientState(ctx, testClientIDB)
+	suite.Require().True(found)
+
+	testCases := []struct {
+		msg       string
+		keeper    keeper.Keeper
+		channelID string
+		expErr    error
+	}{
+		{"client of the channel", transferKeeper, testChannel1, nil},
+		{"client keepers not set", suite.chainA.newTransferKeeper(), testChannel1, types.ErrClientKeepersNotSet},
+		{"channel not found", transferKeeper, "nonexistentchannel", channeltypes.ErrChannelNotFound},
+		{"connection not found", transferKeeper, "channelnoconnection", connectiontypes.ErrConnectionNotFound},
+		{"client not found", transferKeeper, "channelnoclient", clienttypes.ErrClientNotFound},
+	}
+
+	for i, tc := range testCases {
+		querier := keeper.NewQuerier(tc.keeper)
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryChannelClientState}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryChannelClientStateParams(testPort1, tc.channelID)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryChannelClientState}, query)
+		if tc.expErr == nil {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.ChannelClientState
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal([]string{testConnection}, res.ConnectionHops, "test case %d: %s", i, tc.msg)
+			suite.Require().Equal(testClientIDB, res.ClientID, "test case %d: %s", i, tc.msg)
+			suite.Require().Equal(clientState.GetLatestHeight(), res.LatestHeight, "test case %d: %s", i, tc.msg)
+			suite.Require().Equal(clientState, res.ClientState, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().True(errors.Is(err, tc.expErr), "test case %d: %s: unexpected error %v", i, tc.msg, err)
+			suite.Require().Nil(bz)
+		}
+	}
+}
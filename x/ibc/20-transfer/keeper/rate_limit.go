@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// RegisterRateLimit sets an outbound quota for a denomination on the given
+// channel. The amount sent during each window of the given duration cannot
+// exceed the quota. Registering a rate limit again overrides the previous one
+// and starts a new window at the current block time.
+func (k Keeper) RegisterRateLimit(
+	ctx sdk.Context, portID, channelID, denom string, quota sdk.Int, window time.Duration,
+) error {
+	rateLimit := types.NewRateLimit(portID, channelID, denom, quota, window, ctx.BlockTime())
+	if err := rateLimit.Validate(); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// GetRateLimit retrieves the rate limit for a denomination on the given channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, portID, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(portID, channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshalBinaryBare(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit for a denomination on a channel.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(rateLimit)
+	store.Set(types.KeyRateLimit(rateLimit.PortID, rateLimit.ChannelID, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes the rate limit for a denomination on the given channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, portID, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(portID, channelID, denom))
+}
+
+// convertRateLimits moves the rate limits registered for a denomination to the
+// denomination it is mapped to, keeping their current window and outflow.
+func (k Keeper) convertRateLimits(ctx sdk.Context, denoms map[string]string) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the rate limits are collected before they are changed, as the store can't
+	// be written while it's iterated
+	var rateLimits []types.RateLimit
+	iterator := sdk.KVStorePrefixIterator(store, types.RateLimitKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rateLimit)
+		if _, ok := denoms[rateLimit.Denom]; ok {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+	iterator.Close()
+
+	for _, rateLimit := range rateLimits {
+		k.DeleteRateLimit(ctx, rateLimit.PortID, rateLimit.ChannelID, rateLimit.Denom)
+		rateLimit.Denom = denoms[rateLimit.Denom]
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// GetRemainingQuota returns the amount of a denomination that can still be sent
+// on the given channel during the window active at the current block time.
+func (k Keeper) GetRemainingQuota(ctx sdk.Context, portID, channelID, denom string) (sdk.Int, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, denom)
+	if !found {
+		return sdk.Int{}, false
+	}
+	return rateLimit.Rollover(ctx.BlockTime()).Remaining(), true
+}
+
+// consumeRateLimit adds the sent coins to the outflow of their rate limits,
+// rolling the window over if it has elapsed. It fails if any of the coins would
+// exceed the remaining quota. Coins without a registered rate limit are not
+// limited.
+func (k Keeper) consumeRateLimit(ctx sdk.Context, portID, channelID string, coins sdk.Coins) error {
+	for _, coin := range coins {
+		rateLimit, found := k.GetRateLimit(ctx, portID, channelID, coin.Denom)
+		if !found {
+			continue
+		}
+
+		rateLimit = rateLimit.Rollover(ctx.BlockTime())
+		if coin.Amount.GT(rateLimit.Remaining()) {
+			return sdkerrors.Wrapf(
+				types.ErrRateLimitExceeded,
+				"%s exceeds the remaining quota of %s%s on channel %s/%s (window started at %s)",
+				coin, rateLimit.Remaining(), coin.Denom, portID, channelID, rateLimit.WindowStart,
+			)
+		}
+
+		rateLimit.Outflow = rateLimit.Outflow.Add(coin.Amount)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
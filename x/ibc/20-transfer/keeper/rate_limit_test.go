@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterRateLimit() {
+	ctx := suite.chainA.GetContext()
+
+	testCases := []struct {
+		msg     string
+		denom   string
+		quota   sdk.Int
+		window  time.Duration
+		expPass bool
+	}{
+		{"valid rate limit", "atom", sdk.NewInt(100), time.Hour, true},
+		{"invalid denom", "", sdk.NewInt(100), time.Hour, false},
+		{"zero quota", "atom", sdk.ZeroInt(), time.Hour, false},
+		{"nil quota", "atom", sdk.Int{}, time.Hour, false},
+		{"zero window", "atom", sdk.NewInt(100), 0, false},
+	}
+
+	for i, tc := range testCases {
+		err := suite.chainA.App.TransferKeeper.RegisterRateLimit(ctx, testPort1, testChannel1, tc.denom, tc.quota, tc.window)
+		rateLimit, found := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, testPort1, testChannel1, tc.denom)
+
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewRateLimit(testPort1, testChannel1, tc.denom, tc.quota, tc.window, ctx.BlockTime()), rateLimit)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+
+	suite.chainA.App.TransferKeeper.DeleteRateLimit(ctx, testPort1, testChannel1, "atom")
+	_, found := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, testPort1, testChannel1, "atom")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSendTransferRateLimit() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000))))
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	ctx := suite.chainA.GetContext().WithBlockTime(time.Unix(1000, 0))
+	err = suite.chainA.App.TransferKeeper.RegisterRateLimit(ctx, testPort1, testChannel1, "atom", sdk.NewInt(150), time.Hour)
+	suite.Require().NoError(err)
+
+	// tokens are escrowed under the "atom" denomination
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	send := func(ctx sdk.Context) error {
+		return suite.chainA.App.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, amount, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
+	}
+
+	suite.Require().NoError(send(ctx))
+	remaining, found := suite.chainA.App.TransferKeeper.GetRemainingQuota(ctx, testPort1, testChannel1, "atom")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(50), remaining)
+
+	// the second transfer exceeds the quota of the current window
+	err = send(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)))
+	suite.Require().True(types.ErrRateLimitExceeded.Is(err))
+
+	// the quota is available again once the window elapses
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	remaining, found = suite.chainA.App.TransferKeeper.GetRemainingQuota(ctx, testPort1, testChannel1, "atom")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(150), remaining)
+	suite.Require().NoError(send(ctx))
+
+	rateLimit, found := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, testPort1, testChannel1, "atom")
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime(), rateLimit.WindowStart)
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	ctx := suite.chainA.GetContext().WithBlockTime(time.Unix(1000, 0))
+	querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+
+	err := suite.chainA.App.TransferKeeper.RegisterRateLimit(ctx, testPort1, testChannel1, "atom", sdk.NewInt(150), time.Hour)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg     string
+		denom   string
+		expPass bool
+	}{
+		{"rate limit found", "atom", true},
+		{"rate limit not found", "btc", false},
+	}
+
+	for i, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryRateLimit}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryRateLimitParams(testPort1, testChannel1, tc.denom)),
+		}
+
+		bz, err := querier(ctx, []string{types.QueryRateLimit}, query)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			var res types.RateLimitResponse
+			suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &res))
+			suite.Require().Equal(sdk.NewInt(150), res.Remaining)
+			suite.Require().Equal(tc.denom, res.RateLimit.Denom)
+		} else {
+			suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			suite.Require().Nil(bz)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// RefundStuckPacket refunds the tokens of a packet sent through a transfer
+// channel that should have timed out but can't be timed out through the channel,
+// as it would be on a timeout. It must only be called from a passed governance
+// proposal (see HandleRefundStuckPacketProposal).
+//
+// The packet must still be in flight and its timeout height or timestamp must
+// have been reached on the destination chain, according to the latest height
+// and consensus state of the channel's client. The timeout isn't checked if the
+// client is frozen or expired, since the packet can't be timed out through the
+// channel anymore. The packet commitment is deleted before the refund, so that
+// the packet can't be acknowledged, timed out or refunded again afterwards.
+//
+// CONTRACT: the connection and client keepers must be set (see WithClientKeepers).
+func (k Keeper) RefundStuckPacket(ctx sdk.Context, packet channel.Packet) error {
+	commitment := k.channelKeeper.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if len(commitment) == 0 {
+		return sdkerrors.Wrapf(types.ErrPacketNotInFlight, "%s/%s/%d", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	if !bytes.Equal(commitment, channeltypes.CommitPacket(packet)) {
+		return sdkerrors.Wrap(channel.ErrInvalidPacket, "packet doesn't match the stored packet commitment")
+	}
+
+	clientState, err := k.getChannelClientState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if !clientState.IsFrozen() && !isClientExpired(ctx, clientState) {
+		if err := k.checkTimeoutReached(ctx, clientState, packet); err != nil {
+			return err
+		}
+	}
+
+	data, err := k.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	k.channelKeeper.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeletePacketSendHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if err := k.DistributePacketFeesOnTimeout(ctx, packet, nil); err != nil {
+		return err
+	}
+
+	if err := k.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundStuck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySourcePort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.GetRefundAddress()),
+			sdk.NewAttribute(types.AttributeKeyRefundValue, data.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// checkTimeoutReached checks that the timeout height or the timeout timestamp of
+// the packet was reached on the destination chain according to the latest height
+// of the client and its consensus state at that height. Packets without a
+// timeout height or timestamp never time out on the client's view of the
+// destination chain.
+func (k Keeper) checkTimeoutReached(ctx sdk.Context, clientState clientexported.ClientState, packet channel.Packet) error {
+	latestHeight := ibctypes.NewHeight(ibctypes.ParseChainID(clientState.GetChainID()), clientState.GetLatestHeight())
+	if ibctypes.IsTimeoutHeightReached(latestHeight, packet.GetTimeoutHeight()) {
+		return nil
+	}
+
+	if packet.GetTimeoutTimestamp() != 0 {
+		consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientState.GetID(), clientState.GetLatestHeight())
+		if !found {
+			return sdkerrors.Wrapf(
+				clienttypes.ErrConsensusStateNotFound,
+				"client ID (%s) height (%d)", clientState.GetID(), clientState.GetLatestHeight(),
+			)
+		}
+
+		if consensusState.GetTimestamp() >= packet.GetTimeoutTimestamp() {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(
+		types.ErrPacketNotTimedOut,
+		"timeout height %s, timeout timestamp %d, latest height of the destination chain %s",
+		packet.GetTimeoutHeight(), packet.GetTimeoutTimestamp(), latestHeight,
+	)
+}
+
+// isClientExpired returns true if the trusting period of a tendermint client
+// elapsed since its latest header, i.e it can't be updated anymore.
+func isClientExpired(ctx sdk.Context, clientState clientexported.ClientState) bool {
+	tmClientState, ok := clientState.(ibctmtypes.ClientState)
+	if !ok {
+		return false
+	}
+
+	return !tmClientState.GetLatestTimestamp().Add(tmClientState.TrustingPeriod).After(ctx.BlockTime())
+}
+
+// HandleRefundStuckPacketProposal is a handler for executing a passed stuck
+// packet refund proposal
+func HandleRefundStuckPacketProposal(ctx sdk.Context, k Keeper, p *types.RefundStuckPacketProposal) error {
+	if err := k.RefundStuckPacket(ctx, p.Packet); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf(
+		"refunded stuck packet %s/%s/%d", p.Packet.GetSourcePort(), p.Packet.GetSourceChannel(), p.Packet.GetSequence(),
+	))
+	return nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func (suite *KeeperTestSuite) TestRefundStuckPacket() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	amount := sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
+	data := types.NewFungibleTokenPacketData(amount, sender.String(), testAddr2.String(), "")
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+
+	var (
+		ctx            sdk.Context
+		transferKeeper keeper.Keeper
+		packet         channeltypes.Packet
+	)
+
+	// setTimeout replaces the timeout of the packet along with its commitment
+	setTimeout := func(timeoutHeight ibctypes.Height, timeoutTimestamp uint64) {
+		packet.TimeoutHeight = timeoutHeight
+		packet.TimeoutTimestamp = timeoutTimestamp
+		suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 1, channeltypes.CommitPacket(packet))
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"client keepers not set", func() {
+			transferKeeper = suite.chainA.newTransferKeeper()
+		}, false},
+		{"packet not in flight", func() {
+			packet.Sequence = 2
+		}, false},
+		{"packet doesn't match the commitment", func() {
+			packet.Data = types.NewFungibleTokenPacketData(amount, testAddr1.String(), testAddr2.String(), "").GetBytes()
+		}, false},
+		{"timeout timestamp reached", func() {
+			setTimeout(ibctypes.Height{}, uint64(suite.chainB.Header.Time.UnixNano()))
+		}, true},
+		{"timeout height not reached", func() {
+			setTimeout(ibctypes.NewHeight(0, uint64(suite.chainB.Header.Height)+1), 0)
+		}, false},
+		{"timeout timestamp not reached", func() {
+			setTimeout(ibctypes.Height{}, uint64(suite.chainB.Header.Time.UnixNano())+1)
+		}, false},
+		{"frozen client", func() {
+			setTimeout(ibctypes.Height{}, 0)
+			clientState, _ := suite.chainA.App.IBCKeeper.ClientKeeper.GetClientState(ctx, testClientIDB)
+			tmClientState := clientState.(ibctmtypes.ClientState)
+			tmClientState.FrozenHeight = 1
+			suite.chainA.App.IBCKeeper.ClientKeeper.SetClientState(ctx, tmClientState)
+		}, true},
+		{"expired client", func() {
+			setTimeout(ibctypes.Height{}, 0)
+			ctx = ctx.WithBlockTime(suite.chainB.Header.Time.Add(trustingPeriod))
+		}, true},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.chainA.CreateClient(suite.chainB)
+			suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+			suite.setupTransferChannel(testPort1, testChannel1, testPort2, testChannel2)
+
+			ctx = suite.chainA.GetContext()
+			packet = channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 1, 0)
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, testPort1, testChannel1, 1, channeltypes.CommitPacket(packet))
+			_ = suite.chainA.App.BankKeeper.SetBalances(ctx, escrow, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+			transferKeeper = suite.chainA.App.TransferKeeper.WithClientKeepers(
+				suite.chainA.App.IBCKeeper.ConnectionKeeper, suite.chainA.App.IBCKeeper.ClientKeeper,
+			)
+			tc.malleate()
+
+			err := transferKeeper.RefundStuckPacket(ctx, packet)
+			if !tc.expPass {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+				suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "atom").IsZero())
+				return
+			}
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+			suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "atom").Amount)
+			suite.Require().Empty(suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1))
+
+			events := ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeRefundStuck, events[len(events)-1].Type)
+
+			// the packet can't be refunded twice
+			suite.Require().Error(transferKeeper.RefundStuckPacket(ctx, packet))
+			suite.Require().Equal(sdk.NewInt(100), suite.chainA.App.BankKeeper.GetBalance(ctx, sender, "atom").Amount)
+		})
+	}
+}
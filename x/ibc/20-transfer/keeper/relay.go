@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
@@ -24,26 +30,199 @@ func (k Keeper) SendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	destHeight uint64,
 	amount sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
+	timeoutHeight ibctypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
+	return k.SendTransferWithRefundAddress(
+		ctx, sourcePort, sourceChannel, amount, sender, receiver, timeoutHeight, timeoutTimestamp, memo, nil,
+	)
+}
+
+// SendTransferWithRefundAddress handles the transfer sending logic like
+// SendTransfer, but the tokens are refunded to the given refund address instead
+// of the sender on a timeout or an error acknowledgement. An empty refund
+// address defaults to the sender.
+func (k Keeper) SendTransferWithRefundAddress(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	amount sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight ibctypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	refundAddress sdk.AccAddress,
+) error {
+	return k.SendTransferWithMinReceived(
+		ctx, sourcePort, sourceChannel, amount, sender, receiver, timeoutHeight, timeoutTimestamp, memo, refundAddress,
+		types.TransferFee{}, sdk.Coin{},
+	)
+}
+
+// SendTransferWithFee handles the transfer sending logic like
+// SendTransferWithRefundAddress, but the given fee is first deducted from the
+// amount and paid to its recipient. Only the net amount is escrowed or burned
+// and relayed with the packet. An empty fee sends the whole amount.
+func (k Keeper) SendTransferWithFee(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	amount sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight ibctypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	refundAddress sdk.AccAddress,
+	fee types.TransferFee,
+) error {
+	return k.SendTransferWithMinReceived(
+		ctx, sourcePort, sourceChannel, amount, sender, receiver, timeoutHeight, timeoutTimestamp, memo, refundAddress,
+		fee, sdk.Coin{},
+	)
+}
+
+// SendTransferWithMinReceived handles the transfer sending logic like
+// SendTransferWithFee, but the receiver must be credited with at least the
+// given minimum amount of the sent denomination on the destination chain. The
+// packet is acknowledged with an error otherwise, so that the tokens are
+// refunded. An empty minimum received amount disables the check.
+func (k Keeper) SendTransferWithMinReceived(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	amount sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight ibctypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	refundAddress sdk.AccAddress,
+	fee types.TransferFee,
+	minReceived sdk.Coin,
 ) error {
+	if !fee.IsEmpty() {
+		if err := fee.ValidateBasic(amount); err != nil {
+			return err
+		}
+	}
+
+	// only the amount left once the fee is paid is sent
+	if minReceived != (sdk.Coin{}) {
+		if err := types.ValidateMinReceived(minReceived, fee.NetAmount(amount)); err != nil {
+			return err
+		}
+	}
+
+	if !fee.IsEmpty() {
+		if err := k.payTransferFee(ctx, sourcePort, sourceChannel, sender, fee); err != nil {
+			return err
+		}
+	}
+
+	_, data, err := k.sendTransfer(
+		ctx, sourcePort, sourceChannel, fee.NetAmount(amount), sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+		refundAddress, minReceived,
+	)
+	if err != nil {
+		return err
+	}
+
+	k.recordSend(sourcePort, sourceChannel, data.Amount)
+	return nil
+}
+
+// payTransferFee pays the fee of a transfer to its recipient. The fee must have
+// been validated against the transfer amount.
+func (k Keeper) payTransferFee(
+	ctx sdk.Context, sourcePort, sourceChannel string, sender sdk.AccAddress, fee types.TransferFee,
+) error {
+	if err := k.bankKeeper.SendCoins(ctx, sender, fee.Recipient, sdk.NewCoins(fee.Amount)); err != nil {
+		return sdkerrors.Wrap(err, "failed to pay the transfer fee")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeRecipient, fee.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, sourceChannel),
+		),
+	)
+
+	return nil
+}
+
+// sendTransfer executes the transfer sending logic and returns the sent packet
+// along with its fungible token packet data.
+func (k Keeper) sendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	amount sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight ibctypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	refundAddress sdk.AccAddress,
+	minReceived sdk.Coin,
+) (channel.Packet, types.FungibleTokenPacketData, error) {
+	// the transfer module must still own the capability of the source port, so
+	// that tokens can't be sent through a port another module took over
+	if !k.IsBound(ctx, sourcePort) {
+		return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(
+			porttypes.ErrInvalidPort, "port %s is not bound to the transfer module", sourcePort,
+		)
+	}
+
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
-		return sdkerrors.Wrap(channel.ErrChannelNotFound, sourceChannel)
+		return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if sourceChannelEnd.State != channelexported.OPEN {
+		return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(
+			channel.ErrInvalidChannelState,
+			"channel %s/%s is not OPEN (got %s)", sourcePort, sourceChannel, sourceChannelEnd.State.String(),
+		)
 	}
 
 	destinationPort := sourceChannelEnd.Counterparty.PortID
 	destinationChannel := sourceChannelEnd.Counterparty.ChannelID
 
+	// the receiver address format of the destination chain is unknown, so it can
+	// only be checked against the counterparty escrow account when it uses the
+	// same bech32 prefix
+	if receiverAddr, err := sdk.AccAddressFromBech32(receiver); err == nil &&
+		types.IsEscrowAddress(receiverAddr, destinationPort, destinationChannel) {
+		return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(
+			types.ErrEscrowReceiver, "%s is the escrow account of channel %s/%s", receiver, destinationPort, destinationChannel,
+		)
+	}
+
+	if err := k.checkSelfLoopback(ctx, sourcePort, sourceChannel, sourceChannelEnd, sender, receiver); err != nil {
+		return channel.Packet{}, types.FungibleTokenPacketData{}, err
+	}
+
 	// get the next sequence
 	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
-		return channel.ErrSequenceSendNotFound
+		return channel.Packet{}, types.FungibleTokenPacketData{}, channel.ErrSequenceSendNotFound
 	}
 
-	return k.createOutgoingPacket(ctx, sequence, sourcePort, sourceChannel, destinationPort, destinationChannel, destHeight, amount, sender, receiver)
+	return k.createOutgoingPacket(
+		ctx, sequence, sourcePort, sourceChannel, destinationPort, destinationChannel,
+		amount, sender, receiver, timeoutHeight, timeoutTimestamp, memo, refundAddress, minReceived,
+	)
 }
 
 // See spec for this function: https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#packet-relay
@@ -52,14 +231,18 @@ func (k Keeper) createOutgoingPacket(
 	seq uint64,
 	sourcePort, sourceChannel,
 	destinationPort, destinationChannel string,
-	destHeight uint64,
 	amount sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
-) error {
+	timeoutHeight ibctypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	refundAddress sdk.AccAddress,
+	minReceived sdk.Coin,
+) (channel.Packet, types.FungibleTokenPacketData, error) {
 	channelCap, ok := k.scopedKeeper.GetCapability(ctx, ibctypes.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
-		return sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "module does not own channel capability")
+		return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 	// NOTE:
 	// - Coins transferred from the destination chain should have their denomination
@@ -68,12 +251,22 @@ func (k Keeper) createOutgoingPacket(
 	// clear from prefixes when transferred to the escrow account (i.e when they are
 	// locked) BUT MUST have the destination port and channel ID when constructing
 	// the packet data.
+	// - IBC vouchers are held on chain with their hashed denomination (i.e
+	// ibc/{hash}), which is resolved to the full trace path for the packet data.
 	if len(amount) != 1 {
-		return sdkerrors.Wrapf(types.ErrOnlyOneDenomAllowed, "%d denoms included", len(amount))
+		return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(types.ErrOnlyOneDenomAllowed, "%d denoms included", len(amount))
 	}
 
 	prefix := types.GetDenomPrefix(destinationPort, destinationChannel)
-	source := strings.HasPrefix(amount[0].Denom, prefix)
+	source, err := k.isTransferSource(ctx, sourcePort, sourceChannel, prefix, amount[0].Denom)
+	if err != nil {
+		return channel.Packet{}, types.FungibleTokenPacketData{}, err
+	}
+
+	// coins with the full denomination trace path sent on the packet data
+	packetAmount := make(sdk.Coins, len(amount))
+	// coins taken from the sender, with their denomination on this chain
+	var sentCoins types.SentCoins
 
 	if source {
 		// clear the denomination from the prefix to send the coins to the escrow account
@@ -84,32 +277,73 @@ func (k Keeper) createOutgoingPacket(
 			} else {
 				coins[i] = coin
 			}
+
+			fullDenomPath, err := k.DenomPathFromHash(ctx, coins[i].Denom)
+			if err != nil {
+				return channel.Packet{}, types.FungibleTokenPacketData{}, err
+			}
+			packetAmount[i] = sdk.Coin{Denom: prefix + fullDenomPath, Amount: coin.Amount}
+		}
+
+		if err := k.checkSendDenylist(coins); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
+		}
+
+		if err := k.checkSendEnabled(ctx, coins); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
+		}
+
+		if err := k.consumeRateLimit(ctx, sourcePort, sourceChannel, coins); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
 		}
 
 		// escrow tokens if the destination chain is the same as the sender's
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		escrowAddress := k.GetEscrowAddress(ctx, sourcePort, sourceChannel)
+		if err := k.checkBalanceOverflow(ctx, escrowAddress, coins); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
+		}
 
 		// escrow source tokens. It fails if balance insufficient.
 		if err := k.bankKeeper.SendCoins(
 			ctx, sender, escrowAddress, coins,
 		); err != nil {
-			return err
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
 		}
 
+		k.recordEscrowBalance(ctx, sourcePort, sourceChannel, coins)
+		sentCoins = types.NewSentCoins(coins, true)
+
 	} else {
+		if err := k.checkSendDenylist(amount); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
+		}
+
+		if err := k.checkSendEnabled(ctx, amount); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
+		}
+
 		// build the receiving denomination prefix if it's not present
 		prefix = types.GetDenomPrefix(sourcePort, sourceChannel)
-		for _, coin := range amount {
-			if !strings.HasPrefix(coin.Denom, prefix) {
-				return sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "denom was: %s", coin.Denom)
+		for i, coin := range amount {
+			fullDenomPath, err := k.DenomPathFromHash(ctx, coin.Denom)
+			if err != nil {
+				return channel.Packet{}, types.FungibleTokenPacketData{}, err
+			}
+			if !strings.HasPrefix(fullDenomPath, prefix) {
+				return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "denom was: %s", fullDenomPath)
 			}
+			packetAmount[i] = sdk.Coin{Denom: fullDenomPath, Amount: coin.Amount}
+		}
+
+		if err := k.consumeRateLimit(ctx, sourcePort, sourceChannel, amount); err != nil {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
 		}
 
 		// transfer the coins to the module account and burn them
 		if err := k.supplyKeeper.SendCoinsFromAccountToModule(
 			ctx, sender, types.GetModuleAccountName(), amount,
 		); err != nil {
-			return err
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
 		}
 
 		// burn vouchers from the sender's balance if the source is from another chain
@@ -119,25 +353,70 @@ func (k Keeper) createOutgoingPacket(
 			// NOTE: should not happen as the module account was
 			// retrieved on the step above and it has enough balace
 			// to burn.
-			return err
+			return channel.Packet{}, types.FungibleTokenPacketData{}, err
 		}
+		sentCoins = types.NewSentCoins(amount, false)
 	}
 
 	packetData := types.NewFungibleTokenPacketData(
-		amount, sender.String(), receiver,
+		packetAmount, sender.String(), receiver, memo,
 	)
+	if !refundAddress.Empty() {
+		packetData.RefundAddress = refundAddress.String()
+	}
+	// the minimum is relayed with the denomination of the packet amount
+	if minReceived != (sdk.Coin{}) {
+		packetMinReceived, found := types.PacketMinReceived(minReceived, amount, packetAmount)
+		if !found {
+			return channel.Packet{}, types.FungibleTokenPacketData{}, sdkerrors.Wrapf(
+				types.ErrInvalidMinReceived, "denomination of the minimum received amount %s is not sent in %s", minReceived, amount,
+			)
+		}
+		packetData.MinReceived = &packetMinReceived
+	}
 
-	packet := channel.NewPacket(
-		packetData.GetBytes(),
+	packet := channel.NewPacketWithTimeoutHeight(
+		packetData.GetEncodedBytes(k.GetPacketEncoding(ctx, sourcePort, sourceChannel)),
 		seq,
 		sourcePort,
 		sourceChannel,
 		destinationPort,
 		destinationChannel,
-		destHeight+DefaultPacketTimeout,
+		timeoutHeight,
+		timeoutTimestamp,
 	)
 
-	return k.channelKeeper.SendPacket(ctx, channelCap, packet)
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return channel.Packet{}, types.FungibleTokenPacketData{}, err
+	}
+
+	k.recordPacketSent(ctx, packet)
+	k.SetSentCoins(ctx, sourcePort, sourceChannel, seq, sentCoins)
+
+	// the packet sequence, timeouts and data hash are included so that relayers
+	// don't have to query the packet to know when it can be timed out
+	dataHash := tmbytes.HexBytes(tmhash.Sum(packet.GetData())).String()
+	for _, coin := range packetAmount {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransfer,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+				sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeySourcePort, sourcePort),
+				sdk.NewAttribute(types.AttributeKeySourceChannel, sourceChannel),
+				sdk.NewAttribute(types.AttributeKeyIsSource, fmt.Sprintf("%t", source)),
+				sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", seq)),
+				sdk.NewAttribute(types.AttributeKeyTimeoutHeight, packet.GetTimeoutHeight().String()),
+				sdk.NewAttribute(types.AttributeKeyTimeoutTime, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+				sdk.NewAttribute(types.AttributeKeyDataHash, dataHash),
+			),
+		)
+	}
+
+	return packet, packetData, nil
 }
 
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData) error {
@@ -156,50 +435,208 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channel.Packet, data types.
 		return err
 	}
 
+	// tokens sent to the escrow account of the destination channel could never
+	// be withdrawn. The channel escrow address version isn't checked, so that a
+	// receiver is rejected by the channels migrated to another version as well.
+	if types.IsEscrowAddress(receiver, packet.GetDestPort(), packet.GetDestChannel()) {
+		return sdkerrors.Wrapf(
+			types.ErrEscrowReceiver, "%s is the escrow account of channel %s/%s", data.Receiver, packet.GetDestPort(), packet.GetDestChannel(),
+		)
+	}
+
 	if source {
+		// store the denomination traces of the vouchers and mint them with
+		// their hashed denomination
+		coins := make(sdk.Coins, len(data.Amount))
+		for i, coin := range data.Amount {
+			denomTrace, err := k.voucherTrace(ctx, coin.Denom)
+			if err != nil {
+				return err
+			}
+			if err := denomTrace.Validate(); err != nil {
+				return err
+			}
+			if err := k.checkDenomTraceDepth(ctx, denomTrace); err != nil {
+				return err
+			}
+
+			if !k.HasDenomTrace(ctx, k.DenomTraceHash(denomTrace)) {
+				k.SetDenomTrace(ctx, denomTrace)
+				k.emitDenomTraceEvent(ctx, denomTrace)
+			}
+			coins[i] = sdk.NewCoin(k.traceDenom(denomTrace), coin.Amount)
+		}
+
+		if err := checkMinReceived(data, coins); err != nil {
+			return err
+		}
+
+		if err := k.checkReceiveEnabled(ctx, coins); err != nil {
+			return err
+		}
+
+		if err := k.checkMintOverflow(ctx, receiver, coins); err != nil {
+			return err
+		}
 
 		// mint new tokens if the source of the transfer is the same chain
 		if err := k.supplyKeeper.MintCoins(
-			ctx, types.GetModuleAccountName(), data.Amount,
+			ctx, types.GetModuleAccountName(), coins,
 		); err != nil {
 			return err
 		}
 
 		// send to receiver
-		return k.supplyKeeper.SendCoinsFromModuleToAccount(
-			ctx, types.GetModuleAccountName(), receiver, data.Amount,
-		)
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.GetModuleAccountName(), receiver, coins,
+		); err != nil {
+			return err
+		}
+
+		if err := k.afterReceive(ctx, packet, coins, receiver); err != nil {
+			return err
+		}
+
+		emitRecvTransferEvents(ctx, packet, data, coins, source)
+		k.recordRecv(packet.GetDestPort(), packet.GetDestChannel(), data.Amount)
+		return nil
 	}
 
 	// check the denom prefix
-	prefix = types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	sourcePrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
 	coins := make(sdk.Coins, len(data.Amount))
 	for i, coin := range data.Amount {
-		if !strings.HasPrefix(coin.Denom, prefix) {
+		if !strings.HasPrefix(coin.Denom, sourcePrefix) {
 			return sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidCoins,
-				"%s doesn't contain the prefix '%s'", coin.Denom, prefix,
+				types.ErrUnknownDenom,
+				"denomination %s contains neither the prefix '%s' nor '%s'", coin.Denom, prefix, sourcePrefix,
 			)
 		}
-		coins[i] = sdk.NewCoin(coin.Denom[len(prefix):], coin.Amount)
+
+		denomTrace := types.ParseDenomTrace(coin.Denom[len(sourcePrefix):])
+		if err := denomTrace.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrUnknownDenom, "denomination %s: %s", coin.Denom, err.Error())
+		}
+		// vouchers returning to this chain are escrowed with their hashed denomination
+		denom, err := k.escrowedDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), denomTrace.GetFullDenomPath(), coin.Amount)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrUnknownDenom, "denomination %s: %s", coin.Denom, err.Error())
+		}
+		// the trace paths are validated as such, so a native denomination can
+		// still be an invalid coin denomination, on which sdk.NewCoin panics
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(types.ErrUnknownDenom, "denomination %s: %s", coin.Denom, err.Error())
+		}
+		coins[i] = sdk.NewCoin(denom, coin.Amount)
+	}
+
+	if err := checkMinReceived(data, coins); err != nil {
+		return err
+	}
+
+	if err := k.checkReceiveEnabled(ctx, coins); err != nil {
+		return err
 	}
 
 	// unescrow tokens
-	escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-	return k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, coins)
+	if err := k.unescrowCoins(ctx, packet.GetDestPort(), packet.GetDestChannel(), receiver, coins); err != nil {
+		return err
+	}
+
+	if err := k.afterReceive(ctx, packet, coins, receiver); err != nil {
+		return err
+	}
+
+	emitRecvTransferEvents(ctx, packet, data, coins, source)
+	k.recordRecv(packet.GetDestPort(), packet.GetDestChannel(), data.Amount)
+	k.recordEscrowBalance(ctx, packet.GetDestPort(), packet.GetDestChannel(), coins)
+	return nil
+}
+
+// checkMinReceived returns an error if the receiver would be credited with less
+// than the minimum received amount of the packet data, if any. The coins are the
+// packet amount with the denominations they are credited with on this chain.
+func checkMinReceived(data types.FungibleTokenPacketData, coins sdk.Coins) error {
+	if !data.HasMinReceived() {
+		return nil
+	}
+
+	for i, coin := range data.Amount {
+		if coin.Denom == data.MinReceived.Denom && coins[i].Amount.LT(data.MinReceived.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrMinReceivedNotMet, "received %s, expected at least %s", coins[i], data.MinReceived,
+			)
+		}
+	}
+	return nil
+}
+
+// emitRecvTransferEvents emits an event for each of the received coins along
+// with the denomination they were credited under on this chain (i.e the hashed
+// voucher denomination or the native denomination).
+func emitRecvTransferEvents(
+	ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData, coins sdk.Coins, source bool,
+) {
+	for i, coin := range data.Amount {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRecvTransfer,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+				sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyVoucherDenom, coins[i].Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDestPort, packet.GetDestPort()),
+				sdk.NewAttribute(types.AttributeKeyDestChannel, packet.GetDestChannel()),
+				sdk.NewAttribute(types.AttributeKeyIsSource, fmt.Sprintf("%t", source)),
+			),
+		)
+	}
+}
+
+// emitDenomTraceEvent emits an event with the hash and the full path of a
+// denomination trace the first time it is stored.
+func (k Keeper) emitDenomTraceEvent(ctx sdk.Context, denomTrace types.DenomTrace) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomTrace,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTraceHash, k.DenomTraceHash(denomTrace).String()),
+			sdk.NewAttribute(types.AttributeKeyVoucherDenom, k.traceDenom(denomTrace)),
+			sdk.NewAttribute(types.AttributeKeyDenom, denomTrace.GetFullDenomPath()),
+		),
+	)
 }
 
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData, ack types.FungibleTokenPacketAcknowledgement) error {
 	if !ack.Success {
-		return k.refundPacketAmount(ctx, packet, data)
+		if err := k.refundPacketAmount(ctx, packet, data); err != nil {
+			return err
+		}
+
+		// the tokens of a failed forward are refunded along the reverse path
+		return k.refundForward(ctx, packet, data)
 	}
+
+	k.DeleteSentCoins(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeleteForwardRecord(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 	return nil
 }
 
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketAmount(ctx, packet, data)
+	if err := k.refundPacketAmount(ctx, packet, data); err != nil {
+		return err
+	}
+
+	// the tokens of a timed out forward are refunded along the reverse path
+	return k.refundForward(ctx, packet, data)
 }
 
+// refundPacketAmount refunds the tokens of a failed or timed out packet to its
+// refund address. The coins taken from the sender are refunded as they were
+// recorded on send. The denomination of the packets sent before the coins were
+// recorded is resolved from the packet data instead.
 func (k Keeper) refundPacketAmount(ctx sdk.Context, packet channel.Packet, data types.FungibleTokenPacketData) error {
 	// NOTE: packet data type already checked in handler.go
 
@@ -207,37 +644,144 @@ func (k Keeper) refundPacketAmount(ctx sdk.Context, packet channel.Packet, data
 		return sdkerrors.Wrapf(types.ErrOnlyOneDenomAllowed, "%d denoms included", len(data.Amount))
 	}
 
-	// check the denom prefix
-	prefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-	source := strings.HasPrefix(data.Amount[0].Denom, prefix)
-
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	// decode the refund address, which defaults to the sender
+	sender, err := sdk.AccAddressFromBech32(data.GetRefundAddress())
 	if err != nil {
 		return err
 	}
 
+	sentCoins, found := k.GetSentCoins(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if found {
+		k.DeleteSentCoins(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		return k.refundSentCoins(ctx, packet, sender, sentCoins)
+	}
+
+	// check the denom prefix
+	prefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	source := strings.HasPrefix(data.Amount[0].Denom, prefix)
+
 	if source {
 		coins := make(sdk.Coins, len(data.Amount))
 		for i, coin := range data.Amount {
 			coin := coin
 			if !strings.HasPrefix(coin.Denom, prefix) {
-				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s doesn't contain the prefix '%s'", coin.Denom, prefix)
+				return sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "%s doesn't contain the prefix '%s'", coin.Denom, prefix)
+			}
+			denom, err := k.escrowedDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), coin.Denom[len(prefix):], coin.Amount)
+			if err != nil {
+				return err
 			}
-			coins[i] = sdk.NewCoin(coin.Denom[len(prefix):], coin.Amount)
+			coins[i] = sdk.NewCoin(denom, coin.Amount)
 		}
 
 		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		return k.bankKeeper.SendCoins(ctx, escrowAddress, sender, coins)
+		if err := k.unescrowCoins(ctx, packet.GetDestPort(), packet.GetDestChannel(), sender, coins); err != nil {
+			return err
+		}
+
+		k.recordEscrowBalance(ctx, packet.GetDestPort(), packet.GetDestChannel(), coins)
+		return nil
+	}
+
+	// mint vouchers back to sender with their hashed denomination
+	coins := make(sdk.Coins, len(data.Amount))
+	for i, coin := range data.Amount {
+		denom, err := k.voucherDenom(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		coins[i] = sdk.NewCoin(denom, coin.Amount)
+	}
+
+	if err := k.checkMintOverflow(ctx, sender, coins); err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.MintCoins(
+		ctx, types.GetModuleAccountName(), coins,
+	); err != nil {
+		return err
+	}
+
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.GetModuleAccountName(), sender, coins)
+}
+
+// refundSentCoins refunds the coins taken from the sender of a packet to the
+// given address: the escrowed coins are unescrowed from the source channel and
+// the burned vouchers are minted again.
+func (k Keeper) refundSentCoins(ctx sdk.Context, packet channel.Packet, receiver sdk.AccAddress, sentCoins types.SentCoins) error {
+	if sentCoins.Escrowed {
+		if err := k.unescrowCoins(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), receiver, sentCoins.Coins); err != nil {
+			return err
+		}
+
+		k.recordEscrowBalance(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), sentCoins.Coins)
+		return nil
+	}
+
+	if err := k.checkMintOverflow(ctx, receiver, sentCoins.Coins); err != nil {
+		return err
 	}
 
-	// mint vouchers back to sender
 	if err := k.supplyKeeper.MintCoins(
-		ctx, types.GetModuleAccountName(), data.Amount,
+		ctx, types.GetModuleAccountName(), sentCoins.Coins,
 	); err != nil {
 		return err
 	}
 
-	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.GetModuleAccountName(), sender, data.Amount)
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.GetModuleAccountName(), receiver, sentCoins.Coins)
+}
+
+// isTransferSource returns true if this chain is the source of the denomination
+// sent through the source channel, i.e the tokens are escrowed on send instead
+// of being burned. The source is inferred from the denomination trace: native
+// tokens and the vouchers that weren't received through the channel are sent
+// from their source, while the vouchers received through it are sent back to
+// their source chain.
+//
+// The denomination can still be prefixed with the destination port and channel,
+// which marks this chain as the source. The transfer is rejected if the prefix
+// contradicts the denomination trace.
+func (k Keeper) isTransferSource(ctx sdk.Context, sourcePort, sourceChannel, destPrefix, denom string) (bool, error) {
+	prefixed := strings.HasPrefix(denom, destPrefix)
+
+	fullDenomPath, err := k.DenomPathFromHash(ctx, strings.TrimPrefix(denom, destPrefix))
+	if err != nil {
+		return false, err
+	}
+
+	source := !strings.HasPrefix(fullDenomPath, types.GetDenomPrefix(sourcePort, sourceChannel))
+	if prefixed && !source {
+		return false, sdkerrors.Wrapf(
+			types.ErrSourceMismatch,
+			"%s is prefixed with the destination channel but was received through channel %s/%s", denom, sourcePort, sourceChannel,
+		)
+	}
+	return source, nil
+}
+
+// unescrowCoins transfers the coins from the escrow account of the given
+// channel to the recipient. The escrow account must hold at least the amount
+// to unescrow, as the tokens received back can never exceed the tokens sent.
+// Otherwise the packet proof is invalid, so a distinct error is returned.
+func (k Keeper) unescrowCoins(
+	ctx sdk.Context, portID, channelID string, recipient sdk.AccAddress, coins sdk.Coins,
+) error {
+	escrowAddress := k.GetEscrowAddress(ctx, portID, channelID)
+
+	for _, coin := range coins {
+		escrowed := k.bankKeeper.GetBalance(ctx, escrowAddress, coin.Denom)
+		if escrowed.Amount.LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientEscrow,
+				"channel %s/%s escrows %s, cannot unescrow %s", portID, channelID, escrowed, coin,
+			)
+		}
+	}
+
+	if err := k.checkBalanceOverflow(ctx, recipient, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, escrowAddress, recipient, coins)
 }
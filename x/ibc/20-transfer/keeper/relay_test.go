@@ -1,12 +1,21 @@
 package keeper_test
 
 import (
+	"crypto/sha1"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/kv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
 	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 	"github.com/cosmos/cosmos-sdk/x/supply"
@@ -41,8 +50,35 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
 			}, false, true},
+		{"successful transfer of hashed vouchers from external chain", sdk.NewCoins(sdk.NewCoin(prefixTrace.IBCDenom(), sdk.NewInt(100))),
+			func() {
+				voucher := sdk.NewCoins(sdk.NewCoin(prefixTrace.IBCDenom(), sdk.NewInt(100)))
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), prefixTrace)
+				suite.chainA.App.SupplyKeeper.SetSupply(suite.chainA.GetContext(), supply.NewSupply(voucher))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, voucher)
+				suite.Require().NoError(err)
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, false, true},
+		{"denom trace not found", sdk.NewCoins(sdk.NewCoin(prefixTrace.IBCDenom(), sdk.NewInt(100))),
+			func() {
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, false, false},
 		{"source channel not found", testCoins,
 			func() {}, true, false},
+		{"source channel not OPEN", testCoins,
+			func() {
+				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.INIT, channelexported.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, true, false},
 		{"next seq send not found", testCoins,
 			func() {
 				suite.chainA.CreateClient(suite.chainB)
@@ -77,6 +113,17 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				cap, _ := suite.chainA.App.ScopedTransferKeeper.GetCapability(suite.chainA.GetContext(), capName)
 				suite.chainA.App.ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
 			}, true, false},
+		{"port capability not found", testCoins2,
+			func() {
+				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+				// Release port capability
+				cap, _ := suite.chainA.App.ScopedTransferKeeper.GetCapability(suite.chainA.GetContext(), porttypes.PortPath(testPort1))
+				suite.chainA.App.ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
+			}, true, false},
 	}
 
 	for i, tc := range testCases {
@@ -94,7 +141,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			tc.malleate()
 
 			err = suite.chainA.App.TransferKeeper.SendTransfer(
-				suite.chainA.GetContext(), testPort1, testChannel1, 100, tc.amount, testAddr1, testAddr2.String(),
+				suite.chainA.GetContext(), testPort1, testChannel1, tc.amount, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "",
 			)
 
 			if tc.expPass {
@@ -106,42 +153,289 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	}
 }
 
+// TestSendTransferReceiverVerbatim tests that the receiver is relayed verbatim
+// on the packet data, without being decoded by the sending chain
+func (suite *KeeperTestSuite) TestSendTransferReceiverVerbatim() {
+	receiver := "0x71C7656EC7ab88b098defB751B7401B5f6d8976F"
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	err = suite.chainA.App.TransferKeeper.SendTransfer(
+		suite.chainA.GetContext(), testPort1, testChannel1, amount, testAddr1, receiver, testTimeoutHeight, 0, "",
+	)
+	suite.Require().NoError(err)
+
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), receiver, "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+
+	commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+}
+
+// TestSendTransferSourceInference tests that the tokens are escrowed or burned
+// according to their denomination trace, whether or not their denomination is
+// prefixed with the destination channel.
+func (suite *KeeperTestSuite) TestSendTransferSourceInference() {
+	destPrefix := types.GetDenomPrefix(testPort2, testChannel2)
+	channelTrace := types.ParseDenomTrace(types.GetDenomPrefix(testPort1, testChannel1) + "atom")
+	otherTrace := types.ParseDenomTrace(types.GetDenomPrefix(testPort1, "otherchannel") + "atom")
+
+	testCases := []struct {
+		msg          string
+		heldDenom    string // denomination held by the sender
+		denom        string // denomination of the transfer
+		expPacketDen string // denomination sent on the packet data
+		expEscrow    bool
+		expErr       error
+	}{
+		{"native denomination", "atom", "atom", destPrefix + "atom", true, nil},
+		{"native denomination with the destination prefix", "atom", destPrefix + "atom", destPrefix + "atom", true, nil},
+		{"voucher received through the channel", channelTrace.IBCDenom(), channelTrace.IBCDenom(), channelTrace.GetFullDenomPath(), false, nil},
+		{"voucher received through another channel", otherTrace.IBCDenom(), otherTrace.IBCDenom(), destPrefix + otherTrace.GetFullDenomPath(), true, nil},
+		{"voucher received through the channel with the destination prefix", channelTrace.IBCDenom(), destPrefix + channelTrace.IBCDenom(), "", false, types.ErrSourceMismatch},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		suite.chainA.CreateClient(suite.chainB)
+		suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+		suite.setupTransferChannel(testPort1, testChannel1, testPort2, testChannel2)
+		ctx := suite.chainA.GetContext()
+
+		held := sdk.NewCoins(sdk.NewInt64Coin(tc.heldDenom, 100))
+		suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, channelTrace)
+		suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, otherTrace)
+		suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply.NewSupply(held))
+		_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, held)
+
+		// the denominations with the destination prefix can be longer than a coin denomination
+		amount := sdk.Coins{sdk.Coin{Denom: tc.denom, Amount: sdk.NewInt(100)}}
+		err := suite.chainA.App.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, amount, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
+		if tc.expErr != nil {
+			suite.Require().True(errors.Is(err, tc.expErr), "invalid test case %d passed: %s: %v", i, tc.msg, err)
+			suite.Require().Equal(held, suite.chainA.App.BankKeeper.GetAllBalances(ctx, testAddr1), "test case %d: %s", i, tc.msg)
+			continue
+		}
+		suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+		data := types.NewFungibleTokenPacketData(sdk.Coins{sdk.Coin{Denom: tc.expPacketDen, Amount: sdk.NewInt(100)}}, testAddr1.String(), testAddr2.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+		commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1)
+		suite.Require().Equal(channeltypes.CommitPacket(packet), commitment, "test case %d: %s", i, tc.msg)
+
+		escrowed := suite.chainA.App.BankKeeper.GetAllBalances(ctx, types.GetEscrowAddress(testPort1, testChannel1))
+		if tc.expEscrow {
+			suite.Require().Equal(held, escrowed, "test case %d: %s", i, tc.msg)
+		} else {
+			suite.Require().True(escrowed.IsZero(), "test case %d: %s", i, tc.msg)
+			suite.Require().True(suite.chainA.App.SupplyKeeper.GetSupply(ctx).GetTotal().IsZero(), "test case %d: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTransferEvents() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	ctx := suite.chainA.GetContext()
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	timeoutTimestamp := uint64(suite.chainA.Header.Time.Add(time.Hour).UnixNano())
+	err = suite.chainA.App.TransferKeeper.SendTransfer(
+		ctx, testPort1, testChannel1, amount, testAddr1, testAddr2.String(), testTimeoutHeight, timeoutTimestamp, "",
+	)
+	suite.Require().NoError(err)
+
+	events := ctx.EventManager().Events()
+	suite.Require().NotEmpty(events)
+	event := events[len(events)-1]
+	suite.Require().Equal(types.EventTypeTransfer, event.Type)
+
+	// the packet is the one committed by the channel
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), testAddr2.String(), "")
+	packet := channeltypes.NewPacketWithTimeoutHeight(
+		data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, testTimeoutHeight, timeoutTimestamp,
+	)
+	commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+
+	expAttributes := []kv.Pair{
+		{Key: []byte(sdk.AttributeKeyModule), Value: []byte(types.AttributeValueCategory)},
+		{Key: []byte(sdk.AttributeKeySender), Value: []byte(testAddr1.String())},
+		{Key: []byte(types.AttributeKeyReceiver), Value: []byte(testAddr2.String())},
+		{Key: []byte(types.AttributeKeyDenom), Value: []byte("testportid/secondchannel/atom")},
+		{Key: []byte(types.AttributeKeyAmount), Value: []byte("100")},
+		{Key: []byte(types.AttributeKeySourcePort), Value: []byte(testPort1)},
+		{Key: []byte(types.AttributeKeySourceChannel), Value: []byte(testChannel1)},
+		{Key: []byte(types.AttributeKeyIsSource), Value: []byte("true")},
+		{Key: []byte(types.AttributeKeySequence), Value: []byte("1")},
+		{Key: []byte(types.AttributeKeyTimeoutHeight), Value: []byte(testTimeoutHeight.String())},
+		{Key: []byte(types.AttributeKeyTimeoutTime), Value: []byte(fmt.Sprintf("%d", timeoutTimestamp))},
+		{Key: []byte(types.AttributeKeyDataHash), Value: []byte(tmbytes.HexBytes(tmhash.Sum(data.GetBytes())).String())},
+	}
+	suite.Require().Equal(expAttributes, event.Attributes)
+}
+
+func (suite *KeeperTestSuite) TestSendTransferWithMinReceived() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	ctx := suite.chainA.GetContext()
+	fee := types.NewTransferFee(sdk.NewInt64Coin("atom", 10), sdk.AccAddress([]byte("feerecipient")))
+	senderBalance := suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr1, "atom")
+
+	// the minimum can't exceed the amount left once the fee is paid
+	err = suite.chainA.App.TransferKeeper.SendTransferWithMinReceived(
+		ctx, testPort1, testChannel1, testCoins, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "", nil, fee, sdk.NewInt64Coin("atom", 91),
+	)
+	suite.Require().True(types.ErrInvalidMinReceived.Is(err), "unexpected error %v", err)
+
+	err = suite.chainA.App.TransferKeeper.SendTransferWithMinReceived(
+		ctx, testPort1, testChannel1, testCoins, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "", nil, fee, sdk.NewInt64Coin("atom", 90),
+	)
+	suite.Require().NoError(err)
+
+	// the minimum is relayed with the denomination of the packet amount
+	packetDenom := types.GetDenomPrefix(testPort2, testChannel2) + "atom"
+	data := types.NewFungibleTokenPacketData(sdk.Coins{sdk.Coin{Denom: packetDenom, Amount: sdk.NewInt(90)}}, testAddr1.String(), testAddr2.String(), "")
+	data.MinReceived = &sdk.Coin{Denom: packetDenom, Amount: sdk.NewInt(90)}
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+	commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+
+	// the escrowed net amount is refunded to the sender when the minimum isn't
+	// met on the destination chain, the fee is not refunded
+	errorAck := types.FungibleTokenPacketAcknowledgement{Success: false, Error: types.ErrMinReceivedNotMet.Error()}
+	suite.Require().NoError(suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, errorAck))
+	suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, types.GetEscrowAddress(testPort1, testChannel1), "atom").IsZero())
+	suite.Require().True(senderBalance.Sub(fee.Amount).IsEqual(suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr1, "atom")))
+}
+
+func (suite *KeeperTestSuite) TestSendTransferWithFee() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+	ctx := suite.chainA.GetContext()
+	feeRecipient := sdk.AccAddress([]byte("feerecipient"))
+	senderBalance := suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr1, "atom")
+
+	// the fee must be less than the amount
+	fee := types.NewTransferFee(sdk.NewInt64Coin("atom", 100), feeRecipient)
+	err = suite.chainA.App.TransferKeeper.SendTransferWithFee(ctx, testPort1, testChannel1, testCoins, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "", nil, fee)
+	suite.Require().Error(err)
+
+	fee = types.NewTransferFee(sdk.NewInt64Coin("atom", 10), feeRecipient)
+	err = suite.chainA.App.TransferKeeper.SendTransferWithFee(ctx, testPort1, testChannel1, testCoins, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "", nil, fee)
+	suite.Require().NoError(err)
+
+	// only the net amount is escrowed
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 10), suite.chainA.App.BankKeeper.GetBalance(ctx, feeRecipient, "atom"))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 90), suite.chainA.App.BankKeeper.GetBalance(ctx, types.GetEscrowAddress(testPort1, testChannel1), "atom"))
+	suite.Require().True(senderBalance.Sub(testCoins[0]).IsEqual(suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr1, "atom")))
+
+	var feeEvent, transferEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeTransferFee:
+			feeEvent = event
+		case types.EventTypeTransfer:
+			transferEvent = event
+		}
+	}
+
+	expAttributes := []kv.Pair{
+		{Key: []byte(sdk.AttributeKeyModule), Value: []byte(types.AttributeValueCategory)},
+		{Key: []byte(sdk.AttributeKeySender), Value: []byte(testAddr1.String())},
+		{Key: []byte(types.AttributeKeyFeeRecipient), Value: []byte(feeRecipient.String())},
+		{Key: []byte(types.AttributeKeyFee), Value: []byte("10atom")},
+		{Key: []byte(types.AttributeKeySourcePort), Value: []byte(testPort1)},
+		{Key: []byte(types.AttributeKeySourceChannel), Value: []byte(testChannel1)},
+	}
+	suite.Require().Equal(expAttributes, feeEvent.Attributes)
+	suite.Require().Contains(transferEvent.Attributes, kv.Pair{Key: []byte(types.AttributeKeyAmount), Value: []byte("90")})
+}
+
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
+	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String(), "")
 
 	testCases := []struct {
 		msg      string
 		malleate func()
+		source   bool
 		expPass  bool
 	}{
 		{"success receive from source chain",
-			func() {}, true},
+			func() {}, true, true},
+		{"success receive multi-hop voucher from source chain",
+			func() {
+				data.Amount = sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/bank/thirdchannel/atom", sdk.NewInt(100)))
+			}, true, true},
 		// onRecvPacket
 		// - source chain
 		{"no dest prefix on coin denom",
 			func() {
 				data.Amount = testCoins
-			}, false},
+			}, false, false},
+		{"odd number of trace path segments",
+			func() {
+				data.Amount = sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/bank/atom", sdk.NewInt(100)))
+			}, true, false},
 		{"mint failed",
 			func() {
 				data.Amount = prefixCoins2
 				data.Amount[0].Amount = sdk.ZeroInt()
-			}, false},
+			}, true, false},
 		// - receiving chain
 		{"incorrect dest prefix on coin denom",
 			func() {
 				data.Amount = prefixCoins2
-			}, false},
+			}, false, false},
 		{"success receive from external chain",
 			func() {
 				data.Amount = prefixCoins
 				escrow := types.GetEscrowAddress(testPort2, testChannel2)
 				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true},
+			}, false, true},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
 	for i, tc := range testCases {
 		tc := tc
@@ -150,10 +444,36 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			suite.SetupTest() // reset
 			tc.malleate()
 
-			err := suite.chainA.App.TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
 
 			if tc.expPass {
 				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+				events := ctx.EventManager().Events()
+				suite.Require().NotEmpty(events)
+				event := events[len(events)-1]
+				suite.Require().Equal(types.EventTypeRecvTransfer, event.Type)
+
+				// vouchers are minted with their hashed denomination while
+				// escrowed tokens are returned with their native denomination
+				voucherDenom := "atom"
+				if tc.source {
+					voucherDenom = types.ParseDenomTrace(data.Amount[0].Denom).IBCDenom()
+				}
+				suite.Require().Contains(event.Attributes, kv.Pair{
+					Key: []byte(types.AttributeKeyVoucherDenom), Value: []byte(voucherDenom),
+				})
+
+				if tc.source {
+					denomTrace := types.ParseDenomTrace(data.Amount[0].Denom)
+					trace, found := suite.chainA.App.TransferKeeper.GetDenomTrace(suite.chainA.GetContext(), denomTrace.Hash())
+					suite.Require().True(found, "denom trace not stored")
+					suite.Require().Equal(denomTrace, trace)
+
+					balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr2, denomTrace.IBCDenom())
+					suite.Require().Equal(data.Amount[0].Amount, balance.Amount)
+				}
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
 			}
@@ -161,10 +481,197 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestOnRecvPacketInsufficientEscrow tests that tokens received back cannot
+// unescrow more than what the channel escrow account holds.
+func (suite *KeeperTestSuite) TestOnRecvPacketInsufficientEscrow() {
+	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	ctx := suite.chainA.GetContext()
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+	_, err := suite.chainA.App.BankKeeper.AddCoins(ctx, escrow, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(99))))
+	suite.Require().NoError(err)
+
+	err = suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().Error(err)
+	suite.Require().True(types.ErrInsufficientEscrow.Is(err), "unexpected error: %v", err)
+
+	// the escrowed funds are left untouched
+	suite.Require().Equal(sdk.NewInt(99), suite.chainA.App.BankKeeper.GetBalance(ctx, escrow, "atom").Amount)
+	suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr2, "atom").IsZero())
+}
+
+// TestOnRecvPacketDenomTraceEvent tests that the denomination trace event is
+// only emitted when the trace of a voucher is first stored.
+func (suite *KeeperTestSuite) TestOnRecvPacketDenomTraceEvent() {
+	ctx := suite.chainA.GetContext()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(testPort2, testChannel2)+"atom", 100))
+	denomTrace := types.ParseDenomTrace(amount[0].Denom)
+
+	countTraceEvents := func() (count int) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeDenomTrace {
+				continue
+			}
+			count++
+
+			expAttributes := []kv.Pair{
+				{Key: []byte(sdk.AttributeKeyModule), Value: []byte(types.AttributeValueCategory)},
+				{Key: []byte(types.AttributeKeyTraceHash), Value: []byte(denomTrace.Hash().String())},
+				{Key: []byte(types.AttributeKeyVoucherDenom), Value: []byte(denomTrace.IBCDenom())},
+				{Key: []byte(types.AttributeKeyDenom), Value: []byte(denomTrace.GetFullDenomPath())},
+			}
+			suite.Require().Equal(expAttributes, event.Attributes)
+		}
+		return count
+	}
+
+	for seq := uint64(1); seq <= 2; seq++ {
+		data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), testAddr2.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), seq, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		err := suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+		suite.Require().NoError(err)
+		suite.Require().Equal(1, countTraceEvents(), "packet sequence %d", seq)
+	}
+	suite.Require().True(suite.chainA.App.TransferKeeper.HasDenomTrace(ctx, denomTrace.Hash()))
+}
+
+// TestOnRecvPacketMaxDenomTraceDepth tests that the vouchers whose trace would
+// have more hops than allowed are not received.
+func (suite *KeeperTestSuite) TestOnRecvPacketMaxDenomTraceDepth() {
+	ctx := suite.chainA.GetContext()
+	params := types.DefaultParams()
+	params.MaxDenomTraceDepth = 2
+	suite.chainA.App.TransferKeeper.SetParams(ctx, params)
+
+	prefix := types.GetDenomPrefix(testPort2, testChannel2)
+	testCases := []struct {
+		msg     string
+		denom   string
+		expPass bool
+	}{
+		{"native token of the counterparty", prefix + "atom", true},
+		{"maximum depth", prefix + "transfer/otherchannel/atom", true},
+		{"maximum depth exceeded", prefix + "transfer/otherchannel/transfer/thirdchannel/atom", false},
+	}
+
+	for i, tc := range testCases {
+		// the trace paths can be longer than a coin denomination
+		amount := sdk.Coins{sdk.Coin{Denom: tc.denom, Amount: sdk.NewInt(100)}}
+		data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), testAddr2.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), uint64(i+1), testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+		err := suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+		denomTrace := types.ParseDenomTrace(tc.denom)
+		balance := suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr2, denomTrace.IBCDenom())
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			suite.Require().Equal(sdk.NewInt(100), balance.Amount, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrDenomTraceTooDeep.Is(err), "invalid test case %d passed: %s: %v", i, tc.msg, err)
+			suite.Require().True(balance.IsZero(), "invalid test case %d minted vouchers: %s", i, tc.msg)
+			suite.Require().False(suite.chainA.App.TransferKeeper.HasDenomTrace(ctx, denomTrace.Hash()))
+		}
+	}
+}
+
+// TestOnRecvPacketLongDenomPath tests that the vouchers of a trace path longer
+// than a coin denomination are received with their hashed denomination.
+func (suite *KeeperTestSuite) TestOnRecvPacketLongDenomPath() {
+	ctx := suite.chainA.GetContext()
+
+	denom := types.GetDenomPrefix(testPort2, testChannel2) + "transfer/otherchannel/transfer/thirdchannel/atom"
+	suite.Require().Error(sdk.ValidateDenom(denom))
+
+	amount := sdk.Coins{sdk.Coin{Denom: denom, Amount: sdk.NewInt(100)}}
+	data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), testAddr2.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	err := suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+
+	denomTrace := types.ParseDenomTrace(denom)
+	balance := suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr2, denomTrace.IBCDenom())
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+	suite.Require().True(suite.chainA.App.TransferKeeper.HasDenomTrace(ctx, denomTrace.Hash()))
+}
+
+// TestTransferToEscrowAddress tests that the tokens cannot be sent to the
+// escrow account of a channel.
+func (suite *KeeperTestSuite) TestTransferToEscrowAddress() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.BankKeeper.AddCoins(ctx, testAddr1, testCoins)
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, testPort1, testChannel1, 1)
+
+	// the receiver is the escrow account of the counterparty channel
+	counterpartyEscrow := types.GetEscrowAddress(testPort2, testChannel2)
+	err = suite.chainA.App.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, prefixCoins2, testAddr1, counterpartyEscrow.String(), testTimeoutHeight, 0, "")
+	suite.Require().True(types.ErrEscrowReceiver.Is(err), "unexpected error: %v", err)
+
+	// tokens are not received by the escrow account of the destination channel,
+	// whatever its escrow address version
+	for _, version := range []types.EscrowAddressVersion{types.EscrowAddressVersionLegacy, types.EscrowAddressVersionADR028} {
+		escrow := types.GetVersionedEscrowAddress(version, testPort1, testChannel1)
+		data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr2.String(), escrow.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort2, testChannel2, testPort1, testChannel1, 100, 0)
+
+		err = suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+		suite.Require().True(types.ErrEscrowReceiver.Is(err), "version %s: unexpected error: %v", version, err)
+	}
+}
+
+// TestOnRecvPacketPostReceiveHook tests that the post receive hook is executed
+// with the coins credited to the receiver and that its errors fail the receive.
+func (suite *KeeperTestSuite) TestOnRecvPacketPostReceiveHook() {
+	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
+	var hookCalls []sdk.Coin
+	hookErr := errors.New("hook failed")
+	transferKeeper := suite.chainA.App.TransferKeeper
+	transferKeeper.SetPostReceiveHook(func(
+		_ sdk.Context, p channelexported.PacketI, denom string, amount sdk.Int, receiver sdk.AccAddress,
+	) error {
+		suite.Require().Equal(packet, p)
+		suite.Require().Equal(data.Receiver, receiver.String())
+		hookCalls = append(hookCalls, sdk.NewCoin(denom, amount))
+		if len(hookCalls) > 1 {
+			return hookErr
+		}
+		return nil
+	})
+
+	ctx := suite.chainA.GetContext()
+	escrow := types.GetEscrowAddress(testPort2, testChannel2)
+	_, err := suite.chainA.App.BankKeeper.AddCoins(ctx, escrow, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+	suite.Require().NoError(err)
+
+	// the hook is executed with the unescrowed coins
+	err = transferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(100))}, hookCalls)
+
+	// the hook error is returned
+	err = transferKeeper.OnRecvPacket(ctx, packet, data)
+	suite.Require().Equal(hookErr, err)
+
+	// the hook cannot be overridden
+	suite.Require().Panics(func() { transferKeeper.SetPostReceiveHook(nil) })
+}
+
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund
 func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String())
+	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String(), "")
 	testCoins2 := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
 
 	successAck := types.FungibleTokenPacketAcknowledgement{
@@ -196,7 +703,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			}, false, false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
 	for i, tc := range testCases {
 		tc := tc
@@ -213,7 +720,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 				prefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
 				denom = prefixCoins[0].Denom[len(prefix):]
 			} else {
-				denom = data.Amount[0].Denom
+				denom = types.ParseDenomTrace(data.Amount[0].Denom).IBCDenom()
 			}
 
 			err := suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
@@ -233,7 +740,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 // TestOnTimeoutPacket test private refundPacket function since it is a simple wrapper over it
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String())
+	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String(), "")
 	testCoins2 := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
 
 	testCases := []struct {
@@ -266,7 +773,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			}, true, false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
 	for i, tc := range testCases {
 		tc := tc
@@ -283,7 +790,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				prefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
 				denom = prefixCoins[0].Denom[len(prefix):]
 			} else {
-				denom = data.Amount[0].Denom
+				denom = types.ParseDenomTrace(data.Amount[0].Denom).IBCDenom()
 			}
 
 			err := suite.chainA.App.TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
@@ -300,3 +807,127 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+// TestRefundAddress tests that the tokens of a failed transfer are refunded to
+// the refund address of the packet data instead of the sender.
+func (suite *KeeperTestSuite) TestRefundAddress() {
+	refundAddr := sdk.AccAddress(crypto.AddressHash([]byte("refund")))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(types.GetDenomPrefix(testPort2, testChannel2)+"atom", 100))
+	voucherDenom := types.ParseDenomTrace(amount[0].Denom).IBCDenom()
+	errorAck := types.FungibleTokenPacketAcknowledgement{Success: false, Error: "failed packet transfer"}
+
+	testCases := []struct {
+		msg    string
+		refund func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error
+	}{
+		{"timeout", func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+			return suite.chainA.App.TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+		}},
+		{"error acknowledgement", func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+			return suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, errorAck)
+		}},
+	}
+
+	for i, tc := range testCases {
+		suite.SetupTest() // reset
+		capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+		cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+		ctx := suite.chainA.GetContext()
+		_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+		suite.chainA.CreateClient(suite.chainB)
+		suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+		suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+		suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, testPort1, testChannel1, 1)
+
+		err = suite.chainA.App.TransferKeeper.SendTransferWithRefundAddress(
+			ctx, testPort1, testChannel1, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), testAddr1, testAddr2.String(), testTimeoutHeight, 0, "", refundAddr,
+		)
+		suite.Require().NoError(err, "test case %d: %s", i, tc.msg)
+
+		data := types.NewFungibleTokenPacketData(amount, testAddr1.String(), testAddr2.String(), "")
+		data.RefundAddress = refundAddr.String()
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+		commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1)
+		suite.Require().Equal(channeltypes.CommitPacket(packet), commitment, "test case %d: %s", i, tc.msg)
+
+		err = tc.refund(ctx, packet, data)
+		suite.Require().NoError(err, "test case %d: %s", i, tc.msg)
+		// the escrowed tokens are refunded, no voucher is minted
+		suite.Require().Equal(int64(100), suite.chainA.App.BankKeeper.GetBalance(ctx, refundAddr, "atom").Amount.Int64(), "test case %d: %s", i, tc.msg)
+		suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr1, "atom").IsZero(), "test case %d: %s", i, tc.msg)
+		suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, refundAddr, voucherDenom).IsZero(), "test case %d: %s", i, tc.msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundSentCoins() {
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+	cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+	suite.chainA.CreateClient(suite.chainB)
+	suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, testPort1, testChannel1, 1)
+
+	// the vouchers are sent back to their source chain, so they are burned
+	voucher := sdk.NewCoins(sdk.NewCoin(prefixTrace.IBCDenom(), sdk.NewInt(100)))
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, prefixTrace)
+	suite.chainA.App.SupplyKeeper.SetSupply(ctx, supply.NewSupply(voucher))
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, voucher)
+
+	err = suite.chainA.App.TransferKeeper.SendTransfer(ctx, testPort1, testChannel1, voucher, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
+	suite.Require().NoError(err)
+
+	sentCoins, found := suite.chainA.App.TransferKeeper.GetSentCoins(ctx, testPort1, testChannel1, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewSentCoins(voucher, false), sentCoins)
+
+	// the traces are migrated to the chain ID scheme before the packet times out,
+	// which changes the denomination the vouchers would be minted with
+	transferKeeper := suite.chainA.App.TransferKeeper.WithChainIDDenomTraces(
+		suite.chainA.App.IBCKeeper.ConnectionKeeper, suite.chainA.App.IBCKeeper.ClientKeeper,
+	)
+	migrated, err := transferKeeper.MigrateDenomTraces(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, migrated)
+	chainIDTrace := prefixTrace.WithChainID(suite.chainB.Header.ChainID)
+
+	data := types.NewFungibleTokenPacketData(prefixCoins, testAddr1.String(), testAddr2.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+	commitment := suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+	suite.Require().NoError(transferKeeper.OnTimeoutPacket(ctx, packet, data))
+
+	// the burned vouchers are refunded with their original denomination
+	suite.Require().Equal(voucher, suite.chainA.App.BankKeeper.GetAllBalances(ctx, testAddr1))
+	suite.Require().True(suite.chainA.App.BankKeeper.GetBalance(ctx, testAddr1, chainIDTrace.IBCDenom()).IsZero())
+
+	_, found = transferKeeper.GetSentCoins(ctx, testPort1, testChannel1, 1)
+	suite.Require().False(found)
+
+	// the trace hashes are migrated before the packet times out, which converts
+	// the recorded coins along with the balances
+	err = transferKeeper.SendTransfer(ctx, testPort1, testChannel1, voucher, testAddr1, testAddr2.String(), testTimeoutHeight, 0, "")
+	suite.Require().NoError(err)
+
+	hashKeeper := transferKeeper.WithDenomTraceHasher(sha1.New)
+	_, err = hashKeeper.MigrateDenomTraceHashes(ctx, types.DefaultDenomTraceHasher)
+	suite.Require().NoError(err)
+
+	migratedVoucher := sdk.NewCoins(sdk.NewCoin(prefixTrace.IBCDenomWith(sha1.New), sdk.NewInt(100)))
+	sentCoins, found = hashKeeper.GetSentCoins(ctx, testPort1, testChannel1, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewSentCoins(migratedVoucher, false), sentCoins)
+
+	packet = channeltypes.NewPacket(data.GetBytes(), 2, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+	suite.Require().NoError(hashKeeper.OnTimeoutPacket(ctx, packet, data))
+
+	// the burned vouchers are refunded with their migrated denomination
+	suite.Require().Equal(migratedVoucher, suite.chainA.App.BankKeeper.GetAllBalances(ctx, testAddr1))
+}
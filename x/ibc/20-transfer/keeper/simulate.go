@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// SimulateTransfer executes the sending logic of a transfer on a branch of the
+// state that is then discarded. It returns the packet that would be sent along
+// with the coins that would be credited on the destination chain, without
+// mutating state.
+func (k Keeper) SimulateTransfer(ctx sdk.Context, msg types.MsgTransfer) (types.SimulateTransferResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return types.SimulateTransferResponse{}, err
+	}
+
+	// the events emitted by the send logic are discarded along with the state
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	packet, data, err := k.sendTransfer(
+		cacheCtx, msg.SourcePort, msg.SourceChannel, msg.Amount, msg.Sender, msg.Receiver,
+		msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo, msg.RefundAddress, msg.MinReceived,
+	)
+	if err != nil {
+		return types.SimulateTransferResponse{}, err
+	}
+
+	received := make(sdk.Coins, len(data.Amount))
+	for i, coin := range data.Amount {
+		received[i] = sdk.NewCoin(receivedDenom(packet, coin.Denom), coin.Amount)
+	}
+
+	return types.NewSimulateTransferResponse(packet, data, received), nil
+}
+
+// receivedDenom returns the denomination under which the destination chain of
+// the packet credits the given packet data denomination (see OnRecvPacket).
+//
+// NOTE: the destination chain is assumed to use the default denomination trace
+// scheme (see WithChainIDDenomTraces).
+func receivedDenom(packet channel.Packet, denom string) string {
+	prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	if strings.HasPrefix(denom, prefix) {
+		// vouchers are minted with their hashed denomination
+		return types.ParseDenomTrace(denom).IBCDenom()
+	}
+
+	// the tokens are unescrowed on their way back to the source chain
+	sourcePrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	return types.ParseDenomTrace(strings.TrimPrefix(denom, sourcePrefix)).IBCDenom()
+}
+
+// creditedDenom returns the denomination under which this chain credited the
+// given packet data denomination of a received packet (see OnRecvPacket).
+func (k Keeper) creditedDenom(ctx sdk.Context, packet channel.Packet, denom string) (string, error) {
+	prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	if strings.HasPrefix(denom, prefix) {
+		return k.voucherDenom(ctx, denom)
+	}
+
+	sourcePrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	return k.voucherDenom(ctx, strings.TrimPrefix(denom, sourcePrefix))
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func (suite *KeeperTestSuite) TestSimulateTransfer() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	receiver := sdk.AccAddress(crypto.AddressHash([]byte("receiver")))
+	amount := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	voucher := sdk.NewCoin(prefixTrace.IBCDenom(), sdk.NewInt(100))
+	capName := ibctypes.ChannelCapabilityPath(testPort1, testChannel1)
+
+	testCases := []struct {
+		msg         string
+		amount      sdk.Coins
+		malleate    func()
+		packetDenom string
+		expReceived sdk.Coins
+		expPass     bool
+	}{
+		{"native tokens are received as vouchers", amount,
+			func() {
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), sender, testCoins)
+				suite.Require().NoError(err)
+			},
+			"testportid/secondchannel/atom",
+			sdk.NewCoins(sdk.NewCoin(types.ParseDenomTrace("testportid/secondchannel/atom").IBCDenom(), sdk.NewInt(100))),
+			true},
+		{"vouchers are received as native tokens on their source chain", sdk.NewCoins(voucher),
+			func() {
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), prefixTrace)
+				suite.chainA.App.SupplyKeeper.SetSupply(suite.chainA.GetContext(), supply.NewSupply(sdk.NewCoins(voucher)))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), sender, sdk.NewCoins(voucher))
+				suite.Require().NoError(err)
+			},
+			"bank/firstchannel/atom",
+			testCoins,
+			true},
+		{"insufficient funds", amount, func() {}, "", nil, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			cap, err := suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), capName)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.chainA.App.ScopedTransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, capName))
+
+			suite.chainA.CreateClient(suite.chainB)
+			suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectionexported.OPEN)
+			suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.OPEN, channelexported.ORDERED, testConnection)
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			balances := suite.chainA.App.BankKeeper.GetAllBalances(ctx, sender)
+			supplyBefore := suite.chainA.App.SupplyKeeper.GetSupply(ctx).GetTotal()
+
+			msg := types.NewMsgTransfer(testPort1, testChannel1, tc.amount, sender, receiver.String(), testTimeoutHeight, 0, "")
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QuerySimulateTransfer}, "/"),
+				Data: suite.cdc.MustMarshalJSON(types.NewQuerySimulateTransferParams(msg)),
+			}
+
+			querier := keeper.NewQuerier(suite.chainA.App.TransferKeeper)
+			bz, err := querier(ctx, []string{types.QuerySimulateTransfer}, query)
+
+			// the simulation doesn't mutate state
+			suite.Require().Equal(balances, suite.chainA.App.BankKeeper.GetAllBalances(ctx, sender))
+			suite.Require().Equal(supplyBefore, suite.chainA.App.SupplyKeeper.GetSupply(ctx).GetTotal())
+			sequence, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, testPort1, testChannel1)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(1), sequence)
+			suite.Require().Nil(suite.chainA.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, testPort1, testChannel1, 1))
+			suite.Require().Empty(ctx.EventManager().Events())
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+				var simulation types.SimulateTransferResponse
+				suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &simulation))
+
+				expData := types.NewFungibleTokenPacketData(
+					sdk.NewCoins(sdk.NewCoin(tc.packetDenom, sdk.NewInt(100))), sender.String(), receiver.String(), "",
+				)
+				expPacket := channeltypes.NewPacket(expData.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 110, 0)
+				suite.Require().Equal(expPacket, simulation.Packet)
+				suite.Require().Equal(expData, simulation.PacketData)
+				suite.Require().Equal(tc.expReceived, simulation.ReceivedAmount)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// IsSupportedVersion returns true if the given channel version is supported by
+// the keeper.
+func (k Keeper) IsSupportedVersion(version string) bool {
+	return version == types.Version || (k.protoPacketEncoding && version == types.VersionProto)
+}
+
+// ValidateVersion returns an error if the given channel version proposed on the
+// channel handshake is not supported by the keeper.
+func (k Keeper) ValidateVersion(version string) error {
+	if !k.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+	return nil
+}
+
+// NegotiateVersion checks the version agreed on the channel handshake against
+// the proposed one. The agreed version must be supported by the keeper and be
+// either the proposed version or the JSON encoded version, which every transfer
+// module supports.
+func (k Keeper) NegotiateVersion(proposedVersion, version string) error {
+	if proposedVersion != types.Version && proposedVersion != types.VersionProto {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid proposed version %s, expected %s", proposedVersion, types.Version)
+	}
+
+	if err := k.ValidateVersion(version); err != nil {
+		return err
+	}
+
+	if version != proposedVersion && version != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "version %s doesn't match the proposed version %s", version, proposedVersion)
+	}
+	return nil
+}
+
+// NegotiateCounterpartyVersion checks the version chosen by the counterparty
+// against the version proposed by the given channel end on the handshake
+// initialization.
+func (k Keeper) NegotiateCounterpartyVersion(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	channelEnd, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channel.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	return k.NegotiateVersion(channelEnd.Version, counterpartyVersion)
+}
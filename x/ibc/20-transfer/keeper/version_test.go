@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestNegotiateVersion() {
+	testCases := []struct {
+		msg             string
+		protoEncoding   bool
+		proposedVersion string
+		version         string
+		expPass         bool
+	}{
+		{"JSON encoding", false, types.Version, types.Version, true},
+		{"protobuf encoding", true, types.VersionProto, types.VersionProto, true},
+		{"fall back to JSON", true, types.VersionProto, types.Version, true},
+		{"fall back to JSON without protobuf support", false, types.VersionProto, types.Version, true},
+		{"protobuf not supported", false, types.VersionProto, types.VersionProto, false},
+		{"protobuf not proposed", true, types.Version, types.VersionProto, false},
+		{"unsupported proposed version", true, "ics20-2", types.Version, false},
+		{"unsupported version", true, types.Version, "ics20-2", false},
+		{"empty version", true, types.Version, "", false},
+	}
+
+	for i, tc := range testCases {
+		transferKeeper := suite.chainA.App.TransferKeeper
+		if tc.protoEncoding {
+			transferKeeper = transferKeeper.WithProtoPacketEncoding()
+		}
+
+		err := transferKeeper.NegotiateVersion(tc.proposedVersion, tc.version)
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().True(types.ErrInvalidVersion.Is(err), "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestNegotiateCounterpartyVersion() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.App.TransferKeeper.WithProtoPacketEncoding()
+
+	err := transferKeeper.NegotiateCounterpartyVersion(ctx, testPort1, testChannel1, types.Version)
+	suite.Require().Error(err, "channel not found")
+
+	channel := suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channelexported.INIT, channelexported.UNORDERED, testConnection)
+	channel.Version = types.Version
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, testPort1, testChannel1, channel)
+
+	suite.Require().NoError(transferKeeper.NegotiateCounterpartyVersion(ctx, testPort1, testChannel1, types.Version))
+	err = transferKeeper.NegotiateCounterpartyVersion(ctx, testPort1, testChannel1, types.VersionProto)
+	suite.Require().True(types.ErrInvalidVersion.Is(err))
+}
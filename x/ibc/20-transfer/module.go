@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -27,9 +28,10 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ port.IBCModule        = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule          = AppModule{}
+	_ module.QueryServiceModule = AppModule{}
+	_ port.IBCModule            = AppModule{}
+	_ module.AppModuleBasic     = AppModuleBasic{}
 )
 
 // AppModuleBasic is the 20-transfer appmodulebasic
@@ -52,8 +54,13 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
 }
 
 // ValidateGenesis performs genesis state validation for the ibc transfer module.
-func (AppModuleBasic) ValidateGenesis(_ codec.JSONMarshaler, _ json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, bz json.RawMessage) error {
+	var gs types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return gs.Validate()
 }
 
 // RegisterRESTRoutes implements AppModuleBasic interface
@@ -106,7 +113,13 @@ func (AppModule) QuerierRoute() string {
 
 // NewQuerierHandler implements the AppModule interface
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return nil
+	return NewQuerier(am.keeper)
+}
+
+// RegisterQueryService registers the gRPC query service of the module on the
+// given server. The legacy querier remains available (see NewQuerierHandler).
+func (am AppModule) RegisterQueryService(server *grpc.Server) {
+	types.RegisterQueryServer(server, am.keeper)
 }
 
 // InitGenesis performs genesis initialization for the ibc transfer module. It returns
@@ -115,7 +128,6 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data j
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	// TODO: check if the IBC transfer module account is set
 	InitGenesis(ctx, am.keeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
@@ -132,6 +144,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -146,7 +159,9 @@ func (am AppModule) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	// TODO: Enforce ordering, currently relayers use ORDERED channels
+	if err := am.keeper.ValidateChannelOrdering(ctx, order); err != nil {
+		return err
+	}
 
 	// Require portID is the portID transfer module is bound to
 	boundPort := am.keeper.GetPort(ctx)
@@ -154,8 +169,13 @@ func (am AppModule) OnChanOpenInit(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	if version != types.Version {
-		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid version: %s, expected %s", version, "ics20-1")
+	// the transfer module must own the capability of the port it's bound to
+	if !am.keeper.IsBound(ctx, portID) {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "port %s is not bound to the transfer module", portID)
+	}
+
+	if err := am.keeper.ValidateVersion(version); err != nil {
+		return err
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -163,7 +183,9 @@ func (am AppModule) OnChanOpenInit(
 		return sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, err.Error())
 	}
 
-	// TODO: escrow
+	// the escrow address of the channel is derived with the current version for
+	// the whole lifetime of the channel
+	am.keeper.SetEscrowAddressVersion(ctx, portID, channelID, types.CurrentEscrowAddressVersion)
 	return nil
 }
 
@@ -178,7 +200,9 @@ func (am AppModule) OnChanOpenTry(
 	version,
 	counterpartyVersion string,
 ) error {
-	// TODO: Enforce ordering, currently relayers use ORDERED channels
+	if err := am.keeper.ValidateChannelOrdering(ctx, order); err != nil {
+		return err
+	}
 
 	// Require portID is the portID transfer module is bound to
 	boundPort := am.keeper.GetPort(ctx)
@@ -186,12 +210,15 @@ func (am AppModule) OnChanOpenTry(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	if version != types.Version {
-		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid version: %s, expected %s", version, "ics20-1")
+	// the transfer module must own the capability of the port it's bound to
+	if !am.keeper.IsBound(ctx, portID) {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "port %s is not bound to the transfer module", portID)
 	}
 
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid counterparty version: %s, expected %s", counterpartyVersion, "ics20-1")
+	// the counterparty version is the one proposed on the handshake
+	// initialization, which falls back to JSON if it's not supported
+	if err := am.keeper.NegotiateVersion(counterpartyVersion, version); err != nil {
+		return err
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -199,7 +226,9 @@ func (am AppModule) OnChanOpenTry(
 		return sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, err.Error())
 	}
 
-	// TODO: escrow
+	// the escrow address of the channel is derived with the current version for
+	// the whole lifetime of the channel
+	am.keeper.SetEscrowAddressVersion(ctx, portID, channelID, types.CurrentEscrowAddressVersion)
 	return nil
 }
 
@@ -209,10 +238,10 @@ func (am AppModule) OnChanOpenAck(
 	channelID string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid counterparty version: %s, expected %s", counterpartyVersion, "ics20-1")
-	}
-	return nil
+	// the counterparty version is the one agreed on the try step, which is
+	// checked against the version proposed by this channel end. The agreed
+	// version is then recorded by the channel keeper.
+	return am.keeper.NegotiateCounterpartyVersion(ctx, portID, channelID, counterpartyVersion)
 }
 
 func (am AppModule) OnChanOpenConfirm(
@@ -229,7 +258,7 @@ func (am AppModule) OnChanCloseInit(
 	channelID string,
 ) error {
 	// Disallow user-initiated channel closing for transfer channels
-	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+	return sdkerrors.Wrapf(types.ErrChannelCloseNotAllowed, "port ID (%s) channel ID (%s)", portID, channelID)
 }
 
 func (am AppModule) OnChanCloseConfirm(
@@ -243,22 +272,42 @@ func (am AppModule) OnChanCloseConfirm(
 func (am AppModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
 ) (*sdk.Result, error) {
-	var data FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	var nftData NonFungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &nftData); err == nil {
+		return am.onRecvNFTPacket(ctx, packet, nftData, relayer)
+	}
+
+	data, err := am.keeper.UnmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return nil, err
 	}
 	acknowledgement := FungibleTokenPacketAcknowledgement{
 		Success: true,
 		Error:   "",
 	}
-	if err := am.keeper.OnRecvPacket(ctx, packet, data); err != nil {
-		acknowledgement = FungibleTokenPacketAcknowledgement{
-			Success: false,
-			Error:   err.Error(),
-		}
+
+	// the receive is executed on a cached context so that the tokens credited
+	// before a failure (e.g on a post receive hook error) are discarded along
+	// with its events. Invalid packet data results on an error acknowledgement
+	// too, so that the sender is refunded.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := data.ValidateBasic(); err != nil {
+		acknowledgement = NewErrorAcknowledgement(err)
+	} else if err := am.keeper.OnRecvPacket(cacheCtx, packet, data); err != nil {
+		acknowledgement = NewErrorAcknowledgement(err)
+	} else if err := am.keeper.ForwardTransfer(cacheCtx, packet, data); err != nil {
+		// the received tokens are refunded if they can't be forwarded
+		acknowledgement = NewErrorAcknowledgement(err)
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	}
 
+	// the relayer is written on the acknowledgement so that the sending chain
+	// pays it the recv fee
+	acknowledgement.Relayer = relayer.String()
 	if err := am.keeper.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
 		return nil, err
 	}
@@ -269,6 +318,7 @@ func (am AppModule) OnRecvPacket(
 			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
 			sdk.NewAttribute(AttributeKeyReceiver, data.Receiver),
 			sdk.NewAttribute(AttributeKeyValue, data.Amount.String()),
+			sdk.NewAttribute(AttributeKeyAckSuccess, fmt.Sprintf("%t", acknowledgement.Success)),
 		),
 	)
 
@@ -281,39 +331,54 @@ func (am AppModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
+	relayer sdk.AccAddress,
 ) (*sdk.Result, error) {
-	var ack FungibleTokenPacketAcknowledgement
-	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	ack, err := types.GetAcknowledgement(acknowledgement)
+	if err != nil {
+		return nil, err
 	}
-	var data FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+
+	if err := am.keeper.DistributePacketFeesOnAcknowledgement(ctx, packet, ack.Relayer, relayer); err != nil {
+		return nil, err
+	}
+
+	am.keeper.DeletePacketSendHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	var nftData NonFungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &nftData); err == nil {
+		return am.onAcknowledgementNFTPacket(ctx, packet, nftData, ack)
+	}
+
+	data, err := am.keeper.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return nil, err
 	}
 
 	if err := am.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
-			sdk.NewAttribute(AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(AttributeKeyValue, data.Amount.String()),
-			sdk.NewAttribute(AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success)),
-		),
-	)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(AttributeKeySourcePort, packet.GetSourcePort()),
+		sdk.NewAttribute(AttributeKeySourceChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(AttributeKeyReceiver, data.Receiver),
+		sdk.NewAttribute(AttributeKeyValue, data.Amount.String()),
+		sdk.NewAttribute(AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success)),
+	}
 
+	// the tokens of a failed packet are refunded to the sender
 	if !ack.Success {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				EventTypePacket,
-				sdk.NewAttribute(AttributeKeyAckError, ack.Error),
-			),
+		attributes = append(attributes,
+			sdk.NewAttribute(AttributeKeyAckError, ack.Error),
+			sdk.NewAttribute(AttributeKeyRefundReceiver, data.GetRefundAddress()),
+			sdk.NewAttribute(AttributeKeyRefundValue, data.Amount.String()),
 		)
 	}
 
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypePacket, attributes...))
+
 	return &sdk.Result{
 		Events: ctx.EventManager().Events().ToABCIEvents(),
 	}, nil
@@ -322,10 +387,22 @@ func (am AppModule) OnAcknowledgementPacket(
 func (am AppModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
 ) (*sdk.Result, error) {
-	var data FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalBinaryBare(packet.GetData(), &data); err != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	if err := am.keeper.DistributePacketFeesOnTimeout(ctx, packet, relayer); err != nil {
+		return nil, err
+	}
+
+	am.keeper.DeletePacketSendHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	var nftData NonFungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &nftData); err == nil {
+		return am.onTimeoutNFTPacket(ctx, packet, nftData)
+	}
+
+	data, err := am.keeper.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return nil, err
 	}
 	// refund tokens
 	if err := am.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
@@ -335,7 +412,7 @@ func (am AppModule) OnTimeoutPacket(
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeTimeout,
-			sdk.NewAttribute(AttributeKeyRefundReceiver, data.Sender),
+			sdk.NewAttribute(AttributeKeyRefundReceiver, data.GetRefundAddress()),
 			sdk.NewAttribute(AttributeKeyRefundValue, data.Amount.String()),
 			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
 		),
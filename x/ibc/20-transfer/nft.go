@@ -0,0 +1,126 @@
+package transfer
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+// handleMsgTransferNFT sends a non-fungible token transfer packet through the
+// given channel.
+func handleMsgTransferNFT(ctx sdk.Context, k Keeper, msg MsgTransferNFT) (*sdk.Result, error) {
+	if err := k.SendNFTTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassID, msg.TokenIDs, msg.Sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(AttributeKeyReceiver, msg.Receiver),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func (am AppModule) onRecvNFTPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data NonFungibleTokenPacketData,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	acknowledgement := FungibleTokenPacketAcknowledgement{
+		Success: true,
+		Error:   "",
+	}
+
+	// the tokens minted or unescrowed before a failure are discarded
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := data.ValidateBasic(); err != nil {
+		acknowledgement = NewErrorAcknowledgement(err)
+	} else if err := am.keeper.OnRecvNFTPacket(cacheCtx, packet, data); err != nil {
+		acknowledgement = NewErrorAcknowledgement(err)
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	acknowledgement.Relayer = relayer.String()
+	if err := am.keeper.PacketExecuted(ctx, packet, acknowledgement.GetBytes()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeNFTPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(AttributeKeyClassID, data.ClassID),
+			sdk.NewAttribute(AttributeKeyTokenIDs, strings.Join(data.TokenIDs, ",")),
+			sdk.NewAttribute(AttributeKeyAckSuccess, fmt.Sprintf("%t", acknowledgement.Success)),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func (am AppModule) onAcknowledgementNFTPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data NonFungibleTokenPacketData,
+	ack FungibleTokenPacketAcknowledgement,
+) (*sdk.Result, error) {
+	if err := am.keeper.OnAcknowledgementNFTPacket(ctx, packet, data, ack); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeNFTPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(AttributeKeyClassID, data.ClassID),
+			sdk.NewAttribute(AttributeKeyTokenIDs, strings.Join(data.TokenIDs, ",")),
+			sdk.NewAttribute(AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success)),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func (am AppModule) onTimeoutNFTPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data NonFungibleTokenPacketData,
+) (*sdk.Result, error) {
+	// refund tokens
+	if err := am.keeper.OnTimeoutNFTPacket(ctx, packet, data); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTimeout,
+			sdk.NewAttribute(AttributeKeyRefundReceiver, data.Sender),
+			sdk.NewAttribute(AttributeKeyClassID, data.ClassID),
+			sdk.NewAttribute(AttributeKeyTokenIDs, strings.Join(data.TokenIDs, ",")),
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package testutil
+
+import (
+	"fmt"
+	"time"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// periods of the tendermint client state of a MockClient
+const (
+	TrustingPeriod  time.Duration = time.Hour * 24 * 7 * 2
+	UnbondingPeriod time.Duration = time.Hour * 24 * 7 * 3
+)
+
+// MockClient is a tendermint light client of a mock counterparty chain. Its
+// client and consensus states are stored directly on the client keeper, without
+// verifying any header, and all of its consensus states commit to the same root,
+// against which the proofs it returns verify.
+type MockClient struct {
+	ClientID string
+	ChainID  string
+	Header   ibctmtypes.Header // latest header of the counterparty chain
+
+	signers []tmtypes.PrivValidator
+}
+
+// NewMockClient creates a new MockClient at height 1, with a single validator.
+func NewMockClient(clientID, chainID string) *MockClient {
+	privVal := tmtypes.NewMockPV()
+	validator := tmtypes.NewValidator(privVal.GetPubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	signers := []tmtypes.PrivValidator{privVal}
+
+	return &MockClient{
+		ClientID: clientID,
+		ChainID:  chainID,
+		Header:   ibctmtypes.CreateTestHeader(chainID, 1, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), valSet, signers),
+		signers:  signers,
+	}
+}
+
+// GetHeight returns the latest height of the client.
+func (mc *MockClient) GetHeight() uint64 {
+	return uint64(mc.Header.Height)
+}
+
+// GetRoot returns the commitment root of the consensus states of the client.
+func (mc *MockClient) GetRoot() []byte {
+	return mc.Header.AppHash
+}
+
+// Create creates the client on the client keeper at its latest height.
+func (mc *MockClient) Create(ctx sdk.Context, k client.Keeper) error {
+	clientState, err := ibctmtypes.Initialize(mc.ClientID, TrustingPeriod, UnbondingPeriod, mc.Header)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.CreateClient(ctx, clientState, mc.Header.ConsensusState())
+	return err
+}
+
+// AdvanceHeight advances the counterparty chain by the given number of blocks,
+// a minute apart, and stores the client and consensus states of the new height.
+// It returns the new latest height of the client.
+//
+// CONTRACT: the client must have been created (see Create).
+func (mc *MockClient) AdvanceHeight(ctx sdk.Context, k client.Keeper, blocks uint64) (uint64, error) {
+	clientState, found := k.GetClientState(ctx, mc.ClientID)
+	if !found {
+		return 0, fmt.Errorf("client %s not found", mc.ClientID)
+	}
+
+	tmClientState, ok := clientState.(ibctmtypes.ClientState)
+	if !ok {
+		return 0, fmt.Errorf("invalid client state type %T", clientState)
+	}
+
+	mc.Header = ibctmtypes.CreateTestHeader(
+		mc.ChainID, mc.Header.Height+int64(blocks), mc.Header.Time.Add(time.Duration(blocks)*time.Minute),
+		mc.Header.ValidatorSet, mc.signers,
+	)
+
+	tmClientState.LastHeader = mc.Header
+	k.SetClientState(ctx, tmClientState)
+	k.SetClientConsensusState(ctx, mc.ClientID, mc.GetHeight(), mc.Header.ConsensusState())
+	return mc.GetHeight(), nil
+}
+
+// PacketCommitmentProof returns a proof of the commitment of the packet on the
+// counterparty chain at the latest height of the client, for the given
+// commitment prefix of the counterparty chain.
+func (mc *MockClient) PacketCommitmentProof(prefix commitmentexported.Prefix, packet channelexported.PacketI) (MockProof, uint64, error) {
+	return mc.proof(
+		prefix, ibctypes.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()),
+		channeltypes.CommitPacket(packet),
+	)
+}
+
+// AcknowledgementProof returns a proof of the acknowledgement of the packet
+// written by the counterparty chain, at the latest height of the client.
+func (mc *MockClient) AcknowledgementProof(prefix commitmentexported.Prefix, packet channelexported.PacketI, ack []byte) (MockProof, uint64, error) {
+	return mc.proof(
+		prefix, ibctypes.PacketAcknowledgementPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		ack,
+	)
+}
+
+// AcknowledgementAbsenceProof returns a proof that the packet hasn't been
+// acknowledged by the counterparty chain, at the latest height of the client.
+func (mc *MockClient) AcknowledgementAbsenceProof(prefix commitmentexported.Prefix, packet channelexported.PacketI) (MockProof, uint64, error) {
+	return mc.proof(
+		prefix, ibctypes.PacketAcknowledgementPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		nil,
+	)
+}
+
+func (mc *MockClient) proof(prefix commitmentexported.Prefix, path string, value []byte) (MockProof, uint64, error) {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, path)
+	if err != nil {
+		return MockProof{}, 0, err
+	}
+
+	return NewMockProof(mc.GetRoot(), merklePath.String(), value), mc.GetHeight(), nil
+}
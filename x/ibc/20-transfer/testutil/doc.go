@@ -0,0 +1,20 @@
+/*
+Package testutil provides helpers to write the tests of the ICS 20 transfer
+handlers without hand-building packets and proofs.
+
+A PacketBuilder builds the transfer packets of a channel with consecutive
+sequences. A MockClient is a tendermint light client stored directly on the
+client keeper, whose consensus states commit to a fixed root, so that the
+MockProofs returned for its packets verify against it:
+
+	client := testutil.NewMockClient("mockclient", "mockchain")
+	client.Create(ctx, clientKeeper)
+
+	builder := testutil.NewPacketBuilder("bank", "firstchannel", "transfer", "secondchannel")
+	packet := builder.Build(builder.VoucherData(coins, sender, receiver))
+	proof, proofHeight, err := client.PacketCommitmentProof(prefix, packet)
+
+The client height is advanced with AdvanceHeight, so that the proofs can be
+generated at a later height.
+*/
+package testutil
@@ -0,0 +1,83 @@
+package testutil
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// DefaultTimeoutHeight is the timeout height of the packets built by a
+// PacketBuilder, which is high enough not to be reached by the test chains.
+const DefaultTimeoutHeight uint64 = 1000
+
+// PacketBuilder builds the transfer packets sent from a source channel end to
+// its destination channel end, with consecutive sequences starting at 1.
+type PacketBuilder struct {
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	TimeoutHeight      ibctypes.Height
+	TimeoutTimestamp   uint64
+	Encoding           types.PacketEncoding
+
+	sequence uint64
+}
+
+// NewPacketBuilder creates a new PacketBuilder for the given channel ends. The
+// packets time out at the DefaultTimeoutHeight and their data is JSON encoded.
+func NewPacketBuilder(sourcePort, sourceChannel, destinationPort, destinationChannel string) *PacketBuilder {
+	return &PacketBuilder{
+		SourcePort:         sourcePort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    destinationPort,
+		DestinationChannel: destinationChannel,
+		TimeoutHeight:      ibctypes.NewHeight(0, DefaultTimeoutHeight),
+		Encoding:           types.PacketEncodingJSON,
+		sequence:           1,
+	}
+}
+
+// NextSequence returns the sequence of the next packet built.
+func (pb *PacketBuilder) NextSequence() uint64 {
+	return pb.sequence
+}
+
+// Build returns a packet with the given data and the next sequence, which is
+// then incremented.
+func (pb *PacketBuilder) Build(data types.FungibleTokenPacketData) channeltypes.Packet {
+	packet := channeltypes.NewPacketWithTimeoutHeight(
+		data.GetEncodedBytes(pb.Encoding), pb.sequence,
+		pb.SourcePort, pb.SourceChannel, pb.DestinationPort, pb.DestinationChannel,
+		pb.TimeoutHeight, pb.TimeoutTimestamp,
+	)
+	pb.sequence++
+	return packet
+}
+
+// VoucherData returns the packet data of tokens that are native to the source
+// chain, i.e whose denominations are prefixed with the destination channel end
+// so that vouchers are minted on the destination chain.
+func (pb *PacketBuilder) VoucherData(amount sdk.Coins, sender, receiver string) types.FungibleTokenPacketData {
+	return types.NewFungibleTokenPacketData(
+		prefixCoins(types.GetDenomPrefix(pb.DestinationPort, pb.DestinationChannel), amount), sender, receiver, "",
+	)
+}
+
+// ReturnData returns the packet data of vouchers returning to their source
+// chain, i.e whose denominations are prefixed with the source channel end so
+// that the tokens are unescrowed on the destination chain.
+func (pb *PacketBuilder) ReturnData(amount sdk.Coins, sender, receiver string) types.FungibleTokenPacketData {
+	return types.NewFungibleTokenPacketData(
+		prefixCoins(types.GetDenomPrefix(pb.SourcePort, pb.SourceChannel), amount), sender, receiver, "",
+	)
+}
+
+func prefixCoins(prefix string, amount sdk.Coins) sdk.Coins {
+	coins := make(sdk.Coins, len(amount))
+	for i, coin := range amount {
+		coins[i] = sdk.NewCoin(prefix+coin.Denom, coin.Amount)
+	}
+	return coins
+}
@@ -0,0 +1,78 @@
+package testutil
+
+import (
+	"bytes"
+	"errors"
+
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+var _ commitmentexported.Proof = MockProof{}
+
+// MockProof is a commitment proof of a single key-value pair under a commitment
+// root. It verifies the membership of its value at its path, and the absence of
+// the path when the value is empty.
+type MockProof struct {
+	Root  []byte `json:"root" yaml:"root"`
+	Path  string `json:"path" yaml:"path"`
+	Value []byte `json:"value" yaml:"value"`
+}
+
+// NewMockProof creates a new MockProof instance
+func NewMockProof(root []byte, path string, value []byte) MockProof {
+	return MockProof{
+		Root:  root,
+		Path:  path,
+		Value: value,
+	}
+}
+
+// GetCommitmentType implements the Proof interface
+func (MockProof) GetCommitmentType() commitmentexported.Type {
+	return commitmentexported.Merkle
+}
+
+// VerifyMembership implements the Proof interface
+func (proof MockProof) VerifyMembership(root commitmentexported.Root, path commitmentexported.Path, value []byte) error {
+	if err := proof.verifyPath(root, path); err != nil {
+		return err
+	}
+	if len(value) == 0 || !bytes.Equal(value, proof.Value) {
+		return errors.New("mock proof value doesn't match")
+	}
+	return nil
+}
+
+// VerifyNonMembership implements the Proof interface
+func (proof MockProof) VerifyNonMembership(root commitmentexported.Root, path commitmentexported.Path) error {
+	if err := proof.verifyPath(root, path); err != nil {
+		return err
+	}
+	if len(proof.Value) != 0 {
+		return errors.New("mock proof is a membership proof")
+	}
+	return nil
+}
+
+// IsEmpty implements the Proof interface
+func (proof MockProof) IsEmpty() bool {
+	return len(proof.Root) == 0
+}
+
+// ValidateBasic implements the Proof interface
+func (proof MockProof) ValidateBasic() error {
+	if proof.IsEmpty() {
+		return errors.New("mock proof root cannot be empty")
+	}
+	return nil
+}
+
+func (proof MockProof) verifyPath(root commitmentexported.Root, path commitmentexported.Path) error {
+	if root == nil || !bytes.Equal(root.GetHash(), proof.Root) {
+		return errors.New("mock proof root doesn't match")
+	}
+	if path == nil || path.String() != proof.Path {
+		return errors.New("mock proof path doesn't match")
+	}
+	return nil
+}
@@ -12,7 +12,25 @@ var ModuleCdc = codec.New()
 // RegisterCodec registers the IBC transfer types
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTransfer{}, "ibc/transfer/MsgTransfer", nil)
+	cdc.RegisterConcrete(MsgMultiTransfer{}, "ibc/transfer/MsgMultiTransfer", nil)
+	cdc.RegisterConcrete(MsgTransferNFT{}, "ibc/transfer/MsgTransferNFT", nil)
+	cdc.RegisterConcrete(MsgPayPacketFee{}, "ibc/transfer/MsgPayPacketFee", nil)
+	cdc.RegisterConcrete(MsgUpdateParams{}, "ibc/transfer/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(MsgCancelTransfer{}, "ibc/transfer/MsgCancelTransfer", nil)
 	cdc.RegisterConcrete(FungibleTokenPacketData{}, "ibc/transfer/PacketDataTransfer", nil)
+	cdc.RegisterConcrete(NonFungibleTokenPacketData{}, "ibc/transfer/PacketDataNFTTransfer", nil)
+	cdc.RegisterConcrete(DenomTrace{}, "ibc/transfer/DenomTrace", nil)
+	cdc.RegisterConcrete(RateLimit{}, "ibc/transfer/RateLimit", nil)
+}
+
+// RegisterPacketData registers a custom packet data type with the IBC transfer
+// codec, so that applications built on top of the transfer module can marshal
+// their own packet data through ModuleCdc. The name must be unique among the
+// types registered with the codec, which panics otherwise. It must be called
+// before any packet is processed, usually from the init function of the package
+// that defines the type.
+func RegisterPacketData(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
 }
 
 func init() {
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+type customPacketData struct {
+	Value string `json:"value"`
+}
+
+func TestRegisterPacketData(t *testing.T) {
+	RegisterPacketData(customPacketData{}, "test/CustomPacketData")
+
+	data := customPacketData{Value: "value"}
+	bz, err := ModuleCdc.MarshalJSON(data)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "test/CustomPacketData")
+
+	var decodedData customPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decodedData))
+	require.Equal(t, data, decodedData)
+
+	// the names of the registered types can't be reused
+	require.Panics(t, func() { RegisterPacketData(struct{}{}, "test/CustomPacketData") })
+
+	// the packet and proof interfaces are still registered
+	packet := channel.NewPacket(bz, 1, "transfer", "channelone", "transfer", "channeltwo", 100, 0)
+	msg := channel.NewMsgPacket(packet, commitmenttypes.MerkleProof{}, 10, addr1)
+	bz, err = ModuleCdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+
+	var decodedMsg channel.MsgPacket
+	require.NoError(t, ModuleCdc.UnmarshalBinaryBare(bz, &decodedMsg))
+	require.Equal(t, msg.Packet, decodedMsg.Packet)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PacketEncoding defines the encoding of the fungible token packet data sent
+// over a channel, which is negotiated with the channel version.
+type PacketEncoding byte
+
+// Packet data encodings
+const (
+	PacketEncodingJSON  PacketEncoding = iota // sorted JSON encoded with the module codec (default)
+	PacketEncodingProto                       // protobuf encoded
+)
+
+// GetPacketEncoding returns the packet data encoding used by a channel with the
+// given version.
+func GetPacketEncoding(version string) PacketEncoding {
+	if version == VersionProto {
+		return PacketEncodingProto
+	}
+	return PacketEncodingJSON
+}
+
+// String implements the Stringer interface
+func (pe PacketEncoding) String() string {
+	switch pe {
+	case PacketEncodingJSON:
+		return "json"
+	case PacketEncodingProto:
+		return "proto"
+	default:
+		return fmt.Sprintf("%d", byte(pe))
+	}
+}
+
+// GetEncodedBytes returns the packet data bytes with the given encoding.
+func (ftpd FungibleTokenPacketData) GetEncodedBytes(encoding PacketEncoding) []byte {
+	if encoding == PacketEncodingProto {
+		return ftpd.GetProtoBytes()
+	}
+	return ftpd.GetBytes()
+}
+
+// GetProtoBytes returns the protobuf encoding of the packet data. The fields are
+// marshaled in ascending order, so that the encoding is deterministic.
+func (ftpd FungibleTokenPacketData) GetProtoBytes() []byte {
+	bz, err := ftpd.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// DecodePacketData decodes the fungible token packet data bytes with the given
+// encoding.
+func DecodePacketData(bz []byte, encoding PacketEncoding) (FungibleTokenPacketData, error) {
+	if encoding == PacketEncodingProto {
+		return decodeProtoPacketData(bz)
+	}
+	return GetPacketData(bz)
+}
+
+// decodeProtoPacketData decodes the protobuf encoding of the packet data. Only
+// the canonical encoding returned by GetProtoBytes is accepted, so that a packet
+// data has a single valid representation.
+func decodeProtoPacketData(bz []byte) (FungibleTokenPacketData, error) {
+	var data FungibleTokenPacketData
+	if err := data.Unmarshal(bz); err != nil {
+		return FungibleTokenPacketData{}, sdkerrors.Wrap(ErrInvalidPacketData, err.Error())
+	}
+
+	if !bytes.Equal(bz, data.GetProtoBytes()) {
+		return FungibleTokenPacketData{}, sdkerrors.Wrap(ErrInvalidPacketData, "non-canonical protobuf encoding")
+	}
+	return data, nil
+}
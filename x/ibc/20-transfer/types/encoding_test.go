@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPacketDataEncoding(t *testing.T) {
+	testCases := []struct {
+		msg  string
+		data FungibleTokenPacketData
+	}{
+		{"packet data", NewFungibleTokenPacketData(coins, addr1.String(), addr2, "memo")},
+		{"packet data without memo", NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")},
+		{"packet data with refund address", withRefundAddress(addr1.String())},
+		{"packet data with minimum received amount", withMinReceived(sdk.NewInt64Coin("atom", 90))},
+		{"empty packet data", FungibleTokenPacketData{}},
+	}
+
+	for _, tc := range testCases {
+		for _, encoding := range []PacketEncoding{PacketEncodingJSON, PacketEncodingProto} {
+			bz := tc.data.GetEncodedBytes(encoding)
+			require.Equal(t, bz, tc.data.GetEncodedBytes(encoding), "%s: %s encoding is not deterministic", tc.msg, encoding)
+
+			data, err := DecodePacketData(bz, encoding)
+			require.NoError(t, err, "%s: %s", tc.msg, encoding)
+			require.Equal(t, tc.data.GetBytes(), data.GetBytes(), "%s: %s", tc.msg, encoding)
+		}
+	}
+
+	data := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "memo")
+	require.True(t, len(data.GetProtoBytes()) < len(data.GetBytes()))
+
+	// JSON bytes are not valid protobuf and vice versa
+	_, err := DecodePacketData(data.GetBytes(), PacketEncodingProto)
+	require.Error(t, err)
+	_, err = DecodePacketData(data.GetProtoBytes(), PacketEncodingJSON)
+	require.Error(t, err)
+}
+
+func TestDecodeProtoPacketDataNonCanonical(t *testing.T) {
+	data := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "memo")
+	bz := data.GetProtoBytes()
+	bzWithoutMemo := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "").GetProtoBytes()
+
+	// keys of the memo field (4) and of an unknown field (7)
+	memoKey := byte(4<<3 | 2)
+	unknownKey := byte(7<<3 | 2)
+
+	testCases := []struct {
+		msg string
+		bz  []byte
+	}{
+		{"truncated bytes", bz[:len(bz)-1]},
+		{"unordered fields", append([]byte{memoKey, 4, 'm', 'e', 'm', 'o'}, bzWithoutMemo...)},
+		{"explicit empty field", append(append([]byte{}, bzWithoutMemo...), memoKey, 0)},
+		{"unknown field", append(append([]byte{}, bz...), unknownKey, 1, 'x')},
+		{"invalid wire type", append(append([]byte{}, bz...), byte(4<<3), 1)},
+	}
+
+	for _, tc := range testCases {
+		_, err := DecodePacketData(tc.bz, PacketEncodingProto)
+		require.Error(t, err, tc.msg)
+	}
+}
+
+func TestGetPacketEncoding(t *testing.T) {
+	require.Equal(t, PacketEncodingJSON, GetPacketEncoding(Version))
+	require.Equal(t, PacketEncodingProto, GetPacketEncoding(VersionProto))
+	require.Equal(t, PacketEncodingJSON, GetPacketEncoding("unknown"))
+}
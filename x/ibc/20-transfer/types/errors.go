@@ -4,9 +4,44 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// IBC channel sentinel errors
+// IBC transfer sentinel errors
 var (
 	ErrInvalidPacketTimeout    = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
 	ErrOnlyOneDenomAllowed     = sdkerrors.Register(ModuleName, 3, "only one denom allowed")
 	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 4, "invalid denomination for cross-chain transfer")
+	ErrInvalidMemo             = sdkerrors.Register(ModuleName, 5, "invalid memo")
+	ErrInvalidDenomTrace       = sdkerrors.Register(ModuleName, 6, "invalid denomination trace")
+	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 7, "denomination trace not found")
+	ErrTooManyDenoms           = sdkerrors.Register(ModuleName, 8, "too many denominations")
+	ErrInvalidRateLimit        = sdkerrors.Register(ModuleName, 9, "invalid rate limit")
+	ErrRateLimitExceeded       = sdkerrors.Register(ModuleName, 10, "rate limit exceeded")
+	ErrRateLimitNotFound       = sdkerrors.Register(ModuleName, 11, "rate limit not found")
+	ErrInsufficientEscrow      = sdkerrors.Register(ModuleName, 12, "insufficient escrowed funds")
+	ErrEscrowReceiver          = sdkerrors.Register(ModuleName, 13, "receiver cannot be an escrow account")
+	ErrInvalidClassID          = sdkerrors.Register(ModuleName, 14, "invalid non-fungible token class ID")
+	ErrNFTTransfersDisabled    = sdkerrors.Register(ModuleName, 15, "non-fungible token transfers are not enabled")
+	ErrUnknownDenom            = sdkerrors.Register(ModuleName, 16, "unknown denomination")
+	ErrAmountOverflow          = sdkerrors.Register(ModuleName, 17, "amount overflow")
+	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 18, "invalid ICS20 version")
+	ErrInvalidForward          = sdkerrors.Register(ModuleName, 19, "invalid transfer forward")
+	ErrTooManyForwardHops      = sdkerrors.Register(ModuleName, 20, "too many transfer forward hops")
+	ErrInvalidFee              = sdkerrors.Register(ModuleName, 21, "invalid relayer fee")
+	ErrPacketNotInFlight       = sdkerrors.Register(ModuleName, 22, "packet is not in flight")
+	ErrPacketNotTimedOut       = sdkerrors.Register(ModuleName, 23, "packet has not timed out")
+	ErrDenomNotTransferable    = sdkerrors.Register(ModuleName, 24, "denomination is not transferable")
+	ErrSelfLoopbackTransfer    = sdkerrors.Register(ModuleName, 25, "sender and receiver are the same account on a loopback channel")
+	ErrSendDisabled            = sdkerrors.Register(ModuleName, 26, "transfers out of the chain are disabled")
+	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 27, "transfers into the chain are disabled")
+	ErrInvalidPacketData       = sdkerrors.Register(ModuleName, 28, "invalid ICS20 packet data")
+	ErrInvalidAcknowledgement  = sdkerrors.Register(ModuleName, 29, "invalid ICS20 packet acknowledgement")
+	ErrChannelCloseNotAllowed  = sdkerrors.Register(ModuleName, 30, "transfer channels cannot be closed by users")
+	ErrClientKeepersNotSet     = sdkerrors.Register(ModuleName, 31, "connection and client keepers are not set")
+	ErrSourceMismatch          = sdkerrors.Register(ModuleName, 32, "denomination prefix doesn't match the source of the tokens")
+	ErrInvalidParams           = sdkerrors.Register(ModuleName, 33, "invalid IBC transfer parameters")
+	ErrInvalidAuthority        = sdkerrors.Register(ModuleName, 34, "invalid authority")
+	ErrInvalidTransferFee      = sdkerrors.Register(ModuleName, 35, "invalid transfer fee")
+	ErrEscrowSnapshotNotFound  = sdkerrors.Register(ModuleName, 36, "escrow snapshot not found")
+	ErrInvalidMinReceived      = sdkerrors.Register(ModuleName, 37, "invalid minimum received amount")
+	ErrMinReceivedNotMet       = sdkerrors.Register(ModuleName, 38, "received amount is less than the minimum received amount")
+	ErrDenomTraceTooDeep       = sdkerrors.Register(ModuleName, 39, "denomination trace exceeds the maximum depth")
 )
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// EscrowAddressVersion defines the scheme used to derive the escrow address of
+// a channel. The version is recorded when the channel is opened, so that the
+// escrow address of a channel never changes as the derivation evolves.
+type EscrowAddressVersion uint32
+
+const (
+	// EscrowAddressVersionLegacy derives the escrow address from the hash of the
+	// port and channel identifiers (see GetEscrowAddress). It's the version of
+	// the channels opened before the escrow addresses were versioned.
+	EscrowAddressVersionLegacy EscrowAddressVersion = 0
+
+	// EscrowAddressVersionADR028 derives the escrow address as an ADR 028
+	// module account address, with the transfer version as the address type.
+	EscrowAddressVersionADR028 EscrowAddressVersion = 1
+
+	// CurrentEscrowAddressVersion is the version recorded for new channels
+	CurrentEscrowAddressVersion = EscrowAddressVersionADR028
+)
+
+// escrowAddressVersions are all the known escrow address versions
+var escrowAddressVersions = []EscrowAddressVersion{EscrowAddressVersionLegacy, EscrowAddressVersionADR028}
+
+// Validate returns an error if the escrow address version is unknown.
+func (v EscrowAddressVersion) Validate() error {
+	for _, version := range escrowAddressVersions {
+		if v == version {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown escrow address version %d", v)
+}
+
+// String implements the Stringer interface.
+func (v EscrowAddressVersion) String() string {
+	switch v {
+	case EscrowAddressVersionLegacy:
+		return "legacy"
+	case EscrowAddressVersionADR028:
+		return "adr028"
+	default:
+		return fmt.Sprintf("%d", uint32(v))
+	}
+}
+
+// GetVersionedEscrowAddress returns the escrow address for the specified channel
+// derived with the given version.
+func GetVersionedEscrowAddress(version EscrowAddressVersion, portID, channelID string) sdk.AccAddress {
+	switch version {
+	case EscrowAddressVersionADR028:
+		preImage := append([]byte(Version), 0)
+		preImage = append(preImage, fmt.Sprintf("%s/%s", portID, channelID)...)
+		hash := sha256.Sum256(preImage)
+		return sdk.AccAddress(hash[:sdk.AddrLen])
+	default:
+		return GetEscrowAddress(portID, channelID)
+	}
+}
+
+// IsEscrowAddress returns true if the address is the escrow address of the
+// specified channel for any of the escrow address versions. It's used when the
+// version of the channel is unknown, e.g for channels of the counterparty chain.
+func IsEscrowAddress(address sdk.AccAddress, portID, channelID string) bool {
+	for _, version := range escrowAddressVersions {
+		if GetVersionedEscrowAddress(version, portID, channelID).Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChannelEscrowAddressVersion defines the escrow address version recorded for
+// a channel.
+type ChannelEscrowAddressVersion struct {
+	PortID    string               `json:"port_id" yaml:"port_id"`
+	ChannelID string               `json:"channel_id" yaml:"channel_id"`
+	Version   EscrowAddressVersion `json:"version" yaml:"version"`
+}
+
+// NewChannelEscrowAddressVersion creates a new ChannelEscrowAddressVersion instance.
+func NewChannelEscrowAddressVersion(portID, channelID string, version EscrowAddressVersion) ChannelEscrowAddressVersion {
+	return ChannelEscrowAddressVersion{
+		PortID:    portID,
+		ChannelID: channelID,
+		Version:   version,
+	}
+}
+
+// Validate performs a basic validation of the channel identifiers and the
+// escrow address version.
+func (cv ChannelEscrowAddressVersion) Validate() error {
+	if err := host.PortIdentifierValidator(cv.PortID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(cv.ChannelID); err != nil {
+		return err
+	}
+	return cv.Version.Validate()
+}
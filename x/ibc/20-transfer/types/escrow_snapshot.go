@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChannelEscrowBalance defines the balances held by the escrow account of a
+// transfer channel.
+type ChannelEscrowBalance struct {
+	PortID    string         `json:"port_id" yaml:"port_id"`
+	ChannelID string         `json:"channel_id" yaml:"channel_id"`
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Balances  sdk.Coins      `json:"balances" yaml:"balances"`
+}
+
+// NewChannelEscrowBalance creates a new ChannelEscrowBalance instance
+func NewChannelEscrowBalance(portID, channelID string, address sdk.AccAddress, balances sdk.Coins) ChannelEscrowBalance {
+	return ChannelEscrowBalance{
+		PortID:    portID,
+		ChannelID: channelID,
+		Address:   address,
+		Balances:  balances,
+	}
+}
+
+// EscrowSnapshot defines the balances escrowed by each of the transfer channels
+// at a given height, along with their total for each denomination.
+type EscrowSnapshot struct {
+	Height   uint64                 `json:"height" yaml:"height"`
+	Channels []ChannelEscrowBalance `json:"channels" yaml:"channels"`
+	Total    sdk.Coins              `json:"total" yaml:"total"` // sum of the balances of all the channels
+}
+
+// NewEscrowSnapshot creates a new EscrowSnapshot instance. The total is summed
+// from the balances of the channels.
+func NewEscrowSnapshot(height uint64, channels []ChannelEscrowBalance) EscrowSnapshot {
+	total := sdk.NewCoins()
+	for _, ch := range channels {
+		total = total.Add(ch.Balances...)
+	}
+
+	return EscrowSnapshot{
+		Height:   height,
+		Channels: channels,
+		Total:    total,
+	}
+}
@@ -8,9 +8,21 @@ import (
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeChannelClose = "channel_closed"
+	EventTypeTimeout       = "timeout"
+	EventTypePacket        = "fungible_token_packet"
+	EventTypeChannelClose  = "channel_closed"
+	EventTypeTransfer      = "ibc_transfer"
+	EventTypeRecvTransfer  = "recv_ibc_transfer"
+	EventTypeNFTTransfer   = "ibc_nft_transfer"
+	EventTypeNFTPacket     = "non_fungible_token_packet"
+	EventTypeForward       = "forward_ibc_transfer"
+	EventTypeForwardRefund = "forward_ibc_transfer_refund"
+	EventTypePayPacketFee  = "pay_packet_fee"
+	EventTypeDistributeFee = "distribute_packet_fee"
+	EventTypeRefundStuck   = "refund_stuck_packet"
+	EventTypeDenomTrace    = "denomination_trace"
+	EventTypeTransferFee   = "ibc_transfer_fee"
+	EventTypeCancel        = "cancel_ibc_transfer"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyValue          = "value"
@@ -18,6 +30,27 @@ const (
 	AttributeKeyRefundValue    = "refund_value"
 	AttributeKeyAckSuccess     = "success"
 	AttributeKeyAckError       = "error"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyAmount         = "amount"
+	AttributeKeySourcePort     = "source_port"
+	AttributeKeySourceChannel  = "source_channel"
+	AttributeKeyDestPort       = "destination_port"
+	AttributeKeyDestChannel    = "destination_channel"
+	AttributeKeyIsSource       = "is_source"
+	AttributeKeyVoucherDenom   = "voucher_denom"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyRecvFee        = "recv_fee"
+	AttributeKeyAckFee         = "ack_fee"
+	AttributeKeyTimeoutFee     = "timeout_fee"
+	AttributeKeyRelayer        = "relayer"
+	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyFeeRecipient   = "fee_recipient"
+	AttributeKeyFee            = "fee"
+	AttributeKeyTimeoutHeight  = "timeout_height"
+	AttributeKeyTimeoutTime    = "timeout_timestamp"
+	AttributeKeyDataHash       = "data_hash"
 )
 
 // IBC transfer events vars
@@ -7,26 +7,39 @@ import (
 	connection "github.com/cosmos/cosmos-sdk/x/ibc/03-connection"
 	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
 	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 // BankKeeper defines the expected bank keeper
 type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error
+	IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddress, sdk.Coin) bool)
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channel.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
+	DeletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64)
+	GetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool)
 	SendPacket(ctx sdk.Context, channelCap *capability.Capability, packet channelexported.PacketI) error
 	PacketExecuted(ctx sdk.Context, chanCap *capability.Capability, packet channelexported.PacketI, acknowledgement []byte) error
+	TimeoutPacket(ctx sdk.Context, packet channelexported.PacketI, proof commitmentexported.Proof, proofHeight, nextSequenceRecv uint64) (channelexported.PacketI, error)
+	TimeoutExecuted(ctx sdk.Context, chanCap *capability.Capability, packet channelexported.PacketI) error
 	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capability.Capability) error
+	IteratePortChannels(ctx sdk.Context, portID string, cb func(channel.IdentifiedChannel) bool)
 }
 
 // ClientKeeper defines the expected IBC client keeper
 type ClientKeeper interface {
-	GetClientConsensusState(ctx sdk.Context, clientID string) (connection clientexported.ConsensusState, found bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height uint64) (connection clientexported.ConsensusState, found bool)
+	GetClientState(ctx sdk.Context, clientID string) (clientexported.ClientState, bool)
 }
 
 // ConnectionKeeper defines the expected IBC connection keeper
@@ -39,10 +52,21 @@ type PortKeeper interface {
 	BindPort(ctx sdk.Context, portID string) *capability.Capability
 }
 
+// NFTKeeper defines the expected non-fungible token keeper. Minting a token of an
+// unknown class is expected to create the class.
+type NFTKeeper interface {
+	GetOwner(ctx sdk.Context, classID, tokenID string) (sdk.AccAddress, bool)
+	Transfer(ctx sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error
+	Mint(ctx sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID, tokenID string) error
+}
+
 // SupplyKeeper expected supply keeper
 type SupplyKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, name string) supplyexported.ModuleAccountI
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	SetSupply(ctx sdk.Context, supply supplyexported.SupplyI)
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
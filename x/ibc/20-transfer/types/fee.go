@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Fee defines the fees paid to the relayers of a packet:
+//
+// - RecvFee is paid to the relayer that submits the packet to the destination chain
+// - AckFee is paid to the relayer that submits the acknowledgement back to this chain
+// - TimeoutFee is paid to the relayer that submits the timeout of the packet
+//
+// The recv and ack fees are refunded to the payer if the packet times out, and
+// the timeout fee is refunded if the packet is acknowledged.
+type Fee struct {
+	RecvFee    sdk.Coins `json:"recv_fee" yaml:"recv_fee"`
+	AckFee     sdk.Coins `json:"ack_fee" yaml:"ack_fee"`
+	TimeoutFee sdk.Coins `json:"timeout_fee" yaml:"timeout_fee"`
+}
+
+// NewFee creates a new Fee instance
+func NewFee(recvFee, ackFee, timeoutFee sdk.Coins) Fee {
+	return Fee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+	}
+}
+
+// Total returns the sum of all the fees
+func (fee Fee) Total() sdk.Coins {
+	return fee.RecvFee.Add(fee.AckFee...).Add(fee.TimeoutFee...)
+}
+
+// ValidateBasic performs a basic validation of the fees. At least one of the
+// fees must be defined.
+func (fee Fee) ValidateBasic() error {
+	for _, coins := range []sdk.Coins{fee.RecvFee, fee.AckFee, fee.TimeoutFee} {
+		if !coins.Empty() && !coins.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+		}
+	}
+	if fee.Total().IsZero() {
+		return sdkerrors.Wrap(ErrInvalidFee, "at least one of the recv, ack or timeout fees must be defined")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (fee Fee) String() string {
+	return fmt.Sprintf(`Fee:
+  Recv Fee:    %s
+  Ack Fee:     %s
+  Timeout Fee: %s`, fee.RecvFee, fee.AckFee, fee.TimeoutFee)
+}
+
+// PacketFee defines the fees escrowed by a single payer for a packet. The unused
+// fees are refunded to the refund address.
+type PacketFee struct {
+	Fee           Fee            `json:"fee" yaml:"fee"`
+	RefundAddress sdk.AccAddress `json:"refund_address" yaml:"refund_address"`
+}
+
+// NewPacketFee creates a new PacketFee instance
+func NewPacketFee(fee Fee, refundAddress sdk.AccAddress) PacketFee {
+	return PacketFee{
+		Fee:           fee,
+		RefundAddress: refundAddress,
+	}
+}
+
+// IdentifiedPacketFees defines all the fees escrowed for a packet, identified by
+// the port, channel and sequence it was sent with.
+type IdentifiedPacketFees struct {
+	PortID     string      `json:"port_id" yaml:"port_id"`
+	ChannelID  string      `json:"channel_id" yaml:"channel_id"`
+	Sequence   uint64      `json:"sequence" yaml:"sequence"`
+	PacketFees []PacketFee `json:"packet_fees" yaml:"packet_fees"`
+}
+
+// NewIdentifiedPacketFees creates a new IdentifiedPacketFees instance
+func NewIdentifiedPacketFees(portID, channelID string, sequence uint64, packetFees []PacketFee) IdentifiedPacketFees {
+	return IdentifiedPacketFees{
+		PortID:     portID,
+		ChannelID:  channelID,
+		Sequence:   sequence,
+		PacketFees: packetFees,
+	}
+}
+
+// Total returns the sum of all the fees escrowed for the packet
+func (fees IdentifiedPacketFees) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, packetFee := range fees.PacketFees {
+		total = total.Add(packetFee.Fee.Total()...)
+	}
+	return total
+}
+
+// String implements the Stringer interface
+func (fees IdentifiedPacketFees) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Packet Fees %s/%s/%d:", fees.PortID, fees.ChannelID, fees.Sequence)
+	for _, packetFee := range fees.PacketFees {
+		fmt.Fprintf(&b, "\n  %s %s", packetFee.RefundAddress, strings.ReplaceAll(packetFee.Fee.String(), "\n", "\n  "))
+	}
+	return b.String()
+}
+
+// MsgPayPacketFee defines a msg to escrow the relayer fees of a packet sent
+// through a transfer channel. Fees can be paid by any account, and several times
+// for the same packet.
+type MsgPayPacketFee struct {
+	SourcePort    string         `json:"source_port" yaml:"source_port"`       // the port on which the packet was sent
+	SourceChannel string         `json:"source_channel" yaml:"source_channel"` // the channel by which the packet was sent
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`             // the sequence of the packet
+	Fee           Fee            `json:"fee" yaml:"fee"`                       // the fees paid to the relayers of the packet
+	Signer        sdk.AccAddress `json:"signer" yaml:"signer"`                 // the fee payer, which is refunded the unused fees
+}
+
+// NewMsgPayPacketFee creates a new MsgPayPacketFee instance
+func NewMsgPayPacketFee(sourcePort, sourceChannel string, sequence uint64, fee Fee, signer sdk.AccAddress) MsgPayPacketFee {
+	return MsgPayPacketFee{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sequence:      sequence,
+		Fee:           fee,
+		Signer:        signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgPayPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgPayPacketFee) Type() string {
+	return "pay_packet_fee"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPayPacketFee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.Sequence == 0 {
+		return sdkerrors.Wrap(channel.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
+	}
+	return msg.Fee.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgPayPacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayPacketFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// GetFeeEscrowAddress returns the address that escrows the relayer fees of the
+// packets sent through the specified channel. The preimage has one more "/"
+// separated component than the one of the transfer escrow address (see
+// GetEscrowAddress), so the two addresses never collide.
+func GetFeeEscrowAddress(portID, channelID string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s/fee", portID, channelID))))
+}
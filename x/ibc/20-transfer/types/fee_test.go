@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgPayPacketFeeValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	fee := NewFee(coins, coins, coins)
+
+	testCases := []struct {
+		name    string
+		msg     MsgPayPacketFee
+		expPass bool
+	}{
+		{"valid msg", NewMsgPayPacketFee("testportid", "testchannel", 1, fee, signer), true},
+		{"only timeout fee", NewMsgPayPacketFee("testportid", "testchannel", 1, NewFee(nil, nil, coins), signer), true},
+		{"invalid port", NewMsgPayPacketFee("(invalidport)", "testchannel", 1, fee, signer), false},
+		{"invalid channel", NewMsgPayPacketFee("testportid", "(invalidchannel)", 1, fee, signer), false},
+		{"zero sequence", NewMsgPayPacketFee("testportid", "testchannel", 0, fee, signer), false},
+		{"missing signer", NewMsgPayPacketFee("testportid", "testchannel", 1, fee, nil), false},
+		{"no fees", NewMsgPayPacketFee("testportid", "testchannel", 1, NewFee(nil, nil, nil), signer), false},
+		{"invalid fee", NewMsgPayPacketFee("testportid", "testchannel", 1, NewFee(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, nil, nil), signer), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestGetFeeEscrowAddress(t *testing.T) {
+	require.NotEqual(t, GetEscrowAddress("transfer", "channel"), GetFeeEscrowAddress("transfer", "channel"))
+	require.NotEqual(t, GetFeeEscrowAddress("transfer", "channel"), GetFeeEscrowAddress("transfer", "otherchannel"))
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// MaxForwardHops is the maximum number of chains a transfer memo can forward the
+// received tokens through, so that a transfer can't be forwarded indefinitely.
+var MaxForwardHops = 4
+
+// DefaultForwardTimeout is the default timeout of a forwarded transfer, relative
+// to the block time of the forwarding chain.
+const DefaultForwardTimeout = 10 * time.Minute
+
+// ForwardMemo defines the structure of a transfer memo that forwards the received
+// tokens to another chain.
+type ForwardMemo struct {
+	Forward *ForwardMetadata `json:"forward,omitempty"`
+}
+
+// ForwardMetadata defines the next hop of a forwarded transfer. The tokens are
+// sent from the receiver of the incoming transfer, which is credited the tokens
+// first, to the forward receiver through the given port and channel.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`          // the recipient address on the next chain
+	Port     string          `json:"port"`              // the port on which the tokens are forwarded
+	Channel  string          `json:"channel"`           // the channel by which the tokens are forwarded
+	Timeout  time.Duration   `json:"timeout,omitempty"` // the timeout (in nanoseconds) of the forwarded transfer
+	Next     json.RawMessage `json:"next,omitempty"`    // the memo of the forwarded transfer, forwarding it further if it defines a forward
+}
+
+// ParseForwardMetadata returns the forward instruction defined by a transfer
+// memo. Memos that aren't JSON objects or that don't define a forward are not
+// forwarded. The forward instructions of all the hops are validated, so that an
+// invalid or too long forward path fails on the first hop.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	metadata, found := parseForwardMemo(memo)
+	if !found {
+		return ForwardMetadata{}, false, nil
+	}
+
+	hop, hops := metadata, 1
+	for {
+		if err := hop.ValidateBasic(); err != nil {
+			return ForwardMetadata{}, false, sdkerrors.Wrapf(err, "hop %d", hops)
+		}
+
+		next, found := parseForwardMemo(string(hop.Next))
+		if !found {
+			break
+		}
+
+		hops++
+		if hops > MaxForwardHops {
+			return ForwardMetadata{}, false, sdkerrors.Wrapf(
+				ErrTooManyForwardHops, "transfer cannot be forwarded more than %d times", MaxForwardHops,
+			)
+		}
+		hop = next
+	}
+
+	return metadata, true, nil
+}
+
+// parseForwardMemo decodes the forward instruction of a memo, if any.
+func parseForwardMemo(memo string) (ForwardMetadata, bool) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return ForwardMetadata{}, false
+	}
+
+	var forwardMemo ForwardMemo
+	if err := json.Unmarshal([]byte(memo), &forwardMemo); err != nil || forwardMemo.Forward == nil {
+		return ForwardMetadata{}, false
+	}
+	return *forwardMemo.Forward, true
+}
+
+// GetTimeout returns the timeout of the forwarded transfer, which defaults to
+// DefaultForwardTimeout.
+func (fm ForwardMetadata) GetTimeout() time.Duration {
+	if fm.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+	return fm.Timeout
+}
+
+// GetNextMemo returns the memo of the forwarded transfer.
+func (fm ForwardMetadata) GetNextMemo() string {
+	return string(fm.Next)
+}
+
+// ValidateBasic performs a basic validation of the ForwardMetadata fields. The
+// next hops are not validated.
+func (fm ForwardMetadata) ValidateBasic() error {
+	if strings.TrimSpace(fm.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForward, "missing forward receiver address")
+	}
+	if len(fm.Receiver) > MaximumReceiverLength {
+		return sdkerrors.Wrapf(ErrInvalidForward, "forward receiver address length %d exceeds the maximum of %d bytes", len(fm.Receiver), MaximumReceiverLength)
+	}
+	if err := host.PortIdentifierValidator(fm.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForward, "invalid forward port ID: %s", err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(fm.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForward, "invalid forward channel ID: %s", err.Error())
+	}
+	if fm.Timeout < 0 {
+		return sdkerrors.Wrap(ErrInvalidForward, "forward timeout cannot be negative")
+	}
+	return nil
+}
+
+// ForwardRecord defines the reverse path of a forwarded transfer, which the
+// forwarded tokens are refunded along if the forwarded packet fails. It is
+// stored until the forwarded packet is acknowledged or timed out.
+type ForwardRecord struct {
+	RefundPort     string    `json:"refund_port" yaml:"refund_port"`         // the port the incoming transfer was received on
+	RefundChannel  string    `json:"refund_channel" yaml:"refund_channel"`   // the channel the incoming transfer was received on
+	RefundReceiver string    `json:"refund_receiver" yaml:"refund_receiver"` // the sender of the incoming transfer on the previous chain
+	Amount         sdk.Coins `json:"amount" yaml:"amount"`                   // the forwarded tokens, with their denomination on this chain
+}
+
+// NewForwardRecord creates a new ForwardRecord instance
+func NewForwardRecord(refundPort, refundChannel, refundReceiver string, amount sdk.Coins) ForwardRecord {
+	return ForwardRecord{
+		RefundPort:     refundPort,
+		RefundChannel:  refundChannel,
+		RefundReceiver: refundReceiver,
+		Amount:         amount,
+	}
+}
+
+// String returns a string representation of ForwardRecord
+func (fr ForwardRecord) String() string {
+	return fmt.Sprintf(`ForwardRecord:
+	RefundPort:           %s
+	RefundChannel:        %s
+	RefundReceiver:       %s
+	Amount:               %s`,
+		fr.RefundPort,
+		fr.RefundChannel,
+		fr.RefundReceiver,
+		fr.Amount,
+	)
+}
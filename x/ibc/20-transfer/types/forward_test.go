@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// forwardMemo returns a memo forwarding the tokens through the given number of
+// hops, each of them with a valid forward instruction.
+func forwardMemo(hops int) string {
+	memo := ""
+	for i := hops; i > 0; i-- {
+		next := ""
+		if memo != "" {
+			next = fmt.Sprintf(`,"next":%s`, memo)
+		}
+		memo = fmt.Sprintf(`{"forward":{"receiver":"receiver%d","port":"transfer","channel":"forwardchannel"%s}}`, i, next)
+	}
+	return memo
+}
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		expFound bool
+		expPass  bool
+	}{
+		{"empty memo", "", false, true},
+		{"plain text memo", "thanks for the fish", false, true},
+		{"json memo without forward", `{"swap":{"denom":"atom"}}`, false, true},
+		{"malformed json memo", `{"forward":`, false, true},
+		{"single hop", forwardMemo(1), true, true},
+		{"maximum number of hops", forwardMemo(MaxForwardHops), true, true},
+		{"too many hops", forwardMemo(MaxForwardHops + 1), false, false},
+		{"missing receiver", `{"forward":{"port":"transfer","channel":"forwardchannel"}}`, false, false},
+		{"invalid port", `{"forward":{"receiver":"receiver1","port":"(invalidport)","channel":"forwardchannel"}}`, false, false},
+		{"invalid channel", `{"forward":{"receiver":"receiver1","port":"transfer","channel":"(invalidchannel)"}}`, false, false},
+		{"negative timeout", `{"forward":{"receiver":"receiver1","port":"transfer","channel":"forwardchannel","timeout":-1}}`, false, false},
+		{"invalid next hop", `{"forward":{"receiver":"receiver1","port":"transfer","channel":"forwardchannel","next":{"forward":{"port":"transfer","channel":"forwardchannel"}}}}`, false, false},
+	}
+
+	for _, tc := range testCases {
+		metadata, found, err := ParseForwardMetadata(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+
+		if found {
+			require.Equal(t, "receiver1", metadata.Receiver, tc.name)
+			require.Equal(t, "forwardchannel", metadata.Channel, tc.name)
+			require.Equal(t, DefaultForwardTimeout, metadata.GetTimeout(), tc.name)
+		}
+	}
+}
+
+func TestForwardMetadataNextMemo(t *testing.T) {
+	metadata, found, err := ParseForwardMetadata(forwardMemo(3))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// each hop forwards the memo of the remaining hops
+	for hop := 2; hop <= 3; hop++ {
+		metadata, found, err = ParseForwardMetadata(metadata.GetNextMemo())
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, fmt.Sprintf("receiver%d", hop), metadata.Receiver)
+	}
+
+	_, found, err = ParseForwardMetadata(metadata.GetNextMemo())
+	require.NoError(t, err)
+	require.False(t, found, "last hop cannot forward the tokens")
+
+	metadata, found, err = ParseForwardMetadata(`{"forward":{"receiver":"receiver1","port":"transfer","channel":"forwardchannel","timeout":60000000000}}`)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, time.Minute, metadata.GetTimeout())
+}
@@ -1,13 +1,80 @@
 package types
 
-// GenesisState is currently only used to ensure that the InitGenesis gets run
-// by the module manager
+import (
+	"fmt"
+
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// GenesisState defines the ibc transfer module's genesis state: the port it is
+// bound to, the traces of the vouchers minted by the module, the escrow address
+// versions of the channels and the module parameters
 type GenesisState struct {
-	PortID string `json:"portid" yaml:"portid"`
+	PortID                string                        `json:"portid" yaml:"portid"`
+	DenomTraces           []DenomTrace                  `json:"denom_traces" yaml:"denom_traces"`
+	EscrowAddressVersions []ChannelEscrowAddressVersion `json:"escrow_address_versions" yaml:"escrow_address_versions"`
+	Params                Params                        `json:"params" yaml:"params"`
 }
 
-func DefaultGenesis() GenesisState {
+// NewGenesisState creates a new ibc transfer GenesisState instance.
+func NewGenesisState(
+	portID string, denomTraces []DenomTrace, escrowAddressVersions []ChannelEscrowAddressVersion, params Params,
+) GenesisState {
 	return GenesisState{
-		PortID: PortID,
+		PortID:                portID,
+		DenomTraces:           denomTraces,
+		EscrowAddressVersions: escrowAddressVersions,
+		Params:                params,
+	}
+}
+
+// DefaultGenesis returns the default ibc transfer genesis state
+func DefaultGenesis() GenesisState {
+	return NewGenesisState(PortID, []DenomTrace{}, []ChannelEscrowAddressVersion{}, DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortID); err != nil {
+		return err
 	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	seenTraces := make(map[string]bool)
+	for i, denomTrace := range gs.DenomTraces {
+		if err := denomTrace.Validate(); err != nil {
+			return fmt.Errorf("invalid denom trace %d: %w", i, err)
+		}
+
+		// the traces are compared by their full path rather than by their hash,
+		// which depends on the hasher of the keeper. The traces of the same path
+		// received from different chains are distinct.
+		fullDenomPath := denomTrace.GetFullDenomPath()
+		if denomTrace.ChainID != "" {
+			fullDenomPath = denomTrace.ChainID + ":" + fullDenomPath
+		}
+		if seenTraces[fullDenomPath] {
+			return fmt.Errorf("duplicated denom trace %s", fullDenomPath)
+		}
+		seenTraces[fullDenomPath] = true
+	}
+
+	seenChannels := make(map[string]bool)
+	for i, escrowVersion := range gs.EscrowAddressVersions {
+		if err := escrowVersion.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow address version %d: %w", i, err)
+		}
+
+		channel := fmt.Sprintf("%s/%s", escrowVersion.PortID, escrowVersion.ChannelID)
+		if seenChannels[channel] {
+			return fmt.Errorf("duplicated escrow address version for channel %s", channel)
+		}
+		seenChannels[channel] = true
+	}
+
+	return nil
 }
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesis(), true},
+		{
+			"valid genesis",
+			NewGenesisState("transfer", []DenomTrace{
+				NewDenomTrace("transfer/channelone", "uatom"),
+				NewDenomTrace("transfer/channelone/transfer/channeltwo", "uatom"),
+			}, []ChannelEscrowAddressVersion{
+				NewChannelEscrowAddressVersion("transfer", "channelone", EscrowAddressVersionLegacy),
+				NewChannelEscrowAddressVersion("transfer", "channeltwo", EscrowAddressVersionADR028),
+			}, DefaultParams()),
+			true,
+		},
+		{"invalid port", NewGenesisState("(transfer)", nil, nil, DefaultParams()), false},
+		{
+			"invalid denom trace",
+			NewGenesisState("transfer", []DenomTrace{
+				NewDenomTrace("transfer", "uatom"),
+			}, nil, DefaultParams()),
+			false,
+		},
+		{
+			"duplicated denom trace",
+			NewGenesisState("transfer", []DenomTrace{
+				NewDenomTrace("transfer/channelone", "uatom"),
+				NewDenomTrace("transfer/channelone", "uatom"),
+			}, nil, DefaultParams()),
+			false,
+		},
+		{
+			"unknown escrow address version",
+			NewGenesisState("transfer", nil, []ChannelEscrowAddressVersion{
+				NewChannelEscrowAddressVersion("transfer", "channelone", 2),
+			}, DefaultParams()),
+			false,
+		},
+		{
+			"duplicated escrow address version",
+			NewGenesisState("transfer", nil, []ChannelEscrowAddressVersion{
+				NewChannelEscrowAddressVersion("transfer", "channelone", EscrowAddressVersionLegacy),
+				NewChannelEscrowAddressVersion("transfer", "channelone", EscrowAddressVersionADR028),
+			}, DefaultParams()),
+			false,
+		},
+		{
+			"invalid params",
+			NewGenesisState("transfer", nil, nil, Params{
+				DenomSendEnabled: []DenomEnabled{NewDenomEnabled("", true)},
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+)
+
+// PostReceiveHook defines a callback executed for each of the coins credited to
+// the receiver of a fungible token packet. The denomination is the one the coins
+// are held with on this chain (i.e the hashed voucher denomination or the native
+// denomination). Returning an error fails the receive.
+type PostReceiveHook func(
+	ctx sdk.Context, packet channelexported.PacketI, denom string, amount sdk.Int, receiver sdk.AccAddress,
+) error
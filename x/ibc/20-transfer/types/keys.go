@@ -17,6 +17,10 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// VersionProto defines the version of the IBC transfer module that encodes
+	// the fungible token packet data with protobuf instead of JSON
+	VersionProto = "ics20-1-proto"
+
 	// Default PortID that transfer module binds to
 	PortID = "transfer"
 
@@ -31,15 +35,73 @@ const (
 
 	// QuerierRoute is the querier route for IBC transfer
 	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default name for the IBC transfer parameter
+	// subspace
+	DefaultParamspace = ModuleName
+
+	// MaximumMemoLength is the maximum length (in bytes) of the memo carried
+	// with a transfer
+	MaximumMemoLength = 32768
+
+	// MaximumReceiverLength is the maximum length (in bytes) of the receiver
+	// address of a transfer. The receiver is interpreted by the destination
+	// chain, so it is not decoded on the sending chain.
+	MaximumReceiverLength = 2048
+
+	// MaximumAcknowledgementErrorLength is the maximum length (in bytes) of the
+	// error message of a failed acknowledgement. It keeps the acknowledgement
+	// within the maximum acknowledgement size of the channels, even once JSON
+	// escaped, so that it can always be relayed back and the sender refunded.
+	MaximumAcknowledgementErrorLength = 512
+)
+
+var (
+	// DenomTraceKeyPrefix defines the key prefix to store the denomination
+	// traces, indexed by their hash
+	DenomTraceKeyPrefix = []byte{0x02}
+
+	// RateLimitKeyPrefix defines the key prefix to store the outbound rate
+	// limits, indexed by port, channel and denomination
+	RateLimitKeyPrefix = []byte{0x03}
+
+	// ForwardRecordKeyPrefix defines the key prefix to store the reverse path of
+	// the forwarded transfers, indexed by the forwarded packet
+	ForwardRecordKeyPrefix = []byte{0x04}
+
+	// PacketFeesKeyPrefix defines the key prefix to store the relayer fees
+	// escrowed for the packets in flight, indexed by port, channel and sequence
+	PacketFeesKeyPrefix = []byte{0x05}
+
+	// EscrowAddressVersionKeyPrefix defines the key prefix to store the escrow
+	// address version of the channels, indexed by port and channel
+	EscrowAddressVersionKeyPrefix = []byte{0x06}
+
+	// PacketSendHeightKeyPrefix defines the key prefix to store the height at
+	// which the packets in flight were sent, indexed by source port, channel and
+	// sequence
+	PacketSendHeightKeyPrefix = []byte{0x07}
+
+	// EscrowSnapshotKeyPrefix defines the key prefix to store the snapshots of
+	// the escrow balances, indexed by height
+	EscrowSnapshotKeyPrefix = []byte{0x09}
+
+	// SentCoinsKeyPrefix defines the key prefix to store the coins taken from
+	// the senders of the packets in flight, indexed by source port, channel and
+	// sequence
+	SentCoinsKeyPrefix = []byte{0x0a}
 )
 
-// GetEscrowAddress returns the escrow address for the specified channel
+// GetEscrowAddress returns the escrow address for the specified channel. The
+// address is derived by hashing the port and channel identifiers joined by the
+// "/" separator, which valid identifiers cannot contain, so that two different
+// port/channel pairs never hash the same preimage.
 //
 // CONTRACT: this assumes that there's only one bank bridge module that owns the
 // port associated with the channel ID so that the address created is actually
 // unique.
 func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
-	return sdk.AccAddress(crypto.AddressHash([]byte(portID + channelID)))
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s", portID, channelID))))
 }
 
 // GetDenomPrefix returns the receiving denomination prefix
@@ -47,6 +109,61 @@ func GetDenomPrefix(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/", portID, channelID)
 }
 
+// KeyDenomTrace returns the store key for the denomination trace with the
+// given hash
+func KeyDenomTrace(hash []byte) []byte {
+	return append(append([]byte{}, DenomTraceKeyPrefix...), hash...)
+}
+
+// KeyRateLimit returns the store key for the rate limit of a denomination on
+// the given channel
+func KeyRateLimit(portID, channelID, denom string) []byte {
+	return append(append([]byte{}, RateLimitKeyPrefix...), []byte(fmt.Sprintf("%s/%s/%s", portID, channelID, denom))...)
+}
+
+// KeyForwardRecord returns the store key for the forward record of the packet
+// sent with the given sequence on a channel
+func KeyForwardRecord(portID, channelID string, sequence uint64) []byte {
+	return append(append([]byte{}, ForwardRecordKeyPrefix...), []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// KeyChannelPacketFees returns the store key prefix of the relayer fees escrowed
+// for the packets sent through a channel
+func KeyChannelPacketFees(portID, channelID string) []byte {
+	return append(append([]byte{}, PacketFeesKeyPrefix...), []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+}
+
+// KeyPacketFees returns the store key for the relayer fees escrowed for the
+// packet sent with the given sequence on a channel
+func KeyPacketFees(portID, channelID string, sequence uint64) []byte {
+	return append(KeyChannelPacketFees(portID, channelID), []byte(fmt.Sprintf("%d", sequence))...)
+}
+
+// KeyEscrowAddressVersion returns the store key for the escrow address version
+// of a channel
+func KeyEscrowAddressVersion(portID, channelID string) []byte {
+	return append(append([]byte{}, EscrowAddressVersionKeyPrefix...), []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// KeyPacketSendHeight returns the store key for the height at which the packet
+// with the given sequence was sent on a channel
+func KeyPacketSendHeight(portID, channelID string, sequence uint64) []byte {
+	return append(append([]byte{}, PacketSendHeightKeyPrefix...), []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// KeySentCoins returns the store key for the coins taken from the sender of the
+// packet sent with the given sequence on a channel
+func KeySentCoins(portID, channelID string, sequence uint64) []byte {
+	return append(append([]byte{}, SentCoinsKeyPrefix...), []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// KeyEscrowSnapshot returns the key of the snapshot of the escrow balances taken
+// at the given height. The height is big endian encoded so that the snapshots
+// are iterated by increasing height.
+func KeyEscrowSnapshot(height uint64) []byte {
+	return append(append([]byte{}, EscrowSnapshotKeyPrefix...), sdk.Uint64ToBigEndian(height)...)
+}
+
 // GetModuleAccountName returns the IBC transfer module account name for supply
 func GetModuleAccountName() string {
 	return fmt.Sprintf("%s/%s", ibctypes.ModuleName, ModuleName)
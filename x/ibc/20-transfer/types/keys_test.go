@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetEscrowAddress tests that escrow addresses are deterministic and unique
+// per port/channel pair
+func TestGetEscrowAddress(t *testing.T) {
+	addr := GetEscrowAddress(validPort, validChannel)
+	require.Len(t, addr, 20)
+	require.Equal(t, addr, GetEscrowAddress(validPort, validChannel), "escrow address is not reproducible")
+
+	testCases := []struct {
+		msg                 string
+		portID, channelID   string
+		cpPortID, cpChannel string
+	}{
+		{"different channels", validPort, "firstchannel", validPort, "secondchannel"},
+		{"different ports", "bank", validChannel, "transfer", validChannel},
+		{"shifted separator", "transfer", "achannelid", "transfera", "channelid"},
+		{"swapped identifiers", "transfer", "channeltoa", "channeltoa", "transfer"},
+	}
+
+	for i, tc := range testCases {
+		require.NotEqual(
+			t, GetEscrowAddress(tc.portID, tc.channelID), GetEscrowAddress(tc.cpPortID, tc.cpChannel),
+			"test case %d: %s", i, tc.msg,
+		)
+	}
+}
+
+func TestGetVersionedEscrowAddress(t *testing.T) {
+	legacy := GetVersionedEscrowAddress(EscrowAddressVersionLegacy, validPort, validChannel)
+	require.Equal(t, GetEscrowAddress(validPort, validChannel), legacy)
+
+	adr028 := GetVersionedEscrowAddress(EscrowAddressVersionADR028, validPort, validChannel)
+	require.Len(t, adr028, 20)
+	require.NotEqual(t, legacy, adr028)
+
+	require.True(t, IsEscrowAddress(legacy, validPort, validChannel))
+	require.True(t, IsEscrowAddress(adr028, validPort, validChannel))
+	require.False(t, IsEscrowAddress(adr028, validPort, "otherchannel"))
+
+	require.NoError(t, EscrowAddressVersionLegacy.Validate())
+	require.NoError(t, CurrentEscrowAddressVersion.Validate())
+	require.Error(t, EscrowAddressVersion(2).Validate())
+}
@@ -1,33 +1,61 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
+// MaxTransferDenoms is the maximum number of distinct denominations a single
+// MsgTransfer can carry. ICS20 packets are defined per denomination, so it
+// defaults to 1. Integrators can raise it deliberately, keeping in mind that
+// the transfer keeper still relays a single denomination per packet.
+var MaxTransferDenoms = 1
+
 // MsgTransfer defines a msg to transfer fungible tokens (i.e Coins) between ICS20 enabled chains.
 // See ICS Spec here: https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#data-structures
 type MsgTransfer struct {
-	SourcePort    string         `json:"source_port" yaml:"source_port"`       // the port on which the packet will be sent
-	SourceChannel string         `json:"source_channel" yaml:"source_channel"` // the channel by which the packet will be sent
-	DestHeight    uint64         `json:"dest_height" yaml:"dest_height"`       // the current height of the destination chain
-	Amount        sdk.Coins      `json:"amount" yaml:"amount"`                 // the tokens to be transferred
-	Sender        sdk.AccAddress `json:"sender" yaml:"sender"`                 // the sender address
-	Receiver      string         `json:"receiver" yaml:"receiver"`             // the recipient address on the destination chain
+	SourcePort       string          `json:"source_port" yaml:"source_port"`             // the port on which the packet will be sent
+	SourceChannel    string          `json:"source_channel" yaml:"source_channel"`       // the channel by which the packet will be sent
+	Amount           sdk.Coins       `json:"amount" yaml:"amount"`                       // the tokens to be transferred
+	Sender           sdk.AccAddress  `json:"sender" yaml:"sender"`                       // the sender address
+	Receiver         string          `json:"receiver" yaml:"receiver"`                   // the recipient address on the destination chain
+	TimeoutHeight    ibctypes.Height `json:"timeout_height" yaml:"timeout_height"`       // the block height of the destination chain after which the packet times out
+	TimeoutTimestamp uint64          `json:"timeout_timestamp" yaml:"timeout_timestamp"` // the block time (in nanoseconds) of the destination chain after which the packet times out
+	Memo             string          `json:"memo" yaml:"memo"`                           // optional application-layer metadata relayed with the packet data
+
+	// RefundAddress is the optional address the tokens are refunded to on a
+	// timeout or an error acknowledgement, which defaults to the sender.
+	RefundAddress sdk.AccAddress `json:"refund_address,omitempty" yaml:"refund_address,omitempty"`
+	// TransferFee is the optional fee deducted from the amount before it is
+	// escrowed or burned. Only the net amount is relayed with the packet.
+	TransferFee TransferFee `json:"transfer_fee,omitempty" yaml:"transfer_fee,omitempty"`
+	// MinReceived is the optional minimum amount of the sent denomination the
+	// receiver must be credited with on the destination chain. The transfer
+	// fails and the tokens are refunded otherwise.
+	MinReceived sdk.Coin `json:"min_received,omitempty" yaml:"min_received,omitempty"`
 }
 
 // NewMsgTransfer creates a new MsgTransfer instance
 func NewMsgTransfer(
-	sourcePort, sourceChannel string, destHeight uint64, amount sdk.Coins, sender sdk.AccAddress, receiver string,
+	sourcePort, sourceChannel string, amount sdk.Coins, sender sdk.AccAddress, receiver string,
+	timeoutHeight ibctypes.Height, timeoutTimestamp uint64, memo string,
 ) MsgTransfer {
 	return MsgTransfer{
-		SourcePort:    sourcePort,
-		SourceChannel: sourceChannel,
-		DestHeight:    destHeight,
-		Amount:        amount,
-		Sender:        sender,
-		Receiver:      receiver,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Amount:           amount,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -43,23 +71,34 @@ func (MsgTransfer) Type() string {
 
 // ValidateBasic implements sdk.Msg
 func (msg MsgTransfer) ValidateBasic() error {
-	if err := host.DefaultPortIdentifierValidator(msg.SourcePort); err != nil {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
 		return sdkerrors.Wrap(err, "invalid source port ID")
 	}
-	if err := host.DefaultChannelIdentifierValidator(msg.SourceChannel); err != nil {
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Amount.IsAllPositive() {
-		return sdkerrors.ErrInsufficientFunds
-	}
-	if !msg.Amount.IsValid() {
-		return sdkerrors.ErrInvalidCoins
-	}
 	if msg.Sender.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
 	}
-	if msg.Receiver == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	if err := NewTransferOutput(msg.Receiver, msg.Amount).ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "must define at least one of timeout height or timeout timestamp")
+	}
+	if len(msg.Memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "memo length %d exceeds the maximum of %d bytes", len(msg.Memo), MaximumMemoLength)
+	}
+	if !msg.TransferFee.IsEmpty() {
+		if err := msg.TransferFee.ValidateBasic(msg.Amount); err != nil {
+			return err
+		}
+	}
+	// only the amount left once the fee is paid is sent
+	if msg.MinReceived != (sdk.Coin{}) {
+		if err := ValidateMinReceived(msg.MinReceived, msg.TransferFee.NetAmount(msg.Amount)); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -73,3 +112,321 @@ func (msg MsgTransfer) GetSignBytes() []byte {
 func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+// TransferFee defines a fee taken from the amount of a MsgTransfer and paid
+// to the recipient on the sending chain.
+type TransferFee struct {
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`       // the tokens deducted from the transfer amount
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"` // the address the fee is paid to
+}
+
+// NewTransferFee creates a new TransferFee instance
+func NewTransferFee(amount sdk.Coin, recipient sdk.AccAddress) TransferFee {
+	return TransferFee{
+		Amount:    amount,
+		Recipient: recipient,
+	}
+}
+
+// IsEmpty returns true if no fee is defined
+func (fee TransferFee) IsEmpty() bool {
+	return fee.Amount == (sdk.Coin{}) && fee.Recipient.Empty()
+}
+
+// ValidateBasic checks that the fee is a valid positive coin paid to a
+// recipient and that it is less than the transfer amount of its denomination.
+func (fee TransferFee) ValidateBasic(amount sdk.Coins) error {
+	// a fee decoded without an amount holds a nil integer, which can't be
+	// compared
+	if fee.Amount.Amount == (sdk.Int{}) || !fee.Amount.IsValid() || fee.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidTransferFee, "fee must be a positive coin, got %s", fee.Amount)
+	}
+	if fee.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing fee recipient address")
+	}
+	if !fee.Amount.Amount.LT(amount.AmountOf(fee.Amount.Denom)) {
+		return sdkerrors.Wrapf(ErrInvalidTransferFee, "fee %s must be less than the transfer amount %s", fee.Amount, amount)
+	}
+	return nil
+}
+
+// NetAmount returns the amount left once the fee is deducted from it. The fee
+// must have been validated against the amount.
+func (fee TransferFee) NetAmount(amount sdk.Coins) sdk.Coins {
+	if fee.IsEmpty() {
+		return amount
+	}
+	return amount.Sub(sdk.NewCoins(fee.Amount))
+}
+
+// ValidateMinReceived checks that the minimum received amount of a transfer is a
+// valid positive coin that doesn't exceed the sent amount of its denomination.
+func ValidateMinReceived(minReceived sdk.Coin, amount sdk.Coins) error {
+	// a coin decoded without an amount holds a nil integer, which can't be
+	// compared
+	if minReceived.Amount == (sdk.Int{}) || !minReceived.IsValid() || minReceived.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidMinReceived, "minimum received amount must be a positive coin, got %s", minReceived)
+	}
+	if minReceived.Amount.GT(amount.AmountOf(minReceived.Denom)) {
+		return sdkerrors.Wrapf(ErrInvalidMinReceived, "minimum received amount %s exceeds the sent amount %s", minReceived, amount)
+	}
+	return nil
+}
+
+// TransferOutput defines the tokens transferred to a single receiver of a
+// MsgMultiTransfer.
+type TransferOutput struct {
+	Receiver string    `json:"receiver" yaml:"receiver"` // the recipient address on the destination chain
+	Amount   sdk.Coins `json:"amount" yaml:"amount"`     // the tokens to be transferred
+}
+
+// NewTransferOutput creates a new TransferOutput instance
+func NewTransferOutput(receiver string, amount sdk.Coins) TransferOutput {
+	return TransferOutput{
+		Receiver: receiver,
+		Amount:   amount,
+	}
+}
+
+// ValidateBasic performs a basic validation of the TransferOutput fields
+func (out TransferOutput) ValidateBasic() error {
+	if err := validateTransferAmount(out.Amount); err != nil {
+		return err
+	}
+	if len(out.Amount) > MaxTransferDenoms {
+		return sdkerrors.Wrapf(ErrTooManyDenoms, "transfer contains %d denominations, maximum allowed is %d", len(out.Amount), MaxTransferDenoms)
+	}
+	if out.Receiver == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(out.Receiver) > MaximumReceiverLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "recipient address length %d exceeds the maximum of %d bytes", len(out.Receiver), MaximumReceiverLength)
+	}
+	return nil
+}
+
+// validateTransferAmount checks that the amount isn't empty and that each of its
+// coins has a denomination and a positive amount. The error names the first
+// invalid coin.
+func validateTransferAmount(amount sdk.Coins) error {
+	if len(amount) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "transfer amount cannot be empty")
+	}
+	for _, coin := range amount {
+		if strings.TrimSpace(coin.Denom) == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coin with amount %s has an empty denomination", coin.Amount)
+		}
+		if !coin.Amount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount of %s must be positive, got %s", coin.Denom, coin.Amount)
+		}
+	}
+	if !amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid transfer amount %s", amount)
+	}
+	return nil
+}
+
+// MsgMultiTransfer defines a msg to transfer fungible tokens from a single
+// sender to multiple receivers through the same channel. A packet is sent for
+// each of the outputs.
+type MsgMultiTransfer struct {
+	SourcePort       string           `json:"source_port" yaml:"source_port"`             // the port on which the packets will be sent
+	SourceChannel    string           `json:"source_channel" yaml:"source_channel"`       // the channel by which the packets will be sent
+	Sender           sdk.AccAddress   `json:"sender" yaml:"sender"`                       // the sender address
+	Outputs          []TransferOutput `json:"outputs" yaml:"outputs"`                     // the receivers and the tokens transferred to each of them
+	TimeoutHeight    ibctypes.Height  `json:"timeout_height" yaml:"timeout_height"`       // the block height of the destination chain after which the packets time out
+	TimeoutTimestamp uint64           `json:"timeout_timestamp" yaml:"timeout_timestamp"` // the block time (in nanoseconds) of the destination chain after which the packets time out
+	Memo             string           `json:"memo" yaml:"memo"`                           // optional application-layer metadata relayed with each of the packets data
+}
+
+// NewMsgMultiTransfer creates a new MsgMultiTransfer instance
+func NewMsgMultiTransfer(
+	sourcePort, sourceChannel string, sender sdk.AccAddress, outputs []TransferOutput,
+	timeoutHeight ibctypes.Height, timeoutTimestamp uint64, memo string,
+) MsgMultiTransfer {
+	return MsgMultiTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Sender:           sender,
+		Outputs:          outputs,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgMultiTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgMultiTransfer) Type() string {
+	return "multi_transfer"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgMultiTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if len(msg.Outputs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "transfer outputs cannot be empty")
+	}
+
+	seenReceivers := make(map[string]bool)
+	for i, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid output %d", i)
+		}
+		if seenReceivers[out.Receiver] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated receiver %s", out.Receiver)
+		}
+		seenReceivers[out.Receiver] = true
+	}
+
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "must define at least one of timeout height or timeout timestamp")
+	}
+	if len(msg.Memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "memo length %d exceeds the maximum of %d bytes", len(msg.Memo), MaximumMemoLength)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgMultiTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgMultiTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetTotalAmount returns the sum of the tokens transferred to all the receivers
+func (msg MsgMultiTransfer) GetTotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, out := range msg.Outputs {
+		total = total.Add(out.Amount...)
+	}
+	return total
+}
+
+// MsgCancelTransfer defines a msg to cancel a transfer sent through a channel
+// that wasn't received on the destination chain. A proof that the packet wasn't
+// received at some height doesn't prevent it from being received afterwards, so
+// the packet must also have timed out at the proof height: the cancellation is
+// otherwise rejected, as the tokens could be refunded and received at once.
+type MsgCancelTransfer struct {
+	Packet           channel.Packet           `json:"packet" yaml:"packet"`                         // the packet of the transfer to cancel
+	Proof            commitmentexported.Proof `json:"proof" yaml:"proof"`                           // the proof that the packet wasn't received on the destination chain
+	ProofHeight      uint64                   `json:"proof_height" yaml:"proof_height"`             // the height of the destination chain the proof was queried at
+	NextSequenceRecv uint64                   `json:"next_sequence_recv" yaml:"next_sequence_recv"` // the next sequence received on the destination channel end, for ordered channels
+	Sender           sdk.AccAddress           `json:"sender" yaml:"sender"`                         // the sender of the transfer
+}
+
+// NewMsgCancelTransfer creates a new MsgCancelTransfer instance
+func NewMsgCancelTransfer(
+	packet channel.Packet, proof commitmentexported.Proof, proofHeight, nextSequenceRecv uint64, sender sdk.AccAddress,
+) MsgCancelTransfer {
+	return MsgCancelTransfer{
+		Packet:           packet,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		NextSequenceRecv: nextSequenceRecv,
+		Sender:           sender,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgCancelTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgCancelTransfer) Type() string {
+	return "cancel_transfer"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCancelTransfer) ValidateBasic() error {
+	if msg.Proof == nil {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+	}
+	if err := msg.Proof.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid proof of the packet absence")
+	}
+	if msg.ProofHeight == 0 {
+		return sdkerrors.Wrap(ibctypes.ErrInvalidHeight, "proof height must be > 0")
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	return msg.Packet.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCancelTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCancelTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgUpdateParams defines a msg to update all the parameters of the IBC
+// transfer module. It must be signed by the authority of the transfer keeper
+// (see the transfer keeper WithAuthority). The default authority is the
+// governance module account, which can't sign a msg: the parameters are then
+// updated with an UpdateParamsProposal instead.
+type MsgUpdateParams struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"` // the address allowed to update the parameters
+	Params    Params         `json:"params" yaml:"params"`       // the new parameters, replacing all the current ones
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgUpdateParams) Type() string {
+	return "update_params"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
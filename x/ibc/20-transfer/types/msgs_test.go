@@ -2,11 +2,17 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // define constants used for testing
@@ -30,18 +36,26 @@ var (
 	coins, _          = sdk.ParseCoins("100atom")
 	invalidDenomCoins = sdk.Coins{sdk.Coin{Denom: "ato-m", Amount: sdk.NewInt(100)}}
 	negativeCoins     = sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(100)}, sdk.Coin{Denom: "atoms", Amount: sdk.NewInt(-100)}}
+	multiDenomCoins   = sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 100))
+
+	timeoutHeight = ibctypes.NewHeight(0, 10)
+
+	longMemo     = strings.Repeat("m", MaximumMemoLength+1)
+	longReceiver = strings.Repeat("r", MaximumReceiverLength+1)
+	// receiver address of a chain not using bech32 (i.e an Ethereum address)
+	hexReceiver = "0x71C7656EC7ab88b098defB751B7401B5f6d8976F"
 )
 
 // TestMsgTransferRoute tests Route for MsgTransfer
 func TestMsgTransferRoute(t *testing.T) {
-	msg := NewMsgTransfer(validPort, validChannel, 10, coins, addr1, addr2)
+	msg := NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "")
 
 	require.Equal(t, RouterKey, msg.Route())
 }
 
 // TestMsgTransferType tests Type for MsgTransfer
 func TestMsgTransferType(t *testing.T) {
-	msg := NewMsgTransfer(validPort, validChannel, 10, coins, addr1, addr2)
+	msg := NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "")
 
 	require.Equal(t, "transfer", msg.Type())
 }
@@ -49,18 +63,26 @@ func TestMsgTransferType(t *testing.T) {
 // TestMsgTransferValidation tests ValidateBasic for MsgTransfer
 func TestMsgTransferValidation(t *testing.T) {
 	testMsgs := []MsgTransfer{
-		NewMsgTransfer(validPort, validChannel, 10, coins, addr1, addr2),             // valid msg
-		NewMsgTransfer(invalidShortPort, validChannel, 10, coins, addr1, addr2),      // too short port id
-		NewMsgTransfer(invalidLongPort, validChannel, 10, coins, addr1, addr2),       // too long port id
-		NewMsgTransfer(invalidPort, validChannel, 10, coins, addr1, addr2),           // port id contains non-alpha
-		NewMsgTransfer(validPort, invalidShortChannel, 10, coins, addr1, addr2),      // too short channel id
-		NewMsgTransfer(validPort, invalidLongChannel, 10, coins, addr1, addr2),       // too long channel id
-		NewMsgTransfer(validPort, invalidChannel, 10, coins, addr1, addr2),           // channel id contains non-alpha
-		NewMsgTransfer(validPort, validChannel, 10, invalidDenomCoins, addr1, addr2), // invalid amount
-		NewMsgTransfer(validPort, validChannel, 10, negativeCoins, addr1, addr2),     // amount contains negative coin
-		NewMsgTransfer(validPort, validChannel, 10, coins, emptyAddr, addr2),         // missing sender address
-		NewMsgTransfer(validPort, validChannel, 10, coins, addr1, ""),                // missing recipient address
-		NewMsgTransfer(validPort, validChannel, 10, sdk.Coins{}, addr1, addr2),       // not possitive coin
+		NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, ""),             // valid msg
+		NewMsgTransfer(invalidShortPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, ""),      // too short port id
+		NewMsgTransfer(invalidLongPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, ""),       // too long port id
+		NewMsgTransfer(invalidPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, ""),           // port id contains non-alpha
+		NewMsgTransfer(validPort, invalidShortChannel, coins, addr1, addr2, timeoutHeight, 0, ""),      // too short channel id
+		NewMsgTransfer(validPort, invalidLongChannel, coins, addr1, addr2, timeoutHeight, 0, ""),       // too long channel id
+		NewMsgTransfer(validPort, invalidChannel, coins, addr1, addr2, timeoutHeight, 0, ""),           // channel id contains non-alpha
+		NewMsgTransfer(validPort, validChannel, invalidDenomCoins, addr1, addr2, timeoutHeight, 0, ""), // invalid amount
+		NewMsgTransfer(validPort, validChannel, negativeCoins, addr1, addr2, timeoutHeight, 0, ""),     // amount contains negative coin
+		NewMsgTransfer(validPort, validChannel, coins, emptyAddr, addr2, timeoutHeight, 0, ""),         // missing sender address
+		NewMsgTransfer(validPort, validChannel, coins, addr1, "", timeoutHeight, 0, ""),                // missing recipient address
+		NewMsgTransfer(validPort, validChannel, sdk.Coins{}, addr1, addr2, timeoutHeight, 0, ""),       // not possitive coin
+		NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, ibctypes.Height{}, 0, ""),         // zero timeout height and timestamp
+		NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, ibctypes.Height{}, 100, ""),       // only timeout timestamp
+		NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 100, ""),           // timeout height and timestamp
+		NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "memo"),         // valid memo
+		NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, longMemo),       // memo too long
+		NewMsgTransfer(validPort, validChannel, multiDenomCoins, addr1, addr2, timeoutHeight, 0, ""),   // too many denominations
+		NewMsgTransfer(validPort, validChannel, coins, addr1, hexReceiver, timeoutHeight, 0, ""),       // non-bech32 recipient address
+		NewMsgTransfer(validPort, validChannel, coins, addr1, longReceiver, timeoutHeight, 0, ""),      // recipient address too long
 	}
 
 	testCases := []struct {
@@ -79,6 +101,15 @@ func TestMsgTransferValidation(t *testing.T) {
 		{testMsgs[8], false, "amount contains negative coin"},
 		{testMsgs[9], false, "missing sender address"},
 		{testMsgs[10], false, "missing recipient address"},
+		{testMsgs[11], false, "not possitive coin"},
+		{testMsgs[12], false, "zero timeout height and timestamp"},
+		{testMsgs[13], true, "only timeout timestamp"},
+		{testMsgs[14], true, "timeout height and timestamp"},
+		{testMsgs[15], true, "valid memo"},
+		{testMsgs[16], false, "memo too long"},
+		{testMsgs[17], false, "too many denominations"},
+		{testMsgs[18], true, "non-bech32 recipient address"},
+		{testMsgs[19], false, "recipient address too long"},
 	}
 
 	for i, tc := range testCases {
@@ -91,20 +122,244 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// TestMsgTransferMaxDenoms tests that the maximum number of denominations
+// can be raised deliberately
+func TestMsgTransferMaxDenoms(t *testing.T) {
+	defer func(maxDenoms int) { MaxTransferDenoms = maxDenoms }(MaxTransferDenoms)
+
+	msg := NewMsgTransfer(validPort, validChannel, multiDenomCoins, addr1, addr2, timeoutHeight, 0, "")
+	require.Error(t, msg.ValidateBasic())
+
+	MaxTransferDenoms = 2
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// TestMsgTransferAmountValidation tests that the invalid coins of the amount are
+// named by the error
+func TestMsgTransferAmountValidation(t *testing.T) {
+	defer func(maxDenoms int) { MaxTransferDenoms = maxDenoms }(MaxTransferDenoms)
+	MaxTransferDenoms = 3
+
+	testCases := []struct {
+		name     string
+		amount   sdk.Coins
+		expError string
+	}{
+		{"positive coins", sdk.Coins{sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 1)}, ""},
+		{"zero coin among positive coins", sdk.Coins{sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 0)}, "amount of stake must be positive"},
+		{"negative coin among positive coins", sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}, sdk.NewInt64Coin("stake", 1)}, "amount of atom must be positive"},
+		{"empty denomination", sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.NewInt(100)}}, "empty denomination"},
+		{"blank denomination", sdk.Coins{sdk.NewInt64Coin("atom", 100), sdk.Coin{Denom: " ", Amount: sdk.NewInt(100)}}, "empty denomination"},
+		{"empty amount", sdk.Coins{}, "transfer amount cannot be empty"},
+		{"unsorted coins", sdk.Coins{sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("atom", 100)}, "invalid transfer amount"},
+	}
+
+	for _, tc := range testCases {
+		err := NewMsgTransfer(validPort, validChannel, tc.amount, addr1, addr2, timeoutHeight, 0, "").ValidateBasic()
+		if tc.expError == "" {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Contains(t, err.Error(), tc.expError, tc.name)
+		}
+	}
+}
+
+// TestMsgTransferFeeValidation tests the validation of the optional transfer
+// fee of MsgTransfer
+func TestMsgTransferFeeValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fee     TransferFee
+		expPass bool
+	}{
+		{"no fee", TransferFee{}, true},
+		{"fee less than the amount", NewTransferFee(sdk.NewInt64Coin("atom", 99), addr1), true},
+		{"fee equal to the amount", NewTransferFee(sdk.NewInt64Coin("atom", 100), addr1), false},
+		{"fee greater than the amount", NewTransferFee(sdk.NewInt64Coin("atom", 101), addr1), false},
+		{"fee of another denomination", NewTransferFee(sdk.NewInt64Coin("stake", 1), addr1), false},
+		{"zero fee", NewTransferFee(sdk.NewInt64Coin("atom", 0), addr1), false},
+		{"fee without amount", TransferFee{Amount: sdk.Coin{Denom: "atom"}, Recipient: addr1}, false},
+		{"missing fee recipient", NewTransferFee(sdk.NewInt64Coin("atom", 1), emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		msg := NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "")
+		msg.TransferFee = tc.fee
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 90)), NewTransferFee(sdk.NewInt64Coin("atom", 10), addr1).NetAmount(coins))
+	require.Equal(t, coins, TransferFee{}.NetAmount(coins))
+}
+
+// TestMsgTransferMinReceivedValidation tests the validation of the optional
+// minimum received amount of MsgTransfer
+func TestMsgTransferMinReceivedValidation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		minReceived sdk.Coin
+		fee         TransferFee
+		expPass     bool
+	}{
+		{"no minimum", sdk.Coin{}, TransferFee{}, true},
+		{"minimum less than the amount", sdk.NewInt64Coin("atom", 90), TransferFee{}, true},
+		{"minimum equal to the amount", sdk.NewInt64Coin("atom", 100), TransferFee{}, true},
+		{"minimum greater than the amount", sdk.NewInt64Coin("atom", 101), TransferFee{}, false},
+		{"minimum of another denomination", sdk.NewInt64Coin("stake", 1), TransferFee{}, false},
+		{"zero minimum", sdk.NewInt64Coin("atom", 0), TransferFee{}, false},
+		{"minimum without amount", sdk.Coin{Denom: "atom"}, TransferFee{}, false},
+		{"minimum equal to the net amount", sdk.NewInt64Coin("atom", 90), NewTransferFee(sdk.NewInt64Coin("atom", 10), addr1), true},
+		{"minimum greater than the net amount", sdk.NewInt64Coin("atom", 91), NewTransferFee(sdk.NewInt64Coin("atom", 10), addr1), false},
+	}
+
+	for _, tc := range testCases {
+		msg := NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "")
+		msg.MinReceived = tc.minReceived
+		msg.TransferFee = tc.fee
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// TestMsgTransferCustomIdentifierValidator tests that the identifiers are
+// checked by the installed host validators
+func TestMsgTransferCustomIdentifierValidator(t *testing.T) {
+	defer func(validator host.ValidateFn) { host.ChannelIdentifierValidator = validator }(host.ChannelIdentifierValidator)
+
+	msg := NewMsgTransfer(validPort, "channel-0", coins, addr1, addr2, timeoutHeight, 0, "")
+	require.Error(t, msg.ValidateBasic())
+
+	host.ChannelIdentifierValidator = host.NewIdentifierValidator(2, 64, func(id string) bool {
+		return !strings.ContainsAny(id, " /")
+	})
+	require.NoError(t, msg.ValidateBasic())
+}
+
 // TestMsgTransferGetSignBytes tests GetSignBytes for MsgTransfer
 func TestMsgTransferGetSignBytes(t *testing.T) {
-	msg := NewMsgTransfer(validPort, validChannel, 10, coins, addr1, addr2)
+	msg := NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "memo")
 	res := msg.GetSignBytes()
 
-	expected := `{"type":"ibc/transfer/MsgTransfer","value":{"amount":[{"amount":"100","denom":"atom"}],"dest_height":"10","receiver":"cosmos1w3jhxarpv3j8yvs7f9y7g","sender":"cosmos1w3jhxarpv3j8yvg4ufs4x","source_channel":"testchannel","source_port":"testportid"}}`
+	expected := `{"type":"ibc/transfer/MsgTransfer","value":{"amount":[{"amount":"100","denom":"atom"}],"memo":"memo","receiver":"cosmos1w3jhxarpv3j8yvs7f9y7g","sender":"cosmos1w3jhxarpv3j8yvg4ufs4x","source_channel":"testchannel","source_port":"testportid","timeout_height":"10","timeout_timestamp":"0"}}`
 	require.Equal(t, expected, string(res))
+
+	// the revision number is only encoded when the timeout height has one
+	msg.TimeoutHeight = ibctypes.NewHeight(2, 10)
+	require.Contains(t, string(msg.GetSignBytes()), `"timeout_height":"2-10"`)
 }
 
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
-	msg := NewMsgTransfer(validPort, validChannel, 10, coins, addr1, addr2)
+	msg := NewMsgTransfer(validPort, validChannel, coins, addr1, addr2, timeoutHeight, 0, "")
 	res := msg.GetSigners()
 
 	expected := "[746573746164647231]"
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
 }
+
+// TestMsgMultiTransferValidation tests ValidateBasic for MsgMultiTransfer
+func TestMsgMultiTransferValidation(t *testing.T) {
+	addr3 := sdk.AccAddress("testaddr3").String()
+	outputs := []TransferOutput{NewTransferOutput(addr2, coins), NewTransferOutput(addr3, coins)}
+
+	testCases := []struct {
+		msg     MsgMultiTransfer
+		expPass bool
+		errMsg  string
+	}{
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, outputs, timeoutHeight, 0, ""), true, ""},
+		{NewMsgMultiTransfer(invalidPort, validChannel, addr1, outputs, timeoutHeight, 0, ""), false, "port id contains non-alpha"},
+		{NewMsgMultiTransfer(validPort, invalidChannel, addr1, outputs, timeoutHeight, 0, ""), false, "channel id contains non-alpha"},
+		{NewMsgMultiTransfer(validPort, validChannel, emptyAddr, outputs, timeoutHeight, 0, ""), false, "missing sender address"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, nil, timeoutHeight, 0, ""), false, "empty outputs"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, []TransferOutput{NewTransferOutput(addr2, coins), NewTransferOutput(addr2, coins)}, timeoutHeight, 0, ""), false, "duplicated receiver"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, []TransferOutput{NewTransferOutput(addr2, coins), NewTransferOutput("", coins)}, timeoutHeight, 0, ""), false, "missing recipient address"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, []TransferOutput{NewTransferOutput(addr2, negativeCoins)}, timeoutHeight, 0, ""), false, "amount contains negative coin"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, []TransferOutput{NewTransferOutput(addr2, multiDenomCoins)}, timeoutHeight, 0, ""), false, "too many denominations"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, []TransferOutput{NewTransferOutput(longReceiver, coins)}, timeoutHeight, 0, ""), false, "recipient address too long"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, outputs, ibctypes.Height{}, 0, ""), false, "zero timeout height and timestamp"},
+		{NewMsgMultiTransfer(validPort, validChannel, addr1, outputs, timeoutHeight, 0, longMemo), false, "memo too long"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgMultiTransferGetTotalAmount tests GetTotalAmount for MsgMultiTransfer
+func TestMsgMultiTransferGetTotalAmount(t *testing.T) {
+	outputs := []TransferOutput{
+		NewTransferOutput(addr2, coins),
+		NewTransferOutput(hexReceiver, sdk.NewCoins(sdk.NewInt64Coin("atom", 50))),
+	}
+	msg := NewMsgMultiTransfer(validPort, validChannel, addr1, outputs, timeoutHeight, 0, "")
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), msg.GetTotalAmount())
+}
+
+// TestMsgCancelTransferValidation tests ValidateBasic for MsgCancelTransfer
+func TestMsgCancelTransferValidation(t *testing.T) {
+	data := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")
+	packet := channel.NewPacket(data.GetBytes(), 1, validPort, validChannel, validPort, validChannel, 100, 0)
+	proof := commitmenttypes.MerkleProof{Proof: &merkle.Proof{}}
+
+	testCases := []struct {
+		name    string
+		msg     MsgCancelTransfer
+		expPass bool
+	}{
+		{"valid msg", NewMsgCancelTransfer(packet, proof, 1, 0, addr1), true},
+		{"missing proof", NewMsgCancelTransfer(packet, nil, 1, 0, addr1), false},
+		{"empty proof", NewMsgCancelTransfer(packet, commitmenttypes.MerkleProof{}, 1, 0, addr1), false},
+		{"zero proof height", NewMsgCancelTransfer(packet, proof, 0, 0, addr1), false},
+		{"missing sender", NewMsgCancelTransfer(packet, proof, 1, 0, emptyAddr), false},
+		{"invalid packet", NewMsgCancelTransfer(channel.Packet{}, proof, 1, 0, addr1), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority")
+
+	testCases := []struct {
+		name    string
+		msg     MsgUpdateParams
+		expPass bool
+	}{
+		{"valid msg", NewMsgUpdateParams(authority, DefaultParams()), true},
+		{"missing authority", NewMsgUpdateParams(nil, DefaultParams()), false},
+		{"invalid params", NewMsgUpdateParams(authority, NewParams(true, true, []DenomEnabled{NewDenomEnabled("(atom)", false)}, nil, false, 0, false, 0, 0)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
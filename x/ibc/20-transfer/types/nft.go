@@ -0,0 +1,181 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// ValidateClassID performs a basic validation of a non-fungible token class
+// identifier. As with the fungible token denominations, the class identifiers
+// of the vouchers are prefixed with the {portID}/{channelID} pairs they were
+// relayed through.
+func ValidateClassID(classID string) error {
+	if strings.ContainsAny(classID, " \t\n") {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "class ID %s cannot contain whitespaces", classID)
+	}
+	if err := ParseDenomTrace(classID).Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "class ID %s: %s", classID, err.Error())
+	}
+	return nil
+}
+
+// validateTokenIDs checks that the token IDs are non-empty and not repeated
+func validateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "token IDs cannot be empty")
+	}
+
+	seenTokenIDs := make(map[string]bool)
+	for i, tokenID := range tokenIDs {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "token ID at position %d cannot be blank", i)
+		}
+		if seenTokenIDs[tokenID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated token ID %s", tokenID)
+		}
+		seenTokenIDs[tokenID] = true
+	}
+	return nil
+}
+
+// MsgTransferNFT defines a msg to transfer non-fungible tokens of a single class
+// between chains through the transfer channels.
+type MsgTransferNFT struct {
+	SourcePort       string         `json:"source_port" yaml:"source_port"`             // the port on which the packet will be sent
+	SourceChannel    string         `json:"source_channel" yaml:"source_channel"`       // the channel by which the packet will be sent
+	ClassID          string         `json:"class_id" yaml:"class_id"`                   // the class of the tokens to be transferred
+	TokenIDs         []string       `json:"token_ids" yaml:"token_ids"`                 // the identifiers of the tokens to be transferred
+	Sender           sdk.AccAddress `json:"sender" yaml:"sender"`                       // the sender address
+	Receiver         string         `json:"receiver" yaml:"receiver"`                   // the recipient address on the destination chain
+	TimeoutHeight    uint64         `json:"timeout_height" yaml:"timeout_height"`       // the block height of the destination chain after which the packet times out
+	TimeoutTimestamp uint64         `json:"timeout_timestamp" yaml:"timeout_timestamp"` // the block time (in nanoseconds) of the destination chain after which the packet times out
+}
+
+// NewMsgTransferNFT creates a new MsgTransferNFT instance
+func NewMsgTransferNFT(
+	sourcePort, sourceChannel, classID string, tokenIDs []string, sender sdk.AccAddress, receiver string,
+	timeoutHeight, timeoutTimestamp uint64,
+) MsgTransferNFT {
+	return MsgTransferNFT{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassID:          classID,
+		TokenIDs:         tokenIDs,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgTransferNFT) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgTransferNFT) Type() string {
+	return "transfer_nft"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferNFT) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if err := ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+	if err := validateTokenIDs(msg.TokenIDs); err != nil {
+		return err
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if msg.Receiver == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(msg.Receiver) > MaximumReceiverLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "recipient address length %d exceeds the maximum of %d bytes", len(msg.Receiver), MaximumReceiverLength)
+	}
+	if msg.TimeoutHeight == 0 && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "must define at least one of timeout height or timeout timestamp")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgTransferNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NonFungibleTokenPacketData defines a struct for the non-fungible token
+// transfer packet payload. It is relayed through the same channels as the
+// fungible token packets.
+type NonFungibleTokenPacketData struct {
+	ClassID  string   `json:"class_id" yaml:"class_id"`   // the class of the tokens to be transferred, with its full trace path
+	TokenIDs []string `json:"token_ids" yaml:"token_ids"` // the identifiers of the tokens to be transferred
+	Sender   string   `json:"sender" yaml:"sender"`       // the sender address
+	Receiver string   `json:"receiver" yaml:"receiver"`   // the recipient address on the destination chain
+}
+
+// NewNonFungibleTokenPacketData contructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(classID string, tokenIDs []string, sender, receiver string) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassID:  classID,
+		TokenIDs: tokenIDs,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// String returns a string representation of NonFungibleTokenPacketData
+func (nftpd NonFungibleTokenPacketData) String() string {
+	return fmt.Sprintf(`NonFungibleTokenPacketData:
+	ClassID:              %s
+	TokenIDs:             %s
+	Sender:               %s
+	Receiver:             %s`,
+		nftpd.ClassID,
+		strings.Join(nftpd.TokenIDs, ","),
+		nftpd.Sender,
+		nftpd.Receiver,
+	)
+}
+
+// ValidateBasic is used for validating the non-fungible token transfer
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if err := ValidateClassID(nftpd.ClassID); err != nil {
+		return err
+	}
+	if err := validateTokenIDs(nftpd.TokenIDs); err != nil {
+		return err
+	}
+	if nftpd.Sender == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if nftpd.Receiver == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
+	}
+	if len(nftpd.Receiver) > MaximumReceiverLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "receiver address length %d exceeds the maximum of %d bytes", len(nftpd.Receiver), MaximumReceiverLength)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(nftpd))
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateClassID(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		classID string
+		expPass bool
+	}{
+		{"native class", "kitties", true},
+		{"voucher class", "testportid/testchannel/kitties", true},
+		{"empty class", "", false},
+		{"whitespaces", "crypto kitties", false},
+		{"incomplete path", "testportid/kitties", false},
+		{"invalid channel identifier", "testportid/ch/kitties", false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateClassID(tc.classID)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+// TestMsgTransferNFTValidation tests ValidateBasic for MsgTransferNFT
+func TestMsgTransferNFTValidation(t *testing.T) {
+	tokenIDs := []string{"1", "2"}
+
+	testCases := []struct {
+		msg     MsgTransferNFT
+		expPass bool
+		errMsg  string
+	}{
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", tokenIDs, addr1, addr2, 10, 0), true, ""},
+		{NewMsgTransferNFT(invalidPort, validChannel, "kitties", tokenIDs, addr1, addr2, 10, 0), false, "port id contains non-alpha"},
+		{NewMsgTransferNFT(validPort, invalidChannel, "kitties", tokenIDs, addr1, addr2, 10, 0), false, "channel id contains non-alpha"},
+		{NewMsgTransferNFT(validPort, validChannel, "", tokenIDs, addr1, addr2, 10, 0), false, "empty class ID"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", nil, addr1, addr2, 10, 0), false, "empty token IDs"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", []string{"1", " "}, addr1, addr2, 10, 0), false, "blank token ID"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", []string{"1", "1"}, addr1, addr2, 10, 0), false, "duplicated token ID"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", tokenIDs, emptyAddr, addr2, 10, 0), false, "missing sender address"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", tokenIDs, addr1, "", 10, 0), false, "missing recipient address"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", tokenIDs, addr1, longReceiver, 10, 0), false, "recipient address too long"},
+		{NewMsgTransferNFT(validPort, validChannel, "kitties", tokenIDs, addr1, addr2, 0, 0), false, "zero timeout height and timestamp"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestNonFungibleTokenPacketDataDecoding tests that the non-fungible and
+// fungible token packet data cannot be decoded as each other.
+func TestNonFungibleTokenPacketDataDecoding(t *testing.T) {
+	nftData := NewNonFungibleTokenPacketData("kitties", []string{"1"}, addr1.String(), addr2)
+	ftData := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")
+
+	var decodedNFTData NonFungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(nftData.GetBytes(), &decodedNFTData))
+	require.Equal(t, nftData, decodedNFTData)
+	require.Error(t, ModuleCdc.UnmarshalJSON(ftData.GetBytes(), &decodedNFTData))
+
+	var decodedFTData FungibleTokenPacketData
+	require.Error(t, ModuleCdc.UnmarshalJSON(nftData.GetBytes(), &decodedFTData))
+}
@@ -2,26 +2,22 @@ package types
 
 import (
 	"fmt"
+	"unicode/utf8"
+
+	"github.com/tendermint/tendermint/libs/bech32"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// FungibleTokenPacketData defines a struct for the packet payload
-// See FungibleTokenPacketData spec: https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#data-structures
-type FungibleTokenPacketData struct {
-	Amount   sdk.Coins `json:"amount" yaml:"amount"`     // the tokens to be transferred
-	Sender   string    `json:"sender" yaml:"sender"`     // the sender address
-	Receiver string    `json:"receiver" yaml:"receiver"` // the recipient address on the destination chain
-}
-
 // NewFungibleTokenPacketData contructs a new FungibleTokenPacketData instance
 func NewFungibleTokenPacketData(
-	amount sdk.Coins, sender, receiver string) FungibleTokenPacketData {
+	amount sdk.Coins, sender, receiver, memo string) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -30,20 +26,61 @@ func (ftpd FungibleTokenPacketData) String() string {
 	return fmt.Sprintf(`FungibleTokenPacketData:
 	Amount:               %s
 	Sender:               %s
-	Receiver:             %s`,
+	Receiver:             %s
+	Memo:                 %s
+	RefundAddress:        %s
+	MinReceived:          %s`,
 		ftpd.Amount.String(),
 		ftpd.Sender,
 		ftpd.Receiver,
+		ftpd.Memo,
+		ftpd.RefundAddress,
+		ftpd.MinReceived,
 	)
 }
 
+// GetRefundAddress returns the address the tokens are refunded to if the
+// transfer fails, which defaults to the sender.
+func (ftpd FungibleTokenPacketData) GetRefundAddress() string {
+	if ftpd.RefundAddress != "" {
+		return ftpd.RefundAddress
+	}
+	return ftpd.Sender
+}
+
+// HasMinReceived returns true if a minimum received amount is set.
+func (ftpd FungibleTokenPacketData) HasMinReceived() bool {
+	return ftpd.MinReceived != nil
+}
+
+// PacketMinReceived returns the minimum received amount of a transfer with the
+// denomination it is relayed with on the packet data. The amount holds the sent
+// coins with their denomination on the sending chain, and the packet amount the
+// same coins, in the same order, with their full denomination trace path. It
+// returns false if the denomination of the minimum isn't part of the amount.
+func PacketMinReceived(minReceived sdk.Coin, amount, packetAmount sdk.Coins) (sdk.Coin, bool) {
+	for i, coin := range amount {
+		if coin.Denom == minReceived.Denom && i < len(packetAmount) {
+			return sdk.Coin{Denom: packetAmount[i].Denom, Amount: minReceived.Amount}, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
 // ValidateBasic is used for validating the token transfer
 func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if !ftpd.Amount.IsAllPositive() {
 		return sdkerrors.ErrInsufficientFunds
 	}
-	if !ftpd.Amount.IsValid() {
-		return sdkerrors.ErrInvalidCoins
+	// the denominations are the full trace paths, which can be longer than the
+	// coin denominations of this chain
+	for i, coin := range ftpd.Amount {
+		if err := ValidatePrefixedDenom(coin.Denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		if i > 0 && ftpd.Amount[i-1].Denom >= coin.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denominations must be sorted and unique, got %s", ftpd.Amount)
+		}
 	}
 	if ftpd.Sender == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
@@ -51,23 +88,98 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if ftpd.Receiver == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
 	}
+	if len(ftpd.Receiver) > MaximumReceiverLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "receiver address length %d exceeds the maximum of %d bytes", len(ftpd.Receiver), MaximumReceiverLength)
+	}
+	if len(ftpd.Memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "memo length %d exceeds the maximum of %d bytes", len(ftpd.Memo), MaximumMemoLength)
+	}
+	// the refund address is an account of the sending chain, so only its bech32
+	// encoding can be checked on the receiving chain
+	if ftpd.RefundAddress != "" {
+		if _, _, err := bech32.DecodeAndConvert(ftpd.RefundAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund address %s: %s", ftpd.RefundAddress, err.Error())
+		}
+	}
+	// the minimum is checked against the amount credited to the receiver, which
+	// can be less than the amount sent (see the transfer keeper OnRecvPacket)
+	if ftpd.HasMinReceived() {
+		if ftpd.MinReceived.Amount == (sdk.Int{}) || ValidatePrefixedDenom(ftpd.MinReceived.Denom) != nil || !ftpd.MinReceived.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidMinReceived, "minimum received amount must be a positive coin, got %s", ftpd.MinReceived)
+		}
+		if !ftpd.isTransferred(ftpd.MinReceived.Denom) {
+			return sdkerrors.Wrapf(ErrInvalidMinReceived, "denomination %s is not transferred", ftpd.MinReceived.Denom)
+		}
+	}
 	return nil
 }
 
+// isTransferred returns true if the packet amount contains the given denomination.
+// NOTE: sdk.Coins.AmountOf can't be used, as it panics on the trace paths that
+// aren't valid coin denominations.
+func (ftpd FungibleTokenPacketData) isTransferred(denom string) bool {
+	for _, coin := range ftpd.Amount {
+		if coin.Denom == denom {
+			return true
+		}
+	}
+	return false
+}
+
 // GetBytes is a helper for serialising
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(ftpd))
 }
 
+// GetPacketData decodes the packet data bytes returned by GetBytes into a
+// FungibleTokenPacketData.
+func GetPacketData(bz []byte) (FungibleTokenPacketData, error) {
+	var data FungibleTokenPacketData
+	if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return FungibleTokenPacketData{}, sdkerrors.Wrapf(ErrInvalidPacketData, "cannot unmarshal ICS-20 transfer packet data: %v", err)
+	}
+	return data, nil
+}
+
 // FungibleTokenPacketAcknowledgement contains a boolean success flag and an optional error msg
 // error msg is empty string on success
 // See spec for onAcknowledgePacket: https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#packet-relay
+//
+// The relayer is the address of the relayer that submitted the packet to the
+// receiving chain, which is paid the recv fee of the packet (see PacketFee).
 type FungibleTokenPacketAcknowledgement struct {
 	Success bool   `json:"success" yaml:"success"`
 	Error   string `json:"error" yaml:"error"`
+	Relayer string `json:"relayer,omitempty" yaml:"relayer,omitempty"`
+}
+
+// NewErrorAcknowledgement returns the acknowledgement of a packet that failed to
+// be received with the given error. The error message is truncated to
+// MaximumAcknowledgementErrorLength bytes.
+func NewErrorAcknowledgement(err error) FungibleTokenPacketAcknowledgement {
+	msg := err.Error()
+	for len(msg) > MaximumAcknowledgementErrorLength {
+		_, size := utf8.DecodeLastRuneInString(msg)
+		msg = msg[:len(msg)-size]
+	}
+
+	return FungibleTokenPacketAcknowledgement{
+		Success: false,
+		Error:   msg,
+	}
 }
 
 // GetBytes is a helper for serialising
 func (ack FungibleTokenPacketAcknowledgement) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(ack))
 }
+
+// GetAcknowledgement decodes the acknowledgement bytes written by the receiving
+// chain into a FungibleTokenPacketAcknowledgement.
+func GetAcknowledgement(bz []byte) (FungibleTokenPacketAcknowledgement, error) {
+	var ack FungibleTokenPacketAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return FungibleTokenPacketAcknowledgement{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	return ack, nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PacketState defines the lifecycle state of a transfer packet.
+type PacketState string
+
+// available packet lifecycle states
+const (
+	PacketStateUnknown  PacketState = "unknown"  // the packet isn't in flight and wasn't received
+	PacketStateSent     PacketState = "sent"     // the packet is in flight
+	PacketStateReceived PacketState = "received" // the packet was received on its destination channel end
+)
+
+// SentCoins defines the coins taken from the sender of a packet, with the
+// denomination they are held with on this chain, and whether they were escrowed
+// or burned. The refund of the packet returns these exact coins, so that it
+// doesn't depend on the denomination traces stored when it's refunded. It is
+// stored until the packet is acknowledged or timed out.
+type SentCoins struct {
+	Coins    sdk.Coins `json:"coins" yaml:"coins"`
+	Escrowed bool      `json:"escrowed" yaml:"escrowed"` // false if the coins were burned
+}
+
+// NewSentCoins creates a new SentCoins instance
+func NewSentCoins(coins sdk.Coins, escrowed bool) SentCoins {
+	return SentCoins{
+		Coins:    coins,
+		Escrowed: escrowed,
+	}
+}
@@ -1,19 +1,46 @@
 package types
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	longDenomPath    = "transfer/channelidone/transfer/channelidtwo/transfer/channelidthree/uatom"
+	tooLongDenomPath = strings.Repeat("transfer/channelidone/", 6) + "uatom"
 )
 
 // TestFungibleTokenPacketDataValidateBasic tests ValidateBasic for FungibleTokenPacketData
 func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 	testPacketDataTransfer := []FungibleTokenPacketData{
-		NewFungibleTokenPacketData(coins, addr1.String(), addr2),              // valid msg
-		NewFungibleTokenPacketData(invalidDenomCoins, addr1.String(), addr2),  // invalid amount
-		NewFungibleTokenPacketData(negativeCoins, addr1.String(), addr2),      // amount contains negative coin
-		NewFungibleTokenPacketData(coins, emptyAddr.String(), addr2),          // missing sender address
-		NewFungibleTokenPacketData(coins, addr1.String(), emptyAddr.String()), // missing recipient address
+		NewFungibleTokenPacketData(coins, addr1.String(), addr2, ""),              // valid msg
+		NewFungibleTokenPacketData(invalidDenomCoins, addr1.String(), addr2, ""),  // invalid amount
+		NewFungibleTokenPacketData(negativeCoins, addr1.String(), addr2, ""),      // amount contains negative coin
+		NewFungibleTokenPacketData(coins, emptyAddr.String(), addr2, ""),          // missing sender address
+		NewFungibleTokenPacketData(coins, addr1.String(), emptyAddr.String(), ""), // missing recipient address
+		NewFungibleTokenPacketData(coins, addr1.String(), addr2, "memo"),          // valid memo
+		NewFungibleTokenPacketData(coins, addr1.String(), addr2, longMemo),        // memo too long
+		NewFungibleTokenPacketData(coins, addr1.String(), hexReceiver, ""),        // non-bech32 recipient address
+		NewFungibleTokenPacketData(coins, addr1.String(), longReceiver, ""),       // recipient address too long
+
+		withRefundAddress(addr1.String()), // valid refund address
+		withRefundAddress(hexReceiver),    // non-bech32 refund address
+
+		withMinReceived(sdk.NewInt64Coin("atom", 100)),  // valid minimum received amount
+		withMinReceived(sdk.NewInt64Coin("atom", 0)),    // zero minimum received amount
+		withMinReceived(sdk.NewInt64Coin("stake", 1)),   // minimum received amount of another denomination
+		withMinReceived(sdk.Coin{Denom: "atom"}),        // minimum received amount without amount
+		withMinReceived(sdk.NewInt64Coin("atom", 1000)), // minimum received amount greater than the amount
+
+		NewFungibleTokenPacketData(sdk.Coins{sdk.Coin{Denom: longDenomPath, Amount: sdk.NewInt(100)}}, addr1.String(), addr2, ""),    // trace path longer than a coin denomination
+		NewFungibleTokenPacketData(sdk.Coins{sdk.Coin{Denom: tooLongDenomPath, Amount: sdk.NewInt(100)}}, addr1.String(), addr2, ""), // trace path too long
+		NewFungibleTokenPacketData(sdk.Coins{sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("atom", 1)}, addr1.String(), addr2, ""),  // unsorted amount
 	}
 
 	testCases := []struct {
@@ -26,6 +53,20 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		{testPacketDataTransfer[2], false, "amount contains negative coin"},
 		{testPacketDataTransfer[3], false, "missing sender address"},
 		{testPacketDataTransfer[4], false, "missing recipient address"},
+		{testPacketDataTransfer[5], true, "valid memo"},
+		{testPacketDataTransfer[6], false, "memo too long"},
+		{testPacketDataTransfer[7], true, "non-bech32 recipient address"},
+		{testPacketDataTransfer[8], false, "recipient address too long"},
+		{testPacketDataTransfer[9], true, "valid refund address"},
+		{testPacketDataTransfer[10], false, "non-bech32 refund address"},
+		{testPacketDataTransfer[11], true, "valid minimum received amount"},
+		{testPacketDataTransfer[12], false, "zero minimum received amount"},
+		{testPacketDataTransfer[13], false, "minimum received amount of another denomination"},
+		{testPacketDataTransfer[14], false, "minimum received amount without amount"},
+		{testPacketDataTransfer[15], true, "minimum received amount greater than the amount is checked on receive"},
+		{testPacketDataTransfer[16], true, "trace path longer than a coin denomination"},
+		{testPacketDataTransfer[17], false, "trace path too long"},
+		{testPacketDataTransfer[18], false, "unsorted amount"},
 	}
 
 	for i, tc := range testCases {
@@ -37,3 +78,121 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestGetPacketData tests the encoding round trip of FungibleTokenPacketData
+func TestGetPacketData(t *testing.T) {
+	testCases := []struct {
+		msg  string
+		data FungibleTokenPacketData
+	}{
+		{"packet data", NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")},
+		{"packet data with memo", NewFungibleTokenPacketData(coins, addr1.String(), addr2, "memo")},
+
+		{"packet data with refund address", withRefundAddress(addr1.String())},
+		{"packet data with minimum received amount", withMinReceived(sdk.NewInt64Coin("atom", 90))},
+	}
+
+	for i, tc := range testCases {
+		bz := tc.data.GetBytes()
+		require.Equal(t, bz, tc.data.GetBytes(), "test case %d: %s: encoding is not deterministic", i, tc.msg)
+
+		data, err := GetPacketData(bz)
+		require.NoError(t, err, "valid test case %d failed: %s", i, tc.msg)
+		require.Equal(t, tc.data, data, "test case %d: %s", i, tc.msg)
+		require.Equal(t, bz, data.GetBytes(), "test case %d: %s", i, tc.msg)
+	}
+
+	_, err := GetPacketData([]byte("invalid packet data"))
+	require.Error(t, err)
+}
+
+// TestGetRefundAddress tests that the refund address defaults to the sender and
+// that the packet data bytes are unchanged when it isn't set.
+func TestGetRefundAddress(t *testing.T) {
+	data := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")
+	require.Equal(t, addr1.String(), data.GetRefundAddress())
+	require.NotContains(t, string(data.GetBytes()), "refund_address")
+
+	refundAddr := sdk.AccAddress([]byte("refundaddress")).String()
+	data.RefundAddress = refundAddr
+	require.Equal(t, refundAddr, data.GetRefundAddress())
+	require.Contains(t, string(data.GetBytes()), "refund_address")
+	require.NotContains(t, string(data.GetBytes()), "min_received")
+}
+
+func TestPacketMinReceived(t *testing.T) {
+	// the amount is sorted by local denomination, while the packet amount follows
+	// its order with the full trace paths
+	voucherDenom := ParseDenomTrace("transfer/channeltwo/btc").IBCDenom()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin(voucherDenom, 50))
+	packetAmount := sdk.Coins{
+		sdk.NewInt64Coin("transfer/channelone/atom", 100),
+		sdk.NewInt64Coin("transfer/channeltwo/btc", 50),
+	}
+
+	minReceived, found := PacketMinReceived(sdk.NewInt64Coin(voucherDenom, 40), amount, packetAmount)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("transfer/channeltwo/btc", 40), minReceived)
+
+	minReceived, found = PacketMinReceived(sdk.NewInt64Coin("atom", 90), amount, packetAmount)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("transfer/channelone/atom", 90), minReceived)
+
+	_, found = PacketMinReceived(sdk.NewInt64Coin("stake", 10), amount, packetAmount)
+	require.False(t, found)
+}
+
+// withRefundAddress returns a valid packet data refunded to the given address.
+func withRefundAddress(refundAddress string) FungibleTokenPacketData {
+	data := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")
+	data.RefundAddress = refundAddress
+	return data
+}
+
+// withMinReceived returns a valid packet data with the given minimum received
+// amount.
+func withMinReceived(minReceived sdk.Coin) FungibleTokenPacketData {
+	data := NewFungibleTokenPacketData(coins, addr1.String(), addr2, "")
+	data.MinReceived = &minReceived
+	return data
+}
+
+// TestGetAcknowledgement tests decoding of FungibleTokenPacketAcknowledgement
+func TestGetAcknowledgement(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		bz      []byte
+		expAck  FungibleTokenPacketAcknowledgement
+		expPass bool
+	}{
+		{"success ack", FungibleTokenPacketAcknowledgement{Success: true}.GetBytes(), FungibleTokenPacketAcknowledgement{Success: true}, true},
+		{"error ack", FungibleTokenPacketAcknowledgement{Success: false, Error: "insufficient funds"}.GetBytes(), FungibleTokenPacketAcknowledgement{Success: false, Error: "insufficient funds"}, true},
+		{"invalid bytes", []byte("invalid ack"), FungibleTokenPacketAcknowledgement{}, false},
+		{"empty bytes", []byte{}, FungibleTokenPacketAcknowledgement{}, false},
+	}
+
+	for i, tc := range testCases {
+		ack, err := GetAcknowledgement(tc.bz)
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.msg)
+			require.Equal(t, tc.expAck, ack, "test case %d: %s", i, tc.msg)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+// TestNewErrorAcknowledgement tests that the error message of the
+// acknowledgement is truncated to its maximum length on a rune boundary.
+func TestNewErrorAcknowledgement(t *testing.T) {
+	ack := NewErrorAcknowledgement(errors.New("insufficient funds"))
+	require.Equal(t, FungibleTokenPacketAcknowledgement{Success: false, Error: "insufficient funds"}, ack)
+
+	ack = NewErrorAcknowledgement(errors.New(strings.Repeat("a", MaximumAcknowledgementErrorLength+1)))
+	require.Equal(t, strings.Repeat("a", MaximumAcknowledgementErrorLength), ack.Error)
+
+	// the 2-byte rune crossing the limit is dropped
+	ack = NewErrorAcknowledgement(errors.New(strings.Repeat("a", MaximumAcknowledgementErrorLength-1) + "éa"))
+	require.Equal(t, strings.Repeat("a", MaximumAcknowledgementErrorLength-1), ack.Error)
+	require.True(t, utf8.ValidString(ack.Error))
+}
@@ -0,0 +1,287 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultSendEnabled enables the transfers out of the chain
+	DefaultSendEnabled = true
+
+	// DefaultReceiveEnabled enables the transfers into the chain
+	DefaultReceiveEnabled = true
+
+	// DefaultSelfLoopbackCheck disables the check of the transfers sent to the
+	// sender account over a loopback channel
+	DefaultSelfLoopbackCheck = false
+
+	// DefaultEscrowSnapshotInterval disables the snapshots of the escrow balances
+	DefaultEscrowSnapshotInterval uint64 = 0
+
+	// DefaultAllowOrderedChannels only allows UNORDERED transfer channels, so that
+	// a packet that can't be relayed doesn't block the following ones
+	DefaultAllowOrderedChannels = false
+
+	// DefaultMaxDenomTraceDepth allows up to 16 hops in the traces of the received
+	// vouchers, far more than any route used in practice
+	DefaultMaxDenomTraceDepth uint64 = 16
+
+	// DefaultEscrowSnapshotRetention keeps the 100 latest snapshots of the escrow
+	// balances
+	DefaultEscrowSnapshotRetention uint64 = 100
+)
+
+// Parameter store keys
+var (
+	KeySendEnabled         = []byte("SendEnabled")
+	KeyReceiveEnabled      = []byte("ReceiveEnabled")
+	KeyDenomSendEnabled    = []byte("DenomSendEnabled")
+	KeyDenomReceiveEnabled = []byte("DenomReceiveEnabled")
+	KeySelfLoopbackCheck   = []byte("SelfLoopbackCheck")
+
+	KeyEscrowSnapshotInterval = []byte("EscrowSnapshotInterval")
+	KeyAllowOrderedChannels   = []byte("AllowOrderedChannels")
+	KeyMaxDenomTraceDepth     = []byte("MaxDenomTraceDepth")
+
+	KeyEscrowSnapshotRetention = []byte("EscrowSnapshotRetention")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewDenomEnabled creates a new DenomEnabled instance
+func NewDenomEnabled(denom string, enabled bool) DenomEnabled {
+	return DenomEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// String implements the Stringer interface
+func (de DenomEnabled) String() string {
+	return fmt.Sprintf("%s:%t", de.Denom, de.Enabled)
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	sendEnabled, receiveEnabled bool, denomSendEnabled, denomReceiveEnabled []DenomEnabled, selfLoopbackCheck bool,
+	escrowSnapshotInterval uint64, allowOrderedChannels bool, maxDenomTraceDepth, escrowSnapshotRetention uint64,
+) Params {
+	return Params{
+		SendEnabled:         sendEnabled,
+		ReceiveEnabled:      receiveEnabled,
+		DenomSendEnabled:    denomSendEnabled,
+		DenomReceiveEnabled: denomReceiveEnabled,
+		SelfLoopbackCheck:   selfLoopbackCheck,
+
+		EscrowSnapshotInterval: escrowSnapshotInterval,
+		AllowOrderedChannels:   allowOrderedChannels,
+		MaxDenomTraceDepth:     maxDenomTraceDepth,
+
+		EscrowSnapshotRetention: escrowSnapshotRetention,
+	}
+}
+
+// DefaultParams returns the default parameters of the IBC transfer module
+func DefaultParams() Params {
+	return NewParams(
+		DefaultSendEnabled, DefaultReceiveEnabled, nil, nil, DefaultSelfLoopbackCheck,
+		DefaultEscrowSnapshotInterval, DefaultAllowOrderedChannels, DefaultMaxDenomTraceDepth,
+		DefaultEscrowSnapshotRetention,
+	)
+}
+
+// IsSendEnabled returns true if the transfers of the denomination out of the
+// chain are enabled.
+func (p Params) IsSendEnabled(denom string) bool {
+	return isDenomEnabled(p.DenomSendEnabled, denom, p.SendEnabled)
+}
+
+// IsReceiveEnabled returns true if the transfers of the denomination into the
+// chain are enabled.
+func (p Params) IsReceiveEnabled(denom string) bool {
+	return isDenomEnabled(p.DenomReceiveEnabled, denom, p.ReceiveEnabled)
+}
+
+// Validate performs a basic validation of the parameters
+func (p Params) Validate() error {
+	if err := validateEnabled(p.SendEnabled); err != nil {
+		return err
+	}
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+	if err := validateDenomEnabled(p.DenomSendEnabled); err != nil {
+		return err
+	}
+	if err := validateDenomEnabled(p.DenomReceiveEnabled); err != nil {
+		return err
+	}
+	if err := validateSelfLoopbackCheck(p.SelfLoopbackCheck); err != nil {
+		return err
+	}
+	if err := validateEscrowSnapshotInterval(p.EscrowSnapshotInterval); err != nil {
+		return err
+	}
+	if err := validateAllowOrderedChannels(p.AllowOrderedChannels); err != nil {
+		return err
+	}
+	if err := validateMaxDenomTraceDepth(p.MaxDenomTraceDepth); err != nil {
+		return err
+	}
+	return validateEscrowSnapshotRetention(p.EscrowSnapshotRetention)
+}
+
+// ExceedsMaxDenomTraceDepth returns true if the denomination trace has more hops
+// than allowed. A maximum depth of 0 disables the limit.
+func (p Params) ExceedsMaxDenomTraceDepth(denomTrace DenomTrace) bool {
+	return p.MaxDenomTraceDepth > 0 && uint64(denomTrace.Hops()) > p.MaxDenomTraceDepth
+}
+
+// IsEscrowSnapshotHeight returns true if the escrow balances must be snapshotted
+// at the given height, i.e if the snapshots are enabled and the height is a
+// multiple of their interval.
+func (p Params) IsEscrowSnapshotHeight(height int64) bool {
+	return p.EscrowSnapshotInterval > 0 && height > 0 && uint64(height)%p.EscrowSnapshotInterval == 0
+}
+
+// EscrowSnapshotPruneHeight returns the height at or before which the snapshots
+// of the escrow balances are pruned at the given height, so that only the
+// retained number of the latest snapshots are kept. It returns false if no
+// snapshot must be pruned.
+func (p Params) EscrowSnapshotPruneHeight(height int64) (uint64, bool) {
+	if p.EscrowSnapshotInterval == 0 || p.EscrowSnapshotRetention == 0 {
+		return 0, false
+	}
+
+	retained := p.EscrowSnapshotInterval * p.EscrowSnapshotRetention
+	if retained/p.EscrowSnapshotRetention != p.EscrowSnapshotInterval || uint64(height) <= retained {
+		return 0, false
+	}
+	return uint64(height) - retained, true
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	return fmt.Sprintf(`Transfer Params:
+  Send Enabled:              %t
+  Receive Enabled:           %t
+  Denom Send Enabled:        %v
+  Denom Receive Enabled:     %v
+  Self Loopback Check:       %t
+  Escrow Snapshot Interval:  %d
+  Allow Ordered Channels:    %t
+  Max Denom Trace Depth:     %d
+  Escrow Snapshot Retention: %d`,
+		p.SendEnabled, p.ReceiveEnabled, p.DenomSendEnabled, p.DenomReceiveEnabled, p.SelfLoopbackCheck,
+		p.EscrowSnapshotInterval, p.AllowOrderedChannels, p.MaxDenomTraceDepth, p.EscrowSnapshotRetention,
+	)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the IBC transfer module parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyReceiveEnabled, &p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyDenomSendEnabled, &p.DenomSendEnabled, validateDenomEnabled),
+		paramtypes.NewParamSetPair(KeyDenomReceiveEnabled, &p.DenomReceiveEnabled, validateDenomEnabled),
+		paramtypes.NewParamSetPair(KeySelfLoopbackCheck, &p.SelfLoopbackCheck, validateSelfLoopbackCheck),
+		paramtypes.NewParamSetPair(KeyEscrowSnapshotInterval, &p.EscrowSnapshotInterval, validateEscrowSnapshotInterval),
+		paramtypes.NewParamSetPair(KeyAllowOrderedChannels, &p.AllowOrderedChannels, validateAllowOrderedChannels),
+		paramtypes.NewParamSetPair(KeyMaxDenomTraceDepth, &p.MaxDenomTraceDepth, validateMaxDenomTraceDepth),
+		paramtypes.NewParamSetPair(KeyEscrowSnapshotRetention, &p.EscrowSnapshotRetention, validateEscrowSnapshotRetention),
+	}
+}
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// isDenomEnabled returns the override of the denomination if any, or the module
+// wide parameter otherwise.
+func isDenomEnabled(overrides []DenomEnabled, denom string, enabled bool) bool {
+	for _, override := range overrides {
+		if override.Denom == denom {
+			return override.Enabled
+		}
+	}
+	return enabled
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomEnabled(i interface{}) error {
+	overrides, ok := i.([]DenomEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, override := range overrides {
+		if err := sdk.ValidateDenom(override.Denom); err != nil {
+			return err
+		}
+		if seen[override.Denom] {
+			return fmt.Errorf("duplicated denomination %s", override.Denom)
+		}
+		seen[override.Denom] = true
+	}
+
+	return nil
+}
+
+func validateSelfLoopbackCheck(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEscrowSnapshotInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAllowOrderedChannels(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxDenomTraceDepth(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEscrowSnapshotRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"valid overrides", NewParams(true, false, []DenomEnabled{NewDenomEnabled("atom", false)}, []DenomEnabled{NewDenomEnabled("atom", true)}, false, 0, false, 0, 0), true},
+		{"invalid denom", NewParams(true, true, []DenomEnabled{NewDenomEnabled("(atom)", false)}, nil, false, 0, false, 0, 0), false},
+		{"duplicated denom", NewParams(true, true, nil, []DenomEnabled{NewDenomEnabled("atom", false), NewDenomEnabled("atom", true)}, false, 0, false, 0, 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsDenomEnabled(t *testing.T) {
+	params := NewParams(false, true, []DenomEnabled{NewDenomEnabled("atom", true)}, []DenomEnabled{NewDenomEnabled("atom", false)}, false, 0, false, 0, 0)
+
+	require.True(t, params.IsSendEnabled("atom"))
+	require.False(t, params.IsSendEnabled("stake"))
+	require.False(t, params.IsReceiveEnabled("atom"))
+	require.True(t, params.IsReceiveEnabled("stake"))
+}
+
+func TestParamsEscrowSnapshotHeight(t *testing.T) {
+	require.False(t, DefaultParams().IsEscrowSnapshotHeight(10))
+
+	params := NewParams(true, true, nil, nil, false, 5, false, 0, 0)
+	require.True(t, params.IsEscrowSnapshotHeight(5))
+	require.True(t, params.IsEscrowSnapshotHeight(10))
+	require.False(t, params.IsEscrowSnapshotHeight(7))
+	require.False(t, params.IsEscrowSnapshotHeight(0))
+}
+
+func TestParamsEscrowSnapshotPruneHeight(t *testing.T) {
+	params := NewParams(true, true, nil, nil, false, 5, false, 0, 3)
+
+	_, ok := params.EscrowSnapshotPruneHeight(15)
+	require.False(t, ok)
+
+	height, ok := params.EscrowSnapshotPruneHeight(20)
+	require.True(t, ok)
+	require.Equal(t, uint64(5), height)
+
+	// no snapshot is pruned if they are all kept
+	params.EscrowSnapshotRetention = 0
+	_, ok = params.EscrowSnapshotPruneHeight(20)
+	require.False(t, ok)
+}
+
+func TestParamsExceedsMaxDenomTraceDepth(t *testing.T) {
+	deepTrace := ParseDenomTrace("transfer/channelidone/transfer/channelidtwo/transfer/channelidthree/uatom")
+	require.False(t, DefaultParams().ExceedsMaxDenomTraceDepth(deepTrace))
+
+	params := NewParams(true, true, nil, nil, false, 0, false, 2, 0)
+	require.True(t, params.ExceedsMaxDenomTraceDepth(deepTrace))
+	require.False(t, params.ExceedsMaxDenomTraceDepth(ParseDenomTrace("transfer/channelidone/transfer/channelidtwo/uatom")))
+
+	// a maximum depth of 0 disables the limit
+	params.MaxDenomTraceDepth = 0
+	require.False(t, params.ExceedsMaxDenomTraceDepth(deepTrace))
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+)
+
+const (
+	// ProposalTypeRefundStuckPacket defines the type for a RefundStuckPacketProposal
+	ProposalTypeRefundStuckPacket = "RefundStuckPacket"
+
+	// ProposalTypeUpdateParams defines the type for a UpdateParamsProposal
+	ProposalTypeUpdateParams = "UpdateTransferParams"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &RefundStuckPacketProposal{}
+	_ govtypes.Content = &UpdateParamsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRefundStuckPacket)
+	govtypes.RegisterProposalTypeCodec(&RefundStuckPacketProposal{}, "ibc/transfer/RefundStuckPacketProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "ibc/transfer/UpdateParamsProposal")
+}
+
+// NewRefundStuckPacketProposal creates a new stuck packet refund proposal.
+func NewRefundStuckPacketProposal(title, description string, packet channel.Packet) *RefundStuckPacketProposal {
+	return &RefundStuckPacketProposal{title, description, packet}
+}
+
+// GetTitle returns the title of a stuck packet refund proposal.
+func (rsp *RefundStuckPacketProposal) GetTitle() string { return rsp.Title }
+
+// GetDescription returns the description of a stuck packet refund proposal.
+func (rsp *RefundStuckPacketProposal) GetDescription() string { return rsp.Description }
+
+// ProposalRoute returns the routing key of a stuck packet refund proposal.
+func (rsp *RefundStuckPacketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a stuck packet refund proposal.
+func (rsp *RefundStuckPacketProposal) ProposalType() string { return ProposalTypeRefundStuckPacket }
+
+// ValidateBasic runs basic stateless validity checks
+func (rsp *RefundStuckPacketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rsp); err != nil {
+		return err
+	}
+	if err := rsp.Packet.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid stuck packet")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (rsp RefundStuckPacketProposal) String() string {
+	return fmt.Sprintf(`Refund Stuck Packet Proposal:
+  Title:          %s
+  Description:    %s
+  Source Port:    %s
+  Source Channel: %s
+  Sequence:       %d
+`, rsp.Title, rsp.Description, rsp.Packet.SourcePort, rsp.Packet.SourceChannel, rsp.Packet.Sequence)
+}
+
+// NewUpdateParamsProposal creates a new transfer parameters update proposal.
+func NewUpdateParamsProposal(title, description string, params Params) *UpdateParamsProposal {
+	return &UpdateParamsProposal{title, description, params}
+}
+
+// GetTitle returns the title of a transfer parameters update proposal.
+func (upp *UpdateParamsProposal) GetTitle() string { return upp.Title }
+
+// GetDescription returns the description of a transfer parameters update proposal.
+func (upp *UpdateParamsProposal) GetDescription() string { return upp.Description }
+
+// ProposalRoute returns the routing key of a transfer parameters update proposal.
+func (upp *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a transfer parameters update proposal.
+func (upp *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic runs basic stateless validity checks
+func (upp *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(upp); err != nil {
+		return err
+	}
+	if err := upp.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (upp UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Transfer Params Proposal:
+  Title:       %s
+  Description: %s
+%s
+`, upp.Title, upp.Description, upp.Params)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+)
+
+func TestRefundStuckPacketProposalValidateBasic(t *testing.T) {
+	packet := channel.NewPacket([]byte("data"), 1, "testportid", "testchannel", "bank", "otherchannel", 100, 0)
+	invalidPacket := channel.NewPacket([]byte("data"), 0, "testportid", "testchannel", "bank", "otherchannel", 100, 0)
+
+	testCases := []struct {
+		name     string
+		proposal *RefundStuckPacketProposal
+		expPass  bool
+	}{
+		{"valid proposal", NewRefundStuckPacketProposal("title", "description", packet), true},
+		{"empty title", NewRefundStuckPacketProposal("", "description", packet), false},
+		{"invalid packet", NewRefundStuckPacketProposal("title", "description", invalidPacket), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUpdateParamsProposalValidateBasic(t *testing.T) {
+	invalidParams := DefaultParams()
+	invalidParams.DenomSendEnabled = []DenomEnabled{NewDenomEnabled("(atom)", false)}
+
+	testCases := []struct {
+		name     string
+		proposal *UpdateParamsProposal
+		expPass  bool
+	}{
+		{"valid proposal", NewUpdateParamsProposal("title", "description", DefaultParams()), true},
+		{"empty title", NewUpdateParamsProposal("", "description", DefaultParams()), false},
+		{"invalid params", NewUpdateParamsProposal("title", "description", invalidParams), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
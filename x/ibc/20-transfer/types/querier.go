@@ -0,0 +1,493 @@
+package types
+
+import (
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+// query endpoints supported by the IBC transfer Querier
+const (
+	QueryDenomTrace       = "denom-trace"
+	QueryDenomTraces      = "denom-traces"
+	QueryDenomTraceByPath = "denom-trace-by-path"
+	QueryVerifyDenomTrace = "verify-denom-trace"
+	QueryRateLimit        = "rate-limit"
+	QueryTotalEscrow      = "total-escrow"
+
+	QueryEscrowAddress   = "escrow-address"
+	QueryEscrowAddresses = "escrow-addresses"
+	QueryDenomEscrow     = "denom-escrow"
+
+	QuerySimulateTransfer = "simulate-transfer"
+	QueryPacketFees       = "packet-fees"
+
+	QueryTransferChannels = "transfer-channels"
+	QuerySendDenylist     = "send-denylist"
+
+	QueryPacketState    = "packet-state"
+	QueryParams         = "params"
+	QueryEscrowSnapshot = "escrow-snapshot"
+
+	QueryChannelClientState = "channel-client-state"
+)
+
+// QueryDenomTraceParams defines the parameters necessary for querying a
+// denomination trace by its hash.
+type QueryDenomTraceParams struct {
+	// hex hash of the denomination trace, with or without the ibc/ prefix
+	Hash string `json:"hash" yaml:"hash"`
+}
+
+// NewQueryDenomTraceParams creates a new QueryDenomTraceParams instance.
+func NewQueryDenomTraceParams(hash string) QueryDenomTraceParams {
+	return QueryDenomTraceParams{
+		Hash: hash,
+	}
+}
+
+// QueryDenomTracesParams defines the parameters necessary for querying all the
+// denomination traces.
+type QueryDenomTracesParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryDenomTracesParams creates a new QueryDenomTracesParams instance.
+func NewQueryDenomTracesParams(page, limit int) QueryDenomTracesParams {
+	return QueryDenomTracesParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// QueryDenomTraceByPathParams defines the parameters necessary for querying a
+// denomination trace by its path and base denomination.
+type QueryDenomTraceByPathParams struct {
+	// chain of port/channel identifiers of the trace (eg: transfer/channelA/transfer/channelB)
+	Path      string `json:"path" yaml:"path"`
+	BaseDenom string `json:"base_denom" yaml:"base_denom"`
+}
+
+// NewQueryDenomTraceByPathParams creates a new QueryDenomTraceByPathParams instance.
+func NewQueryDenomTraceByPathParams(path, baseDenom string) QueryDenomTraceByPathParams {
+	return QueryDenomTraceByPathParams{
+		Path:      path,
+		BaseDenom: baseDenom,
+	}
+}
+
+// DenomTraceByPathResponse defines the client query response for a denomination
+// trace queried by its path and base denomination, along with the hash under
+// which it is stored.
+type DenomTraceByPathResponse struct {
+	DenomTrace DenomTrace       `json:"denom_trace" yaml:"denom_trace"`
+	Hash       tmbytes.HexBytes `json:"hash" yaml:"hash"`
+}
+
+// NewDenomTraceByPathResponse creates a new DenomTraceByPathResponse instance.
+func NewDenomTraceByPathResponse(denomTrace DenomTrace, hash tmbytes.HexBytes) DenomTraceByPathResponse {
+	return DenomTraceByPathResponse{
+		DenomTrace: denomTrace,
+		Hash:       hash,
+	}
+}
+
+// QueryVerifyDenomTraceParams defines the parameters necessary for verifying
+// that a denomination trace hash matches a path and base denomination.
+type QueryVerifyDenomTraceParams struct {
+	// hex hash of the denomination trace, with or without the ibc/ prefix
+	Hash      string `json:"hash" yaml:"hash"`
+	Path      string `json:"path" yaml:"path"`
+	BaseDenom string `json:"base_denom" yaml:"base_denom"`
+}
+
+// NewQueryVerifyDenomTraceParams creates a new QueryVerifyDenomTraceParams instance.
+func NewQueryVerifyDenomTraceParams(hash, path, baseDenom string) QueryVerifyDenomTraceParams {
+	return QueryVerifyDenomTraceParams{
+		Hash:      hash,
+		Path:      path,
+		BaseDenom: baseDenom,
+	}
+}
+
+// VerifyDenomTraceResponse defines the client query response of a denomination
+// trace verification, along with the hash recomputed from the path and base
+// denomination.
+type VerifyDenomTraceResponse struct {
+	Valid bool             `json:"valid" yaml:"valid"`
+	Hash  tmbytes.HexBytes `json:"hash" yaml:"hash"`
+}
+
+// NewVerifyDenomTraceResponse creates a new VerifyDenomTraceResponse instance.
+func NewVerifyDenomTraceResponse(valid bool, hash tmbytes.HexBytes) VerifyDenomTraceResponse {
+	return VerifyDenomTraceResponse{
+		Valid: valid,
+		Hash:  hash,
+	}
+}
+
+// QueryRateLimitParams defines the parameters necessary for querying the
+// outbound rate limit of a denomination on a channel.
+type QueryRateLimitParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Denom     string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryRateLimitParams creates a new QueryRateLimitParams instance.
+func NewQueryRateLimitParams(portID, channelID, denom string) QueryRateLimitParams {
+	return QueryRateLimitParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Denom:     denom,
+	}
+}
+
+// RateLimitResponse defines the client query response for a rate limit, which
+// also includes the quota remaining for the current window.
+type RateLimitResponse struct {
+	RateLimit RateLimit `json:"rate_limit" yaml:"rate_limit"`
+	Remaining sdk.Int   `json:"remaining" yaml:"remaining"`
+}
+
+// NewRateLimitResponse creates a new RateLimitResponse instance
+func NewRateLimitResponse(rateLimit RateLimit) RateLimitResponse {
+	return RateLimitResponse{
+		RateLimit: rateLimit,
+		Remaining: rateLimit.Remaining(),
+	}
+}
+
+// QueryTotalEscrowParams defines the parameters necessary for querying the total
+// amount of a denomination escrowed by a page of the transfer channels.
+type QueryTotalEscrowParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+	Page  int    `json:"page" yaml:"page"`
+	Limit int    `json:"limit" yaml:"limit"`
+}
+
+// NewQueryTotalEscrowParams creates a new QueryTotalEscrowParams instance.
+func NewQueryTotalEscrowParams(denom string, page, limit int) QueryTotalEscrowParams {
+	return QueryTotalEscrowParams{
+		Denom: denom,
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// TotalEscrowResponse defines the client query response for the total amount of
+// a denomination escrowed by the channels of a page. The number of transfer
+// channels is included so that clients can sum the amounts of all the pages.
+type TotalEscrowResponse struct {
+	Denom         string  `json:"denom" yaml:"denom"`
+	Amount        sdk.Int `json:"amount" yaml:"amount"`
+	Channels      int     `json:"channels" yaml:"channels"`             // number of channels summed
+	TotalChannels int     `json:"total_channels" yaml:"total_channels"` // number of transfer channels
+}
+
+// NewTotalEscrowResponse creates a new TotalEscrowResponse instance
+func NewTotalEscrowResponse(denom string, amount sdk.Int, channels, totalChannels int) TotalEscrowResponse {
+	return TotalEscrowResponse{
+		Denom:         denom,
+		Amount:        amount,
+		Channels:      channels,
+		TotalChannels: totalChannels,
+	}
+}
+
+// QuerySimulateTransferParams defines the parameters necessary for simulating a
+// transfer.
+type QuerySimulateTransferParams struct {
+	Transfer MsgTransfer `json:"transfer" yaml:"transfer"`
+}
+
+// NewQuerySimulateTransferParams creates a new QuerySimulateTransferParams instance.
+func NewQuerySimulateTransferParams(transfer MsgTransfer) QuerySimulateTransferParams {
+	return QuerySimulateTransferParams{
+		Transfer: transfer,
+	}
+}
+
+// SimulateTransferResponse defines the client query response for a simulated
+// transfer. It contains the packet that would be sent and the coins that would
+// be credited to the receiver, with their denomination on the destination chain.
+type SimulateTransferResponse struct {
+	Packet         channel.Packet          `json:"packet" yaml:"packet"`
+	PacketData     FungibleTokenPacketData `json:"packet_data" yaml:"packet_data"`
+	ReceivedAmount sdk.Coins               `json:"received_amount" yaml:"received_amount"`
+}
+
+// NewSimulateTransferResponse creates a new SimulateTransferResponse instance
+func NewSimulateTransferResponse(
+	packet channel.Packet, packetData FungibleTokenPacketData, receivedAmount sdk.Coins,
+) SimulateTransferResponse {
+	return SimulateTransferResponse{
+		Packet:         packet,
+		PacketData:     packetData,
+		ReceivedAmount: receivedAmount,
+	}
+}
+
+// QueryPacketFeesParams defines the parameters necessary for querying the relayer
+// fees escrowed for the packets in flight of a channel.
+type QueryPacketFeesParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Page      int    `json:"page" yaml:"page"`
+	Limit     int    `json:"limit" yaml:"limit"`
+}
+
+// NewQueryPacketFeesParams creates a new QueryPacketFeesParams instance.
+func NewQueryPacketFeesParams(portID, channelID string, page, limit int) QueryPacketFeesParams {
+	return QueryPacketFeesParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Page:      page,
+		Limit:     limit,
+	}
+}
+
+// QueryTransferChannelsParams defines the parameters necessary for querying the
+// channels of the port bound by the transfer module.
+type QueryTransferChannelsParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryTransferChannelsParams creates a new QueryTransferChannelsParams instance.
+func NewQueryTransferChannelsParams(page, limit int) QueryTransferChannelsParams {
+	return QueryTransferChannelsParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// TransferChannel defines the client query response for a channel of the port
+// bound by the transfer module.
+type TransferChannel struct {
+	ChannelID    string                `json:"channel_id" yaml:"channel_id"`
+	State        channelexported.State `json:"state" yaml:"state"`
+	Counterparty channel.Counterparty  `json:"counterparty" yaml:"counterparty"`
+}
+
+// NewTransferChannel creates a new TransferChannel instance from an identified
+// channel
+func NewTransferChannel(ch channel.IdentifiedChannel) TransferChannel {
+	return TransferChannel{
+		ChannelID:    ch.ChannelIdentifier,
+		State:        ch.Channel.State,
+		Counterparty: ch.Channel.Counterparty,
+	}
+}
+
+// QueryEscrowAddressParams defines the parameters necessary for querying the
+// escrow address of a channel.
+type QueryEscrowAddressParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+}
+
+// NewQueryEscrowAddressParams creates a new QueryEscrowAddressParams instance.
+func NewQueryEscrowAddressParams(portID, channelID string) QueryEscrowAddressParams {
+	return QueryEscrowAddressParams{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+}
+
+// EscrowAddressResponse defines the client query response for the escrow
+// address of a channel, along with the version it's derived with.
+type EscrowAddressResponse struct {
+	Address sdk.AccAddress       `json:"address" yaml:"address"`
+	Version EscrowAddressVersion `json:"version" yaml:"version"`
+}
+
+// NewEscrowAddressResponse creates a new EscrowAddressResponse instance
+func NewEscrowAddressResponse(portID, channelID string, version EscrowAddressVersion) EscrowAddressResponse {
+	return EscrowAddressResponse{
+		Address: GetVersionedEscrowAddress(version, portID, channelID),
+		Version: version,
+	}
+}
+
+// QueryEscrowAddressesParams defines the parameters necessary for querying the
+// escrow addresses of a page of the transfer channels.
+type QueryEscrowAddressesParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryEscrowAddressesParams creates a new QueryEscrowAddressesParams instance.
+func NewQueryEscrowAddressesParams(page, limit int) QueryEscrowAddressesParams {
+	return QueryEscrowAddressesParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// ChannelEscrowAddress defines the addresses controlled by the transfer module
+// for a channel: the escrow address of the tokens sent through it, derived with
+// the recorded version, and the address escrowing the relayer fees of its
+// packets.
+type ChannelEscrowAddress struct {
+	PortID     string               `json:"port_id" yaml:"port_id"`
+	ChannelID  string               `json:"channel_id" yaml:"channel_id"`
+	Address    sdk.AccAddress       `json:"address" yaml:"address"`
+	Version    EscrowAddressVersion `json:"version" yaml:"version"`
+	FeeAddress sdk.AccAddress       `json:"fee_address" yaml:"fee_address"`
+}
+
+// NewChannelEscrowAddress creates a new ChannelEscrowAddress instance
+func NewChannelEscrowAddress(portID, channelID string, version EscrowAddressVersion) ChannelEscrowAddress {
+	return ChannelEscrowAddress{
+		PortID:     portID,
+		ChannelID:  channelID,
+		Address:    GetVersionedEscrowAddress(version, portID, channelID),
+		Version:    version,
+		FeeAddress: GetFeeEscrowAddress(portID, channelID),
+	}
+}
+
+// EscrowAddressesResponse defines the client query response for the escrow
+// addresses of a page of the transfer channels. Each channel has its own escrow
+// addresses, so no address is listed twice. The number of transfer channels is
+// included so that clients can query all the pages.
+type EscrowAddressesResponse struct {
+	EscrowAddresses []ChannelEscrowAddress `json:"escrow_addresses" yaml:"escrow_addresses"`
+	TotalChannels   int                    `json:"total_channels" yaml:"total_channels"` // number of transfer channels
+}
+
+// NewEscrowAddressesResponse creates a new EscrowAddressesResponse instance
+func NewEscrowAddressesResponse(escrowAddresses []ChannelEscrowAddress, totalChannels int) EscrowAddressesResponse {
+	return EscrowAddressesResponse{
+		EscrowAddresses: escrowAddresses,
+		TotalChannels:   totalChannels,
+	}
+}
+
+// DenomEscrowResponse defines the client query response for the escrow account
+// holding the base denomination of an IBC voucher on the chain it was received
+// from. The voucher was received through the channel end of the first hop of its
+// denomination trace, and its base denomination is escrowed by the counterparty
+// channel end.
+//
+// NOTE: the address is derived with the default escrow address scheme (see
+// GetEscrowAddress), since the scheme used by the counterparty chain is unknown.
+type DenomEscrowResponse struct {
+	DenomTrace            DenomTrace     `json:"denom_trace" yaml:"denom_trace"`
+	PortID                string         `json:"port_id" yaml:"port_id"`
+	ChannelID             string         `json:"channel_id" yaml:"channel_id"`
+	CounterpartyPortID    string         `json:"counterparty_port_id" yaml:"counterparty_port_id"`
+	CounterpartyChannelID string         `json:"counterparty_channel_id" yaml:"counterparty_channel_id"`
+	Address               sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewDenomEscrowResponse creates a new DenomEscrowResponse instance
+func NewDenomEscrowResponse(
+	denomTrace DenomTrace, portID, channelID, counterpartyPortID, counterpartyChannelID string, address sdk.AccAddress,
+) DenomEscrowResponse {
+	return DenomEscrowResponse{
+		DenomTrace:            denomTrace,
+		PortID:                portID,
+		ChannelID:             channelID,
+		CounterpartyPortID:    counterpartyPortID,
+		CounterpartyChannelID: counterpartyChannelID,
+		Address:               address,
+	}
+}
+
+// QueryPacketStateParams defines the parameters necessary for querying the
+// lifecycle state of a packet.
+type QueryPacketStateParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Sequence  uint64 `json:"sequence" yaml:"sequence"`
+}
+
+// NewQueryPacketStateParams creates a new QueryPacketStateParams instance.
+func NewQueryPacketStateParams(portID, channelID string, sequence uint64) QueryPacketStateParams {
+	return QueryPacketStateParams{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// PacketStateResponse defines the client query response for the lifecycle state
+// of a packet. The send height is only set while the packet is in flight, and is
+// zero for the packets sent before the send heights were recorded.
+type PacketStateResponse struct {
+	PortID     string      `json:"port_id" yaml:"port_id"`
+	ChannelID  string      `json:"channel_id" yaml:"channel_id"`
+	Sequence   uint64      `json:"sequence" yaml:"sequence"`
+	State      PacketState `json:"state" yaml:"state"`
+	SendHeight uint64      `json:"send_height" yaml:"send_height"` // height at which the packet was sent
+}
+
+// NewPacketStateResponse creates a new PacketStateResponse instance with an
+// unknown state
+func NewPacketStateResponse(portID, channelID string, sequence uint64) PacketStateResponse {
+	return PacketStateResponse{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+		State:     PacketStateUnknown,
+	}
+}
+
+// QueryEscrowSnapshotParams defines the parameters necessary for querying the
+// latest snapshot of the escrow balances taken at or before a height.
+type QueryEscrowSnapshotParams struct {
+	Height uint64 `json:"height" yaml:"height"`
+}
+
+// NewQueryEscrowSnapshotParams creates a new QueryEscrowSnapshotParams instance.
+func NewQueryEscrowSnapshotParams(height uint64) QueryEscrowSnapshotParams {
+	return QueryEscrowSnapshotParams{
+		Height: height,
+	}
+}
+
+// QueryChannelClientStateParams defines the parameters necessary for querying
+// the state of the client backing a channel.
+type QueryChannelClientStateParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+}
+
+// NewQueryChannelClientStateParams creates a new QueryChannelClientStateParams instance.
+func NewQueryChannelClientStateParams(portID, channelID string) QueryChannelClientStateParams {
+	return QueryChannelClientStateParams{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+}
+
+// ChannelClientState defines the client query response for the client backing
+// a channel, i.e the client of its first connection hop.
+type ChannelClientState struct {
+	PortID         string                     `json:"port_id" yaml:"port_id"`
+	ChannelID      string                     `json:"channel_id" yaml:"channel_id"`
+	ConnectionHops []string                   `json:"connection_hops" yaml:"connection_hops"`
+	ClientID       string                     `json:"client_id" yaml:"client_id"`
+	LatestHeight   uint64                     `json:"latest_height" yaml:"latest_height"`
+	ClientState    clientexported.ClientState `json:"client_state" yaml:"client_state"`
+}
+
+// NewChannelClientState creates a new ChannelClientState instance.
+func NewChannelClientState(
+	portID, channelID string, connectionHops []string, clientState clientexported.ClientState,
+) ChannelClientState {
+	return ChannelClientState{
+		PortID:         portID,
+		ChannelID:      channelID,
+		ConnectionHops: connectionHops,
+		ClientID:       clientState.GetID(),
+		LatestHeight:   clientState.GetLatestHeight(),
+		ClientState:    clientState,
+	}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// RateLimit defines the maximum amount of a denomination that can be sent
+// through a channel over a rolling window of time.
+type RateLimit struct {
+	PortID      string        `json:"port_id" yaml:"port_id"`           // the port on which the tokens are sent
+	ChannelID   string        `json:"channel_id" yaml:"channel_id"`     // the channel by which the tokens are sent
+	Denom       string        `json:"denom" yaml:"denom"`               // the denomination as held on this chain (i.e escrowed or burned)
+	Quota       sdk.Int       `json:"quota" yaml:"quota"`               // the maximum amount that can be sent during a window
+	Window      time.Duration `json:"window" yaml:"window"`             // the duration of the window
+	WindowStart time.Time     `json:"window_start" yaml:"window_start"` // the block time at which the current window started
+	Outflow     sdk.Int       `json:"outflow" yaml:"outflow"`           // the amount sent during the current window
+}
+
+// NewRateLimit creates a new RateLimit instance with an empty outflow for a
+// window starting at the given time
+func NewRateLimit(
+	portID, channelID, denom string, quota sdk.Int, window time.Duration, windowStart time.Time,
+) RateLimit {
+	return RateLimit{
+		PortID:      portID,
+		ChannelID:   channelID,
+		Denom:       denom,
+		Quota:       quota,
+		Window:      window,
+		WindowStart: windowStart,
+		Outflow:     sdk.ZeroInt(),
+	}
+}
+
+// String returns a string representation of RateLimit
+func (rl RateLimit) String() string {
+	return fmt.Sprintf(`RateLimit:
+	PortID:               %s
+	ChannelID:            %s
+	Denom:                %s
+	Quota:                %s
+	Window:               %s
+	WindowStart:          %s
+	Outflow:              %s`,
+		rl.PortID,
+		rl.ChannelID,
+		rl.Denom,
+		rl.Quota,
+		rl.Window,
+		rl.WindowStart,
+		rl.Outflow,
+	)
+}
+
+// Validate performs a basic validation of the RateLimit fields
+func (rl RateLimit) Validate() error {
+	if err := host.PortIdentifierValidator(rl.PortID); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(rl.ChannelID); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if rl.Quota == (sdk.Int{}) || !rl.Quota.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "quota must be positive")
+	}
+	if rl.Window <= 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "window duration must be positive")
+	}
+	if rl.Outflow == (sdk.Int{}) || rl.Outflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "outflow cannot be negative")
+	}
+	return nil
+}
+
+// Rollover returns the rate limit for the window active at the given block
+// time. If the current window has elapsed, a new window starts at blockTime
+// with an empty outflow.
+func (rl RateLimit) Rollover(blockTime time.Time) RateLimit {
+	if blockTime.Before(rl.WindowStart.Add(rl.Window)) {
+		return rl
+	}
+
+	rl.WindowStart = blockTime
+	rl.Outflow = sdk.ZeroInt()
+	return rl
+}
+
+// Remaining returns the amount that can still be sent during the current window
+func (rl RateLimit) Remaining() sdk.Int {
+	if rl.Outflow.GTE(rl.Quota) {
+		return sdk.ZeroInt()
+	}
+	return rl.Quota.Sub(rl.Outflow)
+}
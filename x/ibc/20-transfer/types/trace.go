@@ -0,0 +1,283 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"regexp"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// DenomPrefix is the prefix used for the denominations of IBC vouchers
+// (i.e ibc/{hash})
+const DenomPrefix = "ibc"
+
+// MaxDenomPathLength is the maximum length of the full denomination trace paths
+// sent on the packet data. They can be longer than the 64 characters of a coin
+// denomination, as each hop prefixes them with its port and channel identifiers.
+const MaxDenomPathLength = 128
+
+var reDenomPath = regexp.MustCompile(fmt.Sprintf(`^[a-z][a-z0-9/]{2,%d}$`, MaxDenomPathLength-1))
+
+// ValidatePrefixedDenom validates a full denomination trace path (i.e
+// {trace path}/{base denom}). It accepts the same characters as a coin
+// denomination, up to MaxDenomPathLength characters.
+func ValidatePrefixedDenom(denom string) error {
+	if !reDenomPath.MatchString(denom) {
+		return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid denomination trace path: %s", denom)
+	}
+	return nil
+}
+
+// DefaultDenomTraceHasher is the hash function of the denomination traces used
+// to derive the voucher denominations (see DenomTrace.Hash).
+var DefaultDenomTraceHasher = sha256.New
+
+// ValidateDenomTraceHasher checks that the hashes of the given hash function can
+// be used in voucher denominations, i.e that ibc/{hash} is a valid coin
+// denomination.
+func ValidateDenomTraceHasher(newHash func() hash.Hash) error {
+	if newHash == nil {
+		return sdkerrors.Wrap(ErrInvalidDenomTrace, "denomination trace hasher cannot be nil")
+	}
+
+	size := newHash().Size()
+	if size == 0 {
+		return sdkerrors.Wrap(ErrInvalidDenomTrace, "denomination trace hasher cannot have an empty output")
+	}
+
+	denom := fmt.Sprintf("%s/%x", DenomPrefix, make([]byte, size))
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomTrace, "hashes of %d bytes don't fit in a voucher denomination: %s", size, err.Error())
+	}
+	return nil
+}
+
+// NewDenomTrace creates a new DenomTrace instance
+func NewDenomTrace(path, baseDenom string) DenomTrace {
+	return DenomTrace{
+		Path:      path,
+		BaseDenom: baseDenom,
+	}
+}
+
+// ParseDenomTrace parses a string with the ibc prefix (denom trace) and the base
+// denomination into a DenomTrace type.
+//
+// Examples:
+//
+// - "transfer/channelidone/uatom" => DenomTrace{Path: "transfer/channelidone", BaseDenom: "uatom"}
+// - "uatom" => DenomTrace{Path: "", BaseDenom: "uatom"}
+//
+// The last segment is always parsed as the base denomination, so native
+// denominations containing a "/" (e.g "transfer/channelidone/gamm/pool1") are
+// parsed into a trace with an invalid path, which Validate rejects.
+func ParseDenomTrace(fullDenom string) DenomTrace {
+	denomSplit := strings.Split(fullDenom, "/")
+
+	if denomSplit[0] == fullDenom {
+		return DenomTrace{
+			Path:      "",
+			BaseDenom: fullDenom,
+		}
+	}
+
+	return DenomTrace{
+		Path:      strings.Join(denomSplit[:len(denomSplit)-1], "/"),
+		BaseDenom: denomSplit[len(denomSplit)-1],
+	}
+}
+
+// WithChainID returns a copy of the denomination trace with the given chain ID
+func (dt DenomTrace) WithChainID(chainID string) DenomTrace {
+	dt.ChainID = chainID
+	return dt
+}
+
+// Hash returns the SHA256 hash (see DefaultDenomTraceHasher) of the
+// full denomination trace path. The chain ID, if any, is prepended with a ":"
+// separator, which neither identifiers nor denominations can contain, so that
+// the vouchers of the same path received from different chains have different
+// hashes.
+func (dt DenomTrace) Hash() tmbytes.HexBytes {
+	return dt.HashWith(DefaultDenomTraceHasher)
+}
+
+// HashWith returns the hash of the denomination trace computed with the given
+// hash function (see Hash).
+func (dt DenomTrace) HashWith(newHash func() hash.Hash) tmbytes.HexBytes {
+	preimage := dt.GetFullDenomPath()
+	if dt.ChainID != "" {
+		preimage = dt.ChainID + ":" + preimage
+	}
+
+	hasher := newHash()
+	hasher.Write([]byte(preimage)) // nolint: errcheck // hash writes never fail
+	return hasher.Sum(nil)
+}
+
+// GetPrefix returns the receiving denomination prefix composed by the trace info
+// and a separator.
+func (dt DenomTrace) GetPrefix() string {
+	return dt.Path + "/"
+}
+
+// IBCDenom returns the hashed denomination used on chain for the IBC vouchers
+// (i.e ibc/{hash}). If the trace doesn't contain a path, the base denomination
+// is returned instead.
+func (dt DenomTrace) IBCDenom() string {
+	return dt.IBCDenomWith(DefaultDenomTraceHasher)
+}
+
+// IBCDenomWith returns the voucher denomination of the trace hashed with the
+// given hash function (see IBCDenom).
+func (dt DenomTrace) IBCDenomWith(newHash func() hash.Hash) string {
+	if dt.Path != "" {
+		return fmt.Sprintf("%s/%x", DenomPrefix, []byte(dt.HashWith(newHash)))
+	}
+	return dt.BaseDenom
+}
+
+// GetFirstHop returns the port and channel identifiers of the first hop of the
+// trace path, i.e the channel end the vouchers were received through. It returns
+// false if the trace doesn't contain a path.
+func (dt DenomTrace) GetFirstHop() (portID, channelID string, found bool) {
+	pathSplit := strings.SplitN(dt.Path, "/", 3)
+	if len(pathSplit) < 2 {
+		return "", "", false
+	}
+	return pathSplit[0], pathSplit[1], true
+}
+
+// Hops returns the number of {portID}/{channelID} hops of the trace path, i.e
+// the number of channels the vouchers were transferred through.
+func (dt DenomTrace) Hops() int {
+	if dt.Path == "" {
+		return 0
+	}
+	return (strings.Count(dt.Path, "/") + 1) / 2
+}
+
+// GetFullDenomPath returns the full denomination according to the ICS20 specification:
+// tracePath + "/" + baseDenom
+// If there exists no trace then the base denomination is returned.
+func (dt DenomTrace) GetFullDenomPath() string {
+	if dt.Path == "" {
+		return dt.BaseDenom
+	}
+	return dt.GetPrefix() + dt.BaseDenom
+}
+
+// String returns a string representation of DenomTrace
+func (dt DenomTrace) String() string {
+	return fmt.Sprintf(`DenomTrace:
+	Path:                 %s
+	BaseDenom:            %s
+	ChainID:              %s`,
+		dt.Path,
+		dt.BaseDenom,
+		dt.ChainID,
+	)
+}
+
+// Validate performs a basic validation of the DenomTrace fields. The path must
+// be composed of {portID}/{channelID} pairs, so traces with an odd number of path
+// segments are rejected. The base denomination cannot contain the "/" separator,
+// otherwise different traces would share the same full path and hash (e.g a
+// transfer/channelidtwo/uatom base denomination on the transfer/channelidone path).
+func (dt DenomTrace) Validate() error {
+	if strings.TrimSpace(dt.BaseDenom) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomTrace, "base denomination cannot be blank")
+	}
+
+	if strings.Contains(dt.BaseDenom, "/") {
+		return sdkerrors.Wrapf(ErrInvalidDenomTrace, "base denomination %s cannot contain the '/' path separator", dt.BaseDenom)
+	}
+
+	if strings.Contains(dt.ChainID, ":") {
+		return sdkerrors.Wrapf(ErrInvalidDenomTrace, "chain ID %s cannot contain the ':' separator", dt.ChainID)
+	}
+
+	// empty path, no need to validate the identifiers
+	if dt.Path == "" {
+		return nil
+	}
+
+	pathSplit := strings.Split(dt.Path, "/")
+	if len(pathSplit)%2 != 0 {
+		return sdkerrors.Wrapf(
+			ErrInvalidDenomTrace,
+			"path %s must contain an even number of {portID}/{channelID} segments, got %d", dt.Path, len(pathSplit),
+		)
+	}
+
+	for i := 0; i < len(pathSplit); i += 2 {
+		if err := host.PortIdentifierValidator(pathSplit[i]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid port ID at position %d: %s", i, err.Error())
+		}
+		if err := host.ChannelIdentifierValidator(pathSplit[i+1]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid channel ID at position %d: %s", i+1, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// VerifyDenomTrace recomputes the hash of the denomination trace made of the
+// given path and base denomination and returns true if it matches the given
+// hash, with or without the ibc/ prefix. It doesn't read any state, so the
+// traces hashed with a chain ID (see DenomTrace.WithChainID) never match.
+func VerifyDenomTrace(hash, path, baseDenom string) (bool, error) {
+	return VerifyDenomTraceWith(hash, path, baseDenom, DefaultDenomTraceHasher)
+}
+
+// VerifyDenomTraceWith performs the same verification as VerifyDenomTrace with
+// the given hash function.
+func VerifyDenomTraceWith(hexHash, path, baseDenom string, newHash func() hash.Hash) (bool, error) {
+	expHash, err := ParseHexHashWithSize(strings.TrimPrefix(hexHash, DenomPrefix+"/"), newHash().Size())
+	if err != nil {
+		return false, err
+	}
+
+	denomTrace := NewDenomTrace(path, baseDenom)
+	if err := denomTrace.Validate(); err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(expHash, denomTrace.HashWith(newHash)), nil
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its correctness.
+func ParseHexHash(hexHash string) (tmbytes.HexBytes, error) {
+	return ParseHexHashWithSize(hexHash, sha256.Size)
+}
+
+// ParseHexHashWithSize parses a hex hash in string format to bytes and validates
+// that it has the given size, i.e the one of the configured denomination trace
+// hasher.
+func ParseHexHashWithSize(hexHash string, size int) (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid hex hash %s: %s", hexHash, err.Error())
+	}
+
+	if len(hash) != size {
+		return nil, sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid hash length %d, expected %d", len(hash), size)
+	}
+
+	return hash, nil
+}
+
+// IsIBCDenom returns true if the denomination has the IBC voucher format
+// (i.e ibc/{hash}).
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, DenomPrefix+"/")
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"crypto/sha1" // nolint: gosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseDenomTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    string
+		expTrace DenomTrace
+	}{
+		{"empty denom", "", DenomTrace{}},
+		{"base denom", "uatom", DenomTrace{BaseDenom: "uatom"}},
+		{"trace info", "transfer/channelidone/uatom", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone"}},
+		{"multi-hop trace info", "transfer/channelidone/transfer/channelidtwo/uatom", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone/transfer/channelidtwo"}},
+		{"incomplete path", "transfer/uatom", DenomTrace{BaseDenom: "uatom", Path: "transfer"}},
+		{"base denom with a separator", "transfer/channelidone/a/b", DenomTrace{BaseDenom: "b", Path: "transfer/channelidone/a"}},
+	}
+
+	for _, tc := range testCases {
+		trace := ParseDenomTrace(tc.denom)
+		require.Equal(t, tc.expTrace, trace, tc.name)
+		require.Equal(t, tc.denom, trace.GetFullDenomPath(), tc.name)
+	}
+}
+
+func TestDenomTraceIBCDenom(t *testing.T) {
+	trace := ParseDenomTrace("transfer/channelidone/transfer/channelidtwo/uatom")
+	denom := trace.IBCDenom()
+
+	require.True(t, IsIBCDenom(denom))
+	require.Len(t, denom, len(DenomPrefix)+1+64)
+	require.NoError(t, sdk.ValidateDenom(denom), "hashed denomination must be a valid coin denom")
+	require.Equal(t, denom, ParseDenomTrace(trace.GetFullDenomPath()).IBCDenom(), "hash is not reproducible")
+	require.NotEqual(t, denom, ParseDenomTrace("transfer/channelidone/uatom").IBCDenom())
+
+	hash, err := ParseHexHash(strings.TrimPrefix(denom, DenomPrefix+"/"))
+	require.NoError(t, err)
+	require.Equal(t, trace.Hash(), hash)
+
+	require.Equal(t, "uatom", ParseDenomTrace("uatom").IBCDenom(), "base denomination must not be hashed")
+}
+
+func TestDenomTraceHashWith(t *testing.T) {
+	trace := ParseDenomTrace("transfer/channelidone/uatom")
+
+	// the default hasher keeps the canonical SHA256 voucher denomination
+	require.Equal(t, "ibc/5df315d7c8ecb6e1cffaab4088ffe54736bd8af0628241816deb4e7e409f9562", trace.IBCDenom())
+	require.Equal(t, trace.Hash(), trace.HashWith(sha256.New))
+	require.Equal(t, trace.IBCDenom(), trace.IBCDenomWith(DefaultDenomTraceHasher))
+
+	shortHash := trace.HashWith(sha1.New)
+	require.Len(t, shortHash, sha1.Size)
+	require.Equal(t, fmt.Sprintf("%s/%x", DenomPrefix, []byte(shortHash)), trace.IBCDenomWith(sha1.New))
+	require.NoError(t, sdk.ValidateDenom(trace.IBCDenomWith(sha1.New)))
+	require.NotEqual(t, trace.HashWith(sha1.New), trace.WithChainID("testchain").HashWith(sha1.New))
+
+	require.Equal(t, "uatom", ParseDenomTrace("uatom").IBCDenomWith(sha1.New), "base denomination must not be hashed")
+
+	hash, err := ParseHexHashWithSize(strings.TrimPrefix(trace.IBCDenomWith(sha1.New), DenomPrefix+"/"), sha1.Size)
+	require.NoError(t, err)
+	require.Equal(t, shortHash, hash)
+
+	_, err = ParseHexHash(shortHash.String())
+	require.Error(t, err, "hash length doesn't match the default hasher")
+}
+
+func TestValidateDenomTraceHasher(t *testing.T) {
+	require.NoError(t, ValidateDenomTraceHasher(DefaultDenomTraceHasher))
+	require.NoError(t, ValidateDenomTraceHasher(sha1.New))
+	require.NoError(t, ValidateDenomTraceHasher(sha256.New))
+	require.Error(t, ValidateDenomTraceHasher(nil))
+	require.Error(t, ValidateDenomTraceHasher(sha512.New), "hash doesn't fit in a denomination")
+}
+
+func TestDenomTraceGetFirstHop(t *testing.T) {
+	portID, channelID, found := ParseDenomTrace("transfer/channelidone/transfer/channelidtwo/uatom").GetFirstHop()
+	require.True(t, found)
+	require.Equal(t, "transfer", portID)
+	require.Equal(t, "channelidone", channelID)
+
+	_, _, found = ParseDenomTrace("uatom").GetFirstHop()
+	require.False(t, found, "native denomination must not have a first hop")
+}
+
+func TestDenomTraceHops(t *testing.T) {
+	require.Equal(t, 0, ParseDenomTrace("uatom").Hops())
+	require.Equal(t, 1, ParseDenomTrace("transfer/channelidone/uatom").Hops())
+	require.Equal(t, 2, ParseDenomTrace("transfer/channelidone/transfer/channelidtwo/uatom").Hops())
+}
+
+func TestDenomTraceWithChainID(t *testing.T) {
+	trace := ParseDenomTrace("transfer/channelidone/uatom")
+	chainTrace := trace.WithChainID("testchain")
+
+	require.Equal(t, trace.GetFullDenomPath(), chainTrace.GetFullDenomPath(), "chain ID must not be part of the full path")
+	require.NotEqual(t, trace.IBCDenom(), chainTrace.IBCDenom())
+	require.NotEqual(t, chainTrace.IBCDenom(), trace.WithChainID("otherchain").IBCDenom())
+	require.Equal(t, chainTrace.IBCDenom(), ParseDenomTrace(trace.GetFullDenomPath()).WithChainID("testchain").IBCDenom(), "hash is not reproducible")
+}
+
+func TestDenomTraceValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trace    DenomTrace
+		expError bool
+	}{
+		{"base denom only", DenomTrace{BaseDenom: "uatom"}, false},
+		{"empty base denom", DenomTrace{Path: "transfer/channelidone"}, true},
+		{"valid single trace info", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone"}, false},
+		{"valid multiple trace info", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone/transfer/channelidtwo"}, false},
+		{"single segment", DenomTrace{BaseDenom: "uatom", Path: "transfer"}, true},
+		{"odd number of segments", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone/transfer"}, true},
+		{"empty identifiers", DenomTrace{BaseDenom: "uatom", Path: "/"}, true},
+		{"invalid channel identifier", DenomTrace{BaseDenom: "uatom", Path: "transfer/ch"}, true},
+		{"valid chain ID", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone", ChainID: "testchain"}, false},
+		{"invalid chain ID", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelidone", ChainID: "test:chain"}, true},
+		{"base denom with a separator", DenomTrace{BaseDenom: "a/b", Path: "transfer/channelidone"}, true},
+		{"base denom only with a separator", DenomTrace{BaseDenom: "a/b"}, true},
+		{"base denom with a trace path", DenomTrace{BaseDenom: "transfer/channelidtwo/uatom", Path: "transfer/channelidone"}, true},
+		{"parsed base denom with a separator", ParseDenomTrace("transfer/channelidone/a/b"), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.trace.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidatePrefixedDenom(t *testing.T) {
+	require.Error(t, sdk.ValidateDenom(longDenomPath), "trace path must be longer than a coin denomination")
+	require.NoError(t, ValidatePrefixedDenom(longDenomPath))
+	require.NoError(t, ValidatePrefixedDenom("uatom"))
+	require.Error(t, ValidatePrefixedDenom(tooLongDenomPath))
+	require.Error(t, ValidatePrefixedDenom("transfer/channel-0/uatom"), "invalid character")
+	require.Error(t, ValidatePrefixedDenom(""))
+}
+
+func TestParseHexHash(t *testing.T) {
+	_, err := ParseHexHash("invalidhash")
+	require.Error(t, err)
+
+	_, err = ParseHexHash("abcdef")
+	require.Error(t, err, "invalid hash length")
+}
+
+func TestVerifyDenomTrace(t *testing.T) {
+	denomTrace := NewDenomTrace("transfer/channelidone", "uatom")
+
+	testCases := []struct {
+		name      string
+		hash      string
+		path      string
+		baseDenom string
+		expValid  bool
+		expPass   bool
+	}{
+		{"matching hash", denomTrace.Hash().String(), "transfer/channelidone", "uatom", true, true},
+		{"matching voucher denomination", denomTrace.IBCDenom(), "transfer/channelidone", "uatom", true, true},
+		{"mismatched path", denomTrace.Hash().String(), "transfer/channelidtwo", "uatom", false, true},
+		{"mismatched base denomination", denomTrace.Hash().String(), "transfer/channelidone", "uosmo", false, true},
+		{"hash with a chain ID", denomTrace.WithChainID("cosmoshub").Hash().String(), "transfer/channelidone", "uatom", false, true},
+		{"invalid hash", "invalidhash", "transfer/channelidone", "uatom", false, false},
+		{"invalid path", denomTrace.Hash().String(), "transfer", "uatom", false, false},
+		{"ambiguous base denomination", NewDenomTrace("transfer/channelidone/transfer/channelidtwo", "uatom").Hash().String(), "transfer/channelidone", "transfer/channelidtwo/uatom", false, false},
+	}
+
+	for _, tc := range testCases {
+		valid, err := VerifyDenomTrace(tc.hash, tc.path, tc.baseDenom)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+		require.Equal(t, tc.expValid, valid, tc.name)
+	}
+}
@@ -0,0 +1,2345 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/ibc/20-transfer/types/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_x_ibc_04_channel_types "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FungibleTokenPacketData defines the ICS20 fungible token packet payload. It is
+// encoded with protobuf on the channels negotiated with the protobuf version.
+type FungibleTokenPacketData struct {
+	// the tokens to be transferred
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// the sender address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver" yaml:"receiver"`
+	// optional application-layer metadata
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty" yaml:"memo"`
+	// optional address on the sending chain the tokens are refunded to on a
+	// timeout or an error acknowledgement, instead of the sender (see
+	// GetRefundAddress)
+	RefundAddress string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address,omitempty"`
+	// optional minimum amount of one of the transferred denominations the
+	// receiver must be credited with. The receive fails with an error
+	// acknowledgement otherwise, so that the sender is refunded.
+	MinReceived *types.Coin `protobuf:"bytes,6,opt,name=min_received,json=minReceived,proto3" json:"min_received,omitempty" yaml:"min_received,omitempty"`
+}
+
+func (m *FungibleTokenPacketData) Reset()      { *m = FungibleTokenPacketData{} }
+func (*FungibleTokenPacketData) ProtoMessage() {}
+func (*FungibleTokenPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2979e3085e18bdce, []int{0}
+}
+func (m *FungibleTokenPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FungibleTokenPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FungibleTokenPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FungibleTokenPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FungibleTokenPacketData.Merge(m, src)
+}
+func (m *FungibleTokenPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *FungibleTokenPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FungibleTokenPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FungibleTokenPacketData proto.InternalMessageInfo
+
+// DenomTrace contains the base denomination for ICS20 fungible tokens and the
+// source tracing information path.
+type DenomTrace struct {
+	// path defines the chain of port/channel identifiers used for tracing the
+	// source of the fungible token (eg: transfer/channelA/transfer/channelB)
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path" yaml:"path"`
+	// base denomination of the relayed fungible token
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom" yaml:"base_denom"`
+	// optional identifier of the chain the vouchers were received from (i.e the
+	// counterparty chain of the first channel of the path). It is only set if
+	// the chain ID denomination scheme is enabled (see the transfer keeper
+	// WithChainIDDenomTraces).
+	ChainID string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id,omitempty"`
+}
+
+func (m *DenomTrace) Reset()      { *m = DenomTrace{} }
+func (*DenomTrace) ProtoMessage() {}
+func (*DenomTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2979e3085e18bdce, []int{1}
+}
+func (m *DenomTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTrace.Merge(m, src)
+}
+func (m *DenomTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTrace proto.InternalMessageInfo
+
+func (m *DenomTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DenomTrace) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *DenomTrace) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+// DenomEnabled defines whether the transfers of a denomination are enabled,
+// overriding the module wide parameter. The denomination is the one held on
+// chain, i.e the hashed denomination for IBC vouchers.
+type DenomEnabled struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom" yaml:"denom"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled" yaml:"enabled"`
+}
+
+func (m *DenomEnabled) Reset()      { *m = DenomEnabled{} }
+func (*DenomEnabled) ProtoMessage() {}
+func (*DenomEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2979e3085e18bdce, []int{2}
+}
+func (m *DenomEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomEnabled.Merge(m, src)
+}
+func (m *DenomEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomEnabled proto.InternalMessageInfo
+
+func (m *DenomEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// Params defines the parameters of the IBC transfer module
+type Params struct {
+	// enables the transfers out of the chain
+	SendEnabled bool `protobuf:"varint,1,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled" yaml:"send_enabled"`
+	// enables the transfers into the chain
+	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled" yaml:"receive_enabled"`
+	// overrides SendEnabled for some denominations
+	DenomSendEnabled []DenomEnabled `protobuf:"bytes,3,rep,name=denom_send_enabled,json=denomSendEnabled,proto3" json:"denom_send_enabled" yaml:"denom_send_enabled"`
+	// overrides ReceiveEnabled for some denominations
+	DenomReceiveEnabled []DenomEnabled `protobuf:"bytes,4,rep,name=denom_receive_enabled,json=denomReceiveEnabled,proto3" json:"denom_receive_enabled" yaml:"denom_receive_enabled"`
+	// rejects the transfers to the sender over a loopback channel
+	SelfLoopbackCheck bool `protobuf:"varint,5,opt,name=self_loopback_check,json=selfLoopbackCheck,proto3" json:"self_loopback_check" yaml:"self_loopback_check"`
+	// number of blocks between two snapshots of the escrow balances, 0 disables them
+	EscrowSnapshotInterval uint64 `protobuf:"varint,6,opt,name=escrow_snapshot_interval,json=escrowSnapshotInterval,proto3" json:"escrow_snapshot_interval" yaml:"escrow_snapshot_interval"`
+	// allows the transfer channels to be opened with the ORDERED ordering
+	AllowOrderedChannels bool `protobuf:"varint,7,opt,name=allow_ordered_channels,json=allowOrderedChannels,proto3" json:"allow_ordered_channels" yaml:"allow_ordered_channels"`
+	// maximum number of {portID}/{channelID} hops of the traces of the received vouchers, 0 disables the limit
+	MaxDenomTraceDepth uint64 `protobuf:"varint,8,opt,name=max_denom_trace_depth,json=maxDenomTraceDepth,proto3" json:"max_denom_trace_depth" yaml:"max_denom_trace_depth"`
+	// number of the latest snapshots of the escrow balances that are kept, 0 keeps all of them
+	EscrowSnapshotRetention uint64 `protobuf:"varint,9,opt,name=escrow_snapshot_retention,json=escrowSnapshotRetention,proto3" json:"escrow_snapshot_retention" yaml:"escrow_snapshot_retention"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2979e3085e18bdce, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *Params) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+func (m *Params) GetDenomSendEnabled() []DenomEnabled {
+	if m != nil {
+		return m.DenomSendEnabled
+	}
+	return nil
+}
+
+func (m *Params) GetDenomReceiveEnabled() []DenomEnabled {
+	if m != nil {
+		return m.DenomReceiveEnabled
+	}
+	return nil
+}
+
+func (m *Params) GetSelfLoopbackCheck() bool {
+	if m != nil {
+		return m.SelfLoopbackCheck
+	}
+	return false
+}
+
+func (m *Params) GetEscrowSnapshotInterval() uint64 {
+	if m != nil {
+		return m.EscrowSnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAllowOrderedChannels() bool {
+	if m != nil {
+		return m.AllowOrderedChannels
+	}
+	return false
+}
+
+func (m *Params) GetMaxDenomTraceDepth() uint64 {
+	if m != nil {
+		return m.MaxDenomTraceDepth
+	}
+	return 0
+}
+
+func (m *Params) GetEscrowSnapshotRetention() uint64 {
+	if m != nil {
+		return m.EscrowSnapshotRetention
+	}
+	return 0
+}
+
+// RefundStuckPacketProposal defines a governance proposal to refund the tokens
+// of a packet that can't be timed out through its channel, e.g because of a bug
+// on either chain. The full packet is required, since only its commitment is
+// stored by the sending chain.
+type RefundStuckPacketProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description" yaml:"description"`
+	// the stuck packet, which isn't a protobuf message and is encoded with amino
+	Packet github_com_cosmos_cosmos_sdk_x_ibc_04_channel_types.Packet `protobuf:"bytes,3,opt,name=packet,proto3,customtype=github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types.Packet" json:"packet" yaml:"packet"`
+}
+
+func (m *RefundStuckPacketProposal) Reset()      { *m = RefundStuckPacketProposal{} }
+func (*RefundStuckPacketProposal) ProtoMessage() {}
+func (*RefundStuckPacketProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2979e3085e18bdce, []int{4}
+}
+func (m *RefundStuckPacketProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundStuckPacketProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundStuckPacketProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundStuckPacketProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundStuckPacketProposal.Merge(m, src)
+}
+func (m *RefundStuckPacketProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundStuckPacketProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundStuckPacketProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundStuckPacketProposal proto.InternalMessageInfo
+
+// UpdateParamsProposal defines a governance proposal to update all the
+// parameters of the IBC transfer module, when the authority of the transfer
+// keeper is the governance module account (see MsgUpdateParams).
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description" yaml:"description"`
+	// the new parameters, replacing all the current ones
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2979e3085e18bdce, []int{5}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FungibleTokenPacketData)(nil), "cosmos_sdk.x.ibc.transfer.v1.FungibleTokenPacketData")
+	proto.RegisterType((*DenomTrace)(nil), "cosmos_sdk.x.ibc.transfer.v1.DenomTrace")
+	proto.RegisterType((*DenomEnabled)(nil), "cosmos_sdk.x.ibc.transfer.v1.DenomEnabled")
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.ibc.transfer.v1.Params")
+	proto.RegisterType((*RefundStuckPacketProposal)(nil), "cosmos_sdk.x.ibc.transfer.v1.RefundStuckPacketProposal")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "cosmos_sdk.x.ibc.transfer.v1.UpdateParamsProposal")
+}
+
+func init() {
+	proto.RegisterFile("x/ibc/20-transfer/types/types.proto", fileDescriptor_2979e3085e18bdce)
+}
+
+var fileDescriptor_2979e3085e18bdce = []byte{
+	// 1127 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xb6, 0xae, 0xe3, 0x8e, 0xdd, 0x94, 0x4e, 0xda, 0x74, 0x53, 0x5a, 0x4f, 0x98, 0xf2,
+	0x51, 0x01, 0xb1, 0xdb, 0xb4, 0x55, 0x45, 0x2a, 0x40, 0x75, 0x02, 0x28, 0x80, 0x20, 0x9a, 0x14,
+	0x24, 0x90, 0xaa, 0xd5, 0x78, 0x77, 0x12, 0xaf, 0xbc, 0x5f, 0xec, 0xae, 0xd3, 0xe4, 0xc0, 0x85,
+	0x13, 0x47, 0xb8, 0x21, 0x24, 0xa4, 0x48, 0xdc, 0x10, 0x7f, 0x48, 0x8f, 0x3d, 0x22, 0x0e, 0x03,
+	0x4a, 0x2e, 0x68, 0x8f, 0xbe, 0x23, 0xa1, 0xf9, 0xd8, 0xec, 0x3a, 0x76, 0x22, 0xb8, 0x71, 0x49,
+	0xfc, 0x7e, 0xbf, 0xf7, 0xde, 0xbc, 0x8f, 0xdd, 0xdf, 0x0e, 0xb8, 0xb9, 0xdb, 0x71, 0x7b, 0x76,
+	0x67, 0xf9, 0xf6, 0x52, 0x1a, 0xd3, 0x20, 0xd9, 0x62, 0x71, 0x27, 0xdd, 0x8b, 0x58, 0xa2, 0xfe,
+	0xb6, 0xa3, 0x38, 0x4c, 0x43, 0x78, 0xdd, 0x0e, 0x13, 0x3f, 0x4c, 0xac, 0xc4, 0x19, 0xb4, 0x77,
+	0xdb, 0x6e, 0xcf, 0x6e, 0xe7, 0xce, 0xed, 0x9d, 0x3b, 0xd7, 0x5e, 0x4d, 0xfb, 0x6e, 0xec, 0x58,
+	0x11, 0x8d, 0xd3, 0xbd, 0x8e, 0x0c, 0xe8, 0x6c, 0x87, 0xdb, 0x61, 0xf1, 0x4b, 0x65, 0xb9, 0x76,
+	0x69, 0x22, 0x31, 0xfe, 0xb9, 0x0a, 0xae, 0xbe, 0x3f, 0x0c, 0xb6, 0xdd, 0x9e, 0xc7, 0x1e, 0x87,
+	0x03, 0x16, 0x6c, 0x50, 0x7b, 0xc0, 0xd2, 0x35, 0x9a, 0x52, 0xb8, 0x0b, 0x6a, 0xd4, 0x0f, 0x87,
+	0x41, 0x6a, 0x1a, 0x8b, 0x67, 0x6f, 0x35, 0x96, 0xe7, 0xda, 0xa5, 0x2a, 0x76, 0xee, 0xb4, 0x57,
+	0x43, 0x37, 0xe8, 0x7e, 0xf4, 0x8c, 0xa3, 0x4a, 0xc6, 0x91, 0x76, 0x1d, 0x71, 0x74, 0x61, 0x8f,
+	0xfa, 0xde, 0x0a, 0x56, 0x36, 0xfe, 0xe5, 0x0f, 0x74, 0x6b, 0xdb, 0x4d, 0xfb, 0xc3, 0x5e, 0xdb,
+	0x0e, 0xfd, 0x8e, 0xca, 0xa0, 0xff, 0x2d, 0x25, 0xce, 0x40, 0x57, 0x23, 0x72, 0x25, 0x44, 0x27,
+	0x81, 0x77, 0x41, 0x2d, 0x61, 0x81, 0xc3, 0x62, 0xf3, 0xcc, 0xa2, 0x71, 0xeb, 0x7c, 0xf7, 0x45,
+	0x71, 0x80, 0x42, 0x8a, 0x03, 0x94, 0x8d, 0x89, 0x26, 0xe0, 0x43, 0x50, 0x8f, 0x99, 0xcd, 0xdc,
+	0x1d, 0x16, 0x9b, 0x67, 0x65, 0x18, 0xca, 0x38, 0x3a, 0xc2, 0x46, 0x1c, 0x5d, 0x54, 0x81, 0x39,
+	0x82, 0xc9, 0x11, 0x09, 0xef, 0x83, 0xaa, 0xcf, 0xfc, 0xd0, 0xac, 0xca, 0xc0, 0x97, 0x32, 0x8e,
+	0x66, 0x85, 0xfd, 0x66, 0xe8, 0xbb, 0x29, 0xf3, 0xa3, 0x74, 0x6f, 0xc4, 0x51, 0x43, 0x85, 0x0b,
+	0x1c, 0x13, 0xe9, 0x0e, 0xb7, 0xc0, 0x6c, 0xcc, 0xb6, 0x86, 0x81, 0x63, 0x51, 0xc7, 0x89, 0x59,
+	0x92, 0x98, 0xe7, 0x64, 0x82, 0x77, 0x33, 0x8e, 0xcc, 0x71, 0x66, 0x2c, 0x15, 0xca, 0x2b, 0x99,
+	0xee, 0x81, 0xc9, 0x05, 0x45, 0x3d, 0x52, 0x0c, 0x4c, 0x40, 0xd3, 0x77, 0x03, 0x4b, 0x97, 0xeb,
+	0x98, 0xb5, 0x45, 0xe3, 0xa4, 0x85, 0x3c, 0xcc, 0x38, 0x9a, 0x2f, 0x3b, 0x8f, 0x1d, 0x7c, 0x43,
+	0xf7, 0x30, 0x95, 0xc7, 0xa4, 0xe1, 0xbb, 0x01, 0xd1, 0xf8, 0x4a, 0xfd, 0xdb, 0x7d, 0x54, 0xf9,
+	0x61, 0x1f, 0x55, 0xf0, 0x81, 0x01, 0xc0, 0x1a, 0x0b, 0x42, 0xff, 0x71, 0x4c, 0x6d, 0x06, 0xdf,
+	0x00, 0xd5, 0x88, 0xa6, 0x7d, 0xd3, 0x90, 0xbd, 0x5e, 0xcd, 0x38, 0x92, 0x76, 0x31, 0x22, 0x61,
+	0x61, 0x22, 0x41, 0xd8, 0x05, 0xa0, 0x47, 0x13, 0x66, 0x39, 0x22, 0x5e, 0xef, 0xf3, 0x66, 0xc6,
+	0x51, 0x09, 0x1d, 0x71, 0x74, 0x49, 0x05, 0x16, 0x18, 0x26, 0xe7, 0x85, 0x21, 0x4f, 0x85, 0x5f,
+	0x80, 0xba, 0xdd, 0xa7, 0x6e, 0x60, 0xb9, 0x8e, 0x5e, 0xed, 0x3b, 0x07, 0x1c, 0xcd, 0xac, 0x0a,
+	0x6c, 0x7d, 0x2d, 0xe3, 0x08, 0xe6, 0xf4, 0x58, 0xb3, 0x0b, 0x2a, 0xe9, 0x24, 0x87, 0xc9, 0x8c,
+	0x04, 0xd7, 0x9d, 0x95, 0xba, 0x68, 0xf0, 0xaf, 0x7d, 0x64, 0xe0, 0x6f, 0x0c, 0xd0, 0x94, 0xc7,
+	0xbd, 0x17, 0xd0, 0x9e, 0xc7, 0x1c, 0xd8, 0x01, 0xe7, 0x54, 0xd1, 0xaa, 0xcf, 0x85, 0x8c, 0xa3,
+	0x73, 0x79, 0xbd, 0x4d, 0x95, 0x5a, 0x97, 0xaa, 0x60, 0xf8, 0x00, 0xcc, 0x30, 0x15, 0x2b, 0xfb,
+	0xac, 0x77, 0x6f, 0x64, 0x1c, 0xe5, 0xd0, 0x88, 0xa3, 0x59, 0x15, 0xa4, 0x01, 0x4c, 0x72, 0xaa,
+	0x54, 0xc4, 0xaf, 0x75, 0x50, 0xdb, 0xa0, 0x31, 0xf5, 0x13, 0xf8, 0x21, 0x68, 0x8a, 0x27, 0xdb,
+	0xca, 0x53, 0x1a, 0x32, 0xe5, 0x6b, 0x19, 0x47, 0x63, 0xf8, 0x88, 0xa3, 0xb9, 0xe2, 0x85, 0xb0,
+	0x8e, 0x92, 0x37, 0x84, 0x99, 0xb7, 0xf2, 0x39, 0xb8, 0xa8, 0xd7, 0x6d, 0x8d, 0x57, 0xb8, 0x94,
+	0x71, 0x74, 0x9c, 0x1a, 0x71, 0x34, 0x3f, 0xf6, 0xa6, 0x14, 0x49, 0x67, 0x35, 0x92, 0xe7, 0xfd,
+	0xde, 0x00, 0x50, 0xf6, 0x6e, 0x8d, 0x95, 0x7a, 0x56, 0xea, 0xc5, 0xeb, 0xed, 0xd3, 0x54, 0xab,
+	0x5d, 0x9e, 0x75, 0xf7, 0x81, 0x96, 0x91, 0x29, 0xd9, 0x8a, 0x45, 0x4e, 0x72, 0x98, 0xbc, 0x20,
+	0xc1, 0xcd, 0x52, 0xaf, 0x3f, 0x19, 0xe0, 0x8a, 0xf2, 0x3c, 0xde, 0x72, 0xf5, 0x3f, 0x97, 0xf5,
+	0xb6, 0x2e, 0x6b, 0x7a, 0xc2, 0x11, 0x47, 0xd7, 0xcb, 0x95, 0x4d, 0x8c, 0x6b, 0x4e, 0xe2, 0x64,
+	0x7c, 0x66, 0x0c, 0xcc, 0x25, 0xcc, 0xdb, 0xb2, 0xbc, 0x30, 0x8c, 0x7a, 0xd4, 0x1e, 0x58, 0x76,
+	0x9f, 0xd9, 0x03, 0x29, 0x1c, 0xf5, 0xee, 0xfd, 0x8c, 0xa3, 0x69, 0xf4, 0x88, 0xa3, 0x6b, 0xf9,
+	0x96, 0x27, 0x48, 0x4c, 0x2e, 0x09, 0xf4, 0x63, 0x0d, 0xae, 0x0a, 0x0c, 0xee, 0x01, 0x93, 0x25,
+	0x76, 0x1c, 0x3e, 0xb5, 0x92, 0x80, 0x46, 0x49, 0x3f, 0x4c, 0x2d, 0x37, 0x48, 0x59, 0xbc, 0x43,
+	0x3d, 0x29, 0x1f, 0x55, 0x25, 0x52, 0x27, 0xf9, 0x14, 0x22, 0x75, 0x92, 0x07, 0x26, 0xf3, 0x8a,
+	0xda, 0xd4, 0xcc, 0xba, 0x26, 0xe0, 0x57, 0x60, 0x9e, 0x7a, 0x5e, 0xf8, 0xd4, 0x0a, 0x63, 0x87,
+	0xc5, 0xcc, 0xb1, 0xec, 0x3e, 0x0d, 0x02, 0xe6, 0x25, 0xe6, 0x8c, 0x6c, 0x52, 0x4a, 0xd4, 0x74,
+	0x8f, 0x42, 0xa2, 0xa6, 0xf3, 0x98, 0x5c, 0x96, 0xc4, 0xa7, 0x0a, 0x5f, 0xd5, 0x30, 0xf4, 0xc0,
+	0x15, 0x9f, 0xee, 0x2a, 0xe9, 0xb0, 0x52, 0xa1, 0x52, 0x96, 0xc3, 0xa2, 0xb4, 0x6f, 0xd6, 0x65,
+	0xab, 0x6f, 0x89, 0x1d, 0x4e, 0x75, 0x28, 0x76, 0x38, 0x95, 0xc6, 0x04, 0xfa, 0x74, 0xb7, 0xd0,
+	0xbe, 0x35, 0x01, 0xc2, 0xaf, 0xc1, 0xc2, 0xf1, 0xa9, 0xc4, 0x2c, 0x65, 0x41, 0xea, 0x86, 0x81,
+	0x79, 0x5e, 0x9e, 0xf8, 0x28, 0xe3, 0xe8, 0x64, 0xa7, 0x11, 0x47, 0x8b, 0xd3, 0xa7, 0x7b, 0xe4,
+	0x82, 0xc9, 0xd5, 0xf1, 0xf1, 0x92, 0x9c, 0x29, 0xc9, 0xc5, 0x8f, 0x67, 0xc0, 0x02, 0x91, 0x5f,
+	0x8a, 0xcd, 0x74, 0x68, 0x0f, 0xd4, 0xc7, 0x7b, 0x23, 0x0e, 0xa3, 0x30, 0xa1, 0x9e, 0x10, 0xb0,
+	0xd4, 0x4d, 0x3d, 0x56, 0x16, 0x30, 0x09, 0x14, 0x02, 0x26, 0x4d, 0x4c, 0x14, 0x0c, 0x3f, 0x00,
+	0x0d, 0x47, 0x1c, 0xea, 0x46, 0xb2, 0x13, 0x25, 0xd6, 0xaf, 0x64, 0x1c, 0x95, 0xe1, 0x11, 0x47,
+	0x30, 0x7f, 0xea, 0x8f, 0x40, 0x4c, 0xca, 0x2e, 0x70, 0x08, 0x6a, 0x91, 0xac, 0x45, 0xca, 0x75,
+	0xb3, 0xfb, 0x44, 0xbc, 0x47, 0xbf, 0x73, 0xb4, 0x72, 0xea, 0x55, 0x40, 0xdd, 0x84, 0x6e, 0xdf,
+	0x5b, 0xd2, 0xdb, 0xd6, 0x77, 0x03, 0xd5, 0x95, 0xb8, 0x02, 0xa8, 0x9c, 0xc5, 0x15, 0x40, 0xd9,
+	0x98, 0x68, 0x62, 0xa5, 0x99, 0x7f, 0xb1, 0xe4, 0x70, 0xfe, 0x36, 0xc0, 0xe5, 0xcf, 0x22, 0x87,
+	0xa6, 0x4c, 0x29, 0xea, 0xff, 0x60, 0x2e, 0x4f, 0xc4, 0x5c, 0x44, 0x2d, 0x72, 0x2e, 0x8d, 0xe5,
+	0x97, 0x4f, 0xd7, 0x22, 0x55, 0x77, 0x17, 0xe5, 0x77, 0x2c, 0x15, 0x5b, 0xee, 0x5f, 0xd8, 0xb2,
+	0x7f, 0xf1, 0x63, 0xbc, 0xff, 0xee, 0x27, 0xcf, 0x0e, 0x5a, 0xc6, 0xf3, 0x83, 0x96, 0xf1, 0xe7,
+	0x41, 0xcb, 0xf8, 0xee, 0xb0, 0x55, 0x79, 0x7e, 0xd8, 0xaa, 0xfc, 0x76, 0xd8, 0xaa, 0x7c, 0x79,
+	0xef, 0x5f, 0xac, 0x61, 0xe2, 0x42, 0xda, 0xab, 0xc9, 0x2b, 0xe3, 0xdd, 0x7f, 0x06, 0x00, 0x26,
+	0x3f, 0x15, 0x41, 0xb2, 0x0a, 0x00, 0x00,
+}
+
+func (this *DenomTrace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTrace)
+	if !ok {
+		that2, ok := that.(DenomTrace)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if this.ChainID != that1.ChainID {
+		return false
+	}
+	return true
+}
+func (this *DenomEnabled) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomEnabled)
+	if !ok {
+		that2, ok := that.(DenomEnabled)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SendEnabled != that1.SendEnabled {
+		return false
+	}
+	if this.ReceiveEnabled != that1.ReceiveEnabled {
+		return false
+	}
+	if len(this.DenomSendEnabled) != len(that1.DenomSendEnabled) {
+		return false
+	}
+	for i := range this.DenomSendEnabled {
+		if !this.DenomSendEnabled[i].Equal(&that1.DenomSendEnabled[i]) {
+			return false
+		}
+	}
+	if len(this.DenomReceiveEnabled) != len(that1.DenomReceiveEnabled) {
+		return false
+	}
+	for i := range this.DenomReceiveEnabled {
+		if !this.DenomReceiveEnabled[i].Equal(&that1.DenomReceiveEnabled[i]) {
+			return false
+		}
+	}
+	if this.SelfLoopbackCheck != that1.SelfLoopbackCheck {
+		return false
+	}
+	if this.EscrowSnapshotInterval != that1.EscrowSnapshotInterval {
+		return false
+	}
+	if this.AllowOrderedChannels != that1.AllowOrderedChannels {
+		return false
+	}
+	if this.MaxDenomTraceDepth != that1.MaxDenomTraceDepth {
+		return false
+	}
+	if this.EscrowSnapshotRetention != that1.EscrowSnapshotRetention {
+		return false
+	}
+	return true
+}
+func (this *RefundStuckPacketProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefundStuckPacketProposal)
+	if !ok {
+		that2, ok := that.(RefundStuckPacketProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Packet.Equal(that1.Packet) {
+		return false
+	}
+	return true
+}
+func (this *UpdateParamsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateParamsProposal)
+	if !ok {
+		that2, ok := that.(UpdateParamsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FungibleTokenPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinReceived != nil {
+		{
+			size, err := m.MinReceived.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscrowSnapshotRetention != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EscrowSnapshotRetention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxDenomTraceDepth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxDenomTraceDepth))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AllowOrderedChannels {
+		i--
+		if m.AllowOrderedChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EscrowSnapshotInterval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EscrowSnapshotInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SelfLoopbackCheck {
+		i--
+		if m.SelfLoopbackCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DenomReceiveEnabled) > 0 {
+		for iNdEx := len(m.DenomReceiveEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomReceiveEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomSendEnabled) > 0 {
+		for iNdEx := len(m.DenomSendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomSendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundStuckPacketProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundStuckPacketProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundStuckPacketProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Packet.Size()
+		i -= size
+		if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FungibleTokenPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MinReceived != nil {
+		l = m.MinReceived.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if len(m.DenomSendEnabled) > 0 {
+		for _, e := range m.DenomSendEnabled {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DenomReceiveEnabled) > 0 {
+		for _, e := range m.DenomReceiveEnabled {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SelfLoopbackCheck {
+		n += 2
+	}
+	if m.EscrowSnapshotInterval != 0 {
+		n += 1 + sovTypes(uint64(m.EscrowSnapshotInterval))
+	}
+	if m.AllowOrderedChannels {
+		n += 2
+	}
+	if m.MaxDenomTraceDepth != 0 {
+		n += 1 + sovTypes(uint64(m.MaxDenomTraceDepth))
+	}
+	if m.EscrowSnapshotRetention != 0 {
+		n += 1 + sovTypes(uint64(m.EscrowSnapshotRetention))
+	}
+	return n
+}
+
+func (m *RefundStuckPacketProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FungibleTokenPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FungibleTokenPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinReceived == nil {
+				m.MinReceived = &types.Coin{}
+			}
+			if err := m.MinReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomSendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomSendEnabled = append(m.DenomSendEnabled, DenomEnabled{})
+			if err := m.DenomSendEnabled[len(m.DenomSendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomReceiveEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomReceiveEnabled = append(m.DenomReceiveEnabled, DenomEnabled{})
+			if err := m.DenomReceiveEnabled[len(m.DenomReceiveEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfLoopbackCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelfLoopbackCheck = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSnapshotInterval", wireType)
+			}
+			m.EscrowSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowSnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOrderedChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowOrderedChannels = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomTraceDepth", wireType)
+			}
+			m.MaxDenomTraceDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomTraceDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSnapshotRetention", wireType)
+			}
+			m.EscrowSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowSnapshotRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundStuckPacketProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundStuckPacketProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundStuckPacketProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
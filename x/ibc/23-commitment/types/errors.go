@@ -9,6 +9,8 @@ const SubModuleName string = "commitment"
 
 // IBC connection sentinel errors
 var (
-	ErrInvalidProof  = sdkerrors.Register(SubModuleName, 1, "invalid proof")
-	ErrInvalidPrefix = sdkerrors.Register(SubModuleName, 2, "invalid prefix")
+	ErrInvalidProof     = sdkerrors.Register(SubModuleName, 1, "invalid proof")
+	ErrInvalidPrefix    = sdkerrors.Register(SubModuleName, 2, "invalid prefix")
+	ErrKeyMismatch      = sdkerrors.Register(SubModuleName, 3, "proof key mismatch")
+	ErrInvalidProofSpec = sdkerrors.Register(SubModuleName, 4, "proof doesn't match the proof specs")
 )
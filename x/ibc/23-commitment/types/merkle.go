@@ -1,12 +1,13 @@
 package types
 
 import (
-	"errors"
+	"bytes"
 	"net/url"
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 )
@@ -133,7 +134,7 @@ func ApplyPrefix(prefix exported.Prefix, path string) (MerklePath, error) {
 	}
 
 	if prefix == nil || prefix.IsEmpty() {
-		return MerklePath{}, errors.New("prefix can't be empty")
+		return MerklePath{}, sdkerrors.Wrap(ErrInvalidPrefix, "prefix can't be empty")
 	}
 	return NewMerklePath([]string{string(prefix.Bytes()), path}), nil
 }
@@ -154,23 +155,97 @@ func (MerkleProof) GetCommitmentType() exported.Type {
 }
 
 // VerifyMembership verifies the membership pf a merkle proof against the given root, path, and value.
+// The proof must match the SDK multistore proof specs.
 func (proof MerkleProof) VerifyMembership(root exported.Root, path exported.Path, value []byte) error {
+	return proof.VerifyMembershipWithSpecs(GetSDKSpecs(), root, path, value)
+}
+
+// VerifyNonMembership verifies the absence of a merkle proof against the given root and path.
+// The proof must match the SDK multistore proof specs.
+func (proof MerkleProof) VerifyNonMembership(root exported.Root, path exported.Path) error {
+	return proof.VerifyNonMembershipWithSpecs(GetSDKSpecs(), root, path)
+}
+
+// VerifyMembershipWithSpecs verifies the membership of a merkle proof against
+// the given root, path, and value. The proof operators must match the specs,
+// which are ordered from the leaf to the root.
+// It returns ErrInvalidProofSpec if the proof structure doesn't match the specs,
+// ErrKeyMismatch if the proof doesn't prove the given path and ErrInvalidProof if
+// it fails to verify.
+func (proof MerkleProof) VerifyMembershipWithSpecs(specs []ProofSpec, root exported.Root, path exported.Path, value []byte) error {
 	if proof.IsEmpty() || root == nil || root.IsEmpty() || path == nil || path.IsEmpty() || len(value) == 0 {
-		return errors.New("empty params or proof")
+		return sdkerrors.Wrap(ErrInvalidProof, "empty params or proof")
 	}
 
-	runtime := rootmulti.DefaultProofRuntime()
-	return runtime.VerifyValue(proof.Proof, root.GetHash(), path.String(), value)
+	return proof.verify(specs, root, path, [][]byte{value})
 }
 
-// VerifyNonMembership verifies the absence of a merkle proof against the given root and path.
-func (proof MerkleProof) VerifyNonMembership(root exported.Root, path exported.Path) error {
+// VerifyNonMembershipWithSpecs verifies the absence of a merkle proof against
+// the given root and path. The proof operators must match the specs, which are
+// ordered from the leaf to the root.
+// It returns ErrInvalidProofSpec if the proof structure doesn't match the specs,
+// ErrKeyMismatch if the proof doesn't prove the given path and ErrInvalidProof if
+// it fails to verify.
+func (proof MerkleProof) VerifyNonMembershipWithSpecs(specs []ProofSpec, root exported.Root, path exported.Path) error {
 	if proof.IsEmpty() || root == nil || root.IsEmpty() || path == nil || path.IsEmpty() {
-		return errors.New("empty params or proof")
+		return sdkerrors.Wrap(ErrInvalidProof, "empty params or proof")
+	}
+
+	return proof.verify(specs, root, path, nil)
+}
+
+// verify checks the proof structure against the specs, decodes the proof
+// operators, checks that their keys match the path and runs them against the
+// root.
+func (proof MerkleProof) verify(specs []ProofSpec, root exported.Root, path exported.Path, args [][]byte) error {
+	if err := ValidateProofSpecs(proof.Proof, specs); err != nil {
+		return err
 	}
 
 	runtime := rootmulti.DefaultProofRuntime()
-	return runtime.VerifyAbsence(proof.Proof, root.GetHash(), path.String())
+	poz, err := runtime.DecodeProof(proof.Proof)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	if err := verifyKeyPath(poz, path); err != nil {
+		return err
+	}
+
+	if err := poz.Verify(root.GetHash(), path.String(), args); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProof, err.Error())
+	}
+	return nil
+}
+
+// verifyKeyPath checks that the keys of the proof operators match the keys of
+// the path. The operators are applied from the leaf to the root, so they consume
+// the path keys in reverse order.
+func verifyKeyPath(poz merkle.ProofOperators, path exported.Path) error {
+	keys, err := merkle.KeyPathToKeys(path.String())
+	if err != nil {
+		return sdkerrors.Wrap(ErrKeyMismatch, err.Error())
+	}
+
+	for i, op := range poz {
+		key := op.GetKey()
+		if len(key) == 0 {
+			continue
+		}
+		if len(keys) == 0 {
+			return sdkerrors.Wrapf(ErrKeyMismatch, "path has no key left for operation #%d with key %s", i, key)
+		}
+		lastKey := keys[len(keys)-1]
+		if !bytes.Equal(lastKey, key) {
+			return sdkerrors.Wrapf(ErrKeyMismatch, "operation #%d: expected key %s, got %s", i, lastKey, key)
+		}
+		keys = keys[:len(keys)-1]
+	}
+
+	if len(keys) != 0 {
+		return sdkerrors.Wrapf(ErrKeyMismatch, "%d path keys not proven", len(keys))
+	}
+	return nil
 }
 
 // IsEmpty returns true if the root is empty
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ProofSpec defines the structure of a single level of a merkle proof, ie the
+// types of proof operators that can prove a key of the path in one tree.
+type ProofSpec struct {
+	Name    string   `json:"name" yaml:"name"`
+	OpTypes []string `json:"op_types" yaml:"op_types"`
+}
+
+// NewProofSpec creates a new ProofSpec instance
+func NewProofSpec(name string, opTypes ...string) ProofSpec {
+	return ProofSpec{
+		Name:    name,
+		OpTypes: opTypes,
+	}
+}
+
+// Proof specs of the trees committed by an SDK-based chain.
+var (
+	// IAVLSpec is the spec of the IAVL trees of the individual stores
+	IAVLSpec = NewProofSpec("iavl", iavl.ProofOpIAVLValue, iavl.ProofOpIAVLAbsence)
+
+	// SimpleSpec is the spec of the simple merkle trees, such as the one of the
+	// multistore that commits to the root of each store
+	SimpleSpec = NewProofSpec("simple", merkle.ProofOpSimpleValue, rootmulti.ProofOpMultiStore)
+)
+
+// GetSDKSpecs returns the proof specs of the multistore proofs of the SDK: an
+// inner IAVL tree proof of the key followed by an outer simple tree proof of the
+// store root. The specs are ordered from the leaf to the root, the same way as
+// the operators of a proof.
+func GetSDKSpecs() []ProofSpec {
+	return []ProofSpec{IAVLSpec, SimpleSpec}
+}
+
+// Matches returns true if the proof operator type is allowed by the spec.
+func (ps ProofSpec) Matches(op merkle.ProofOp) bool {
+	for _, opType := range ps.OpTypes {
+		if op.Type == opType {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements fmt.Stringer.
+func (ps ProofSpec) String() string {
+	return fmt.Sprintf("%s(%s)", ps.Name, strings.Join(ps.OpTypes, ","))
+}
+
+// ValidateProofSpecs checks that the proof has exactly one operator per spec and
+// that each operator matches the spec at the same position.
+func ValidateProofSpecs(proof *merkle.Proof, specs []ProofSpec) error {
+	if len(specs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProofSpec, "proof specs can't be empty")
+	}
+
+	if len(proof.Ops) != len(specs) {
+		return sdkerrors.Wrapf(
+			ErrInvalidProofSpec, "proof has %d operators, expected %d", len(proof.Ops), len(specs),
+		)
+	}
+
+	for i, op := range proof.Ops {
+		if !specs[i].Matches(op) {
+			return sdkerrors.Wrapf(
+				ErrInvalidProofSpec, "operator #%d of type %s doesn't match spec %s", i, op.Type, specs[i],
+			)
+		}
+	}
+	return nil
+}
@@ -12,6 +12,50 @@ func CalculateRoot(ctx sdk.Context) exported.Root {
 	return types.NewMerkleRoot(ctx.BlockHeader().AppHash)
 }
 
+// VerifyMembership verifies a proof that the path, interpreted in the context of
+// the prefix, has been set to the value in the commitment root. The proof must
+// match the ordered list of specs, from the inner tree to the outer one (eg:
+// types.GetSDKSpecs() for multistore proofs). It returns ErrInvalidProofSpec if
+// the proof structure doesn't match the specs, ErrKeyMismatch if the proof is for
+// a different path and ErrInvalidProof if the proof fails to verify against the
+// root and value.
+func VerifyMembership(
+	specs []types.ProofSpec,
+	root exported.Root,
+	proof types.MerkleProof,
+	prefix exported.Prefix,
+	path string,
+	value []byte,
+) error {
+	prefixedPath, err := types.ApplyPrefix(prefix, path)
+	if err != nil {
+		return err
+	}
+
+	return proof.VerifyMembershipWithSpecs(specs, root, prefixedPath, value)
+}
+
+// VerifyNonMembership verifies a proof that the path, interpreted in the context
+// of the prefix, has not been set to any value in the commitment root. The proof
+// must match the ordered list of specs, from the inner tree to the outer one. It
+// returns ErrInvalidProofSpec if the proof structure doesn't match the specs,
+// ErrKeyMismatch if the proof is for a different path and ErrInvalidProof if the
+// proof fails to verify against the root.
+func VerifyNonMembership(
+	specs []types.ProofSpec,
+	root exported.Root,
+	proof types.MerkleProof,
+	prefix exported.Prefix,
+	path string,
+) error {
+	prefixedPath, err := types.ApplyPrefix(prefix, path)
+	if err != nil {
+		return err
+	}
+
+	return proof.VerifyNonMembershipWithSpecs(specs, root, prefixedPath)
+}
+
 // BatchVerifyMembership verifies a proof that many paths have been set to
 // specific values in a commitment. It calls the proof's VerifyMembership method
 // with the calculated root and the provided paths.
@@ -0,0 +1,176 @@
+package commitment_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitment "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment"
+	"github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+const (
+	testPath       = "keys/mykey"
+	testAbsentPath = "keys/myabsentkey"
+)
+
+var (
+	// the test store proofs are two-level: an IAVL proof of the key and a
+	// multistore proof of the IAVL store root
+	sdkSpecs      = types.GetSDKSpecs()
+	reversedSpecs = []types.ProofSpec{types.SimpleSpec, types.IAVLSpec}
+)
+
+type VerifyTestSuite struct {
+	suite.Suite
+
+	store     *rootmulti.Store
+	storeKey  *storetypes.KVStoreKey
+	iavlStore *iavl.Store
+
+	root   types.MerkleRoot
+	prefix types.MerklePrefix
+}
+
+func (suite *VerifyTestSuite) SetupTest() {
+	db := dbm.NewMemDB()
+	suite.store = rootmulti.NewStore(db)
+
+	suite.storeKey = storetypes.NewKVStoreKey("iavlStoreKey")
+
+	suite.store.MountStoreWithDB(suite.storeKey, storetypes.StoreTypeIAVL, nil)
+	suite.store.LoadVersion(0)
+
+	suite.iavlStore = suite.store.GetCommitStore(suite.storeKey).(*iavl.Store)
+	suite.iavlStore.Set([]byte(testPath), []byte("MYVALUE"))
+	cid := suite.store.Commit()
+
+	suite.root = types.NewMerkleRoot(cid.Hash)
+	suite.prefix = types.NewMerklePrefix([]byte(suite.storeKey.Name()))
+}
+
+func TestVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTestSuite))
+}
+
+// queryProof returns the merkle proof of the given key on the test store.
+func (suite *VerifyTestSuite) queryProof(key string) types.MerkleProof {
+	res := suite.store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+		Data:  []byte(key),
+		Prove: true,
+	})
+	suite.Require().NotNil(res.Proof)
+
+	return types.MerkleProof{Proof: res.Proof}
+}
+
+// tamper returns a copy of the proof with a byte of its first operation flipped.
+func tamper(proof types.MerkleProof) types.MerkleProof {
+	ops := make([]merkle.ProofOp, len(proof.Proof.Ops))
+	copy(ops, proof.Proof.Ops)
+
+	data := append([]byte{}, ops[0].Data...)
+	data[len(data)-1] ^= 1
+	ops[0].Data = data
+
+	return types.MerkleProof{Proof: &merkle.Proof{Ops: ops}}
+}
+
+// truncate returns a copy of the proof without its outer multistore operation.
+func truncate(proof types.MerkleProof) types.MerkleProof {
+	ops := make([]merkle.ProofOp, len(proof.Proof.Ops)-1)
+	copy(ops, proof.Proof.Ops)
+
+	return types.MerkleProof{Proof: &merkle.Proof{Ops: ops}}
+}
+
+func (suite *VerifyTestSuite) TestVerifyMembership() {
+	proof := suite.queryProof(testPath)
+
+	cases := []struct {
+		name   string
+		specs  []types.ProofSpec
+		root   types.MerkleRoot
+		proof  types.MerkleProof
+		prefix types.MerklePrefix
+		path   string
+		value  []byte
+		expErr *sdkerrors.Error
+	}{
+		{"valid proof", sdkSpecs, suite.root, proof, suite.prefix, testPath, []byte("MYVALUE"), nil},
+		{"tampered proof", sdkSpecs, suite.root, tamper(proof), suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProof},
+		{"empty proof", sdkSpecs, suite.root, types.MerkleProof{}, suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProof},
+		{"wrong value", sdkSpecs, suite.root, proof, suite.prefix, testPath, []byte("WRONGVALUE"), types.ErrInvalidProof},
+		{"wrong root", sdkSpecs, types.NewMerkleRoot([]byte("WRONGROOT")), proof, suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProof},
+		{"wrong path", sdkSpecs, suite.root, proof, suite.prefix, testAbsentPath, []byte("MYVALUE"), types.ErrKeyMismatch},
+		{"wrong prefix", sdkSpecs, suite.root, proof, types.NewMerklePrefix([]byte("otherStoreKey")), testPath, []byte("MYVALUE"), types.ErrKeyMismatch},
+		{"empty prefix", sdkSpecs, suite.root, proof, types.MerklePrefix{}, testPath, []byte("MYVALUE"), types.ErrInvalidPrefix},
+		{"reversed specs", reversedSpecs, suite.root, proof, suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProofSpec},
+		{"missing spec", []types.ProofSpec{types.IAVLSpec}, suite.root, proof, suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProofSpec},
+		{"no specs", nil, suite.root, proof, suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProofSpec},
+		{"truncated proof", sdkSpecs, suite.root, truncate(proof), suite.prefix, testPath, []byte("MYVALUE"), types.ErrInvalidProofSpec},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := commitment.VerifyMembership(tc.specs, tc.root, tc.proof, tc.prefix, tc.path, tc.value)
+
+			if tc.expErr == nil {
+				// nolint: scopelint
+				suite.Require().NoError(err, "test case %d should have passed", i)
+			} else {
+				// nolint: scopelint
+				suite.Require().True(tc.expErr.Is(err), "test case %d returned an unexpected error: %v", i, err)
+			}
+		})
+	}
+}
+
+func (suite *VerifyTestSuite) TestVerifyNonMembership() {
+	proof := suite.queryProof(testAbsentPath)
+
+	cases := []struct {
+		name   string
+		specs  []types.ProofSpec
+		root   types.MerkleRoot
+		proof  types.MerkleProof
+		prefix types.MerklePrefix
+		path   string
+		expErr *sdkerrors.Error
+	}{
+		{"valid proof", sdkSpecs, suite.root, proof, suite.prefix, testAbsentPath, nil},
+		{"tampered proof", sdkSpecs, suite.root, tamper(proof), suite.prefix, testAbsentPath, types.ErrInvalidProof},
+		{"empty proof", sdkSpecs, suite.root, types.MerkleProof{}, suite.prefix, testAbsentPath, types.ErrInvalidProof},
+		{"wrong root", sdkSpecs, types.NewMerkleRoot([]byte("WRONGROOT")), proof, suite.prefix, testAbsentPath, types.ErrInvalidProof},
+		{"existing path", sdkSpecs, suite.root, proof, suite.prefix, testPath, types.ErrKeyMismatch},
+		{"wrong prefix", sdkSpecs, suite.root, proof, types.NewMerklePrefix([]byte("otherStoreKey")), testAbsentPath, types.ErrKeyMismatch},
+		{"empty prefix", sdkSpecs, suite.root, proof, types.MerklePrefix{}, testAbsentPath, types.ErrInvalidPrefix},
+		{"reversed specs", reversedSpecs, suite.root, proof, suite.prefix, testAbsentPath, types.ErrInvalidProofSpec},
+		{"truncated proof", sdkSpecs, suite.root, truncate(proof), suite.prefix, testAbsentPath, types.ErrInvalidProofSpec},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := commitment.VerifyNonMembership(tc.specs, tc.root, tc.proof, tc.prefix, tc.path)
+
+			if tc.expErr == nil {
+				// nolint: scopelint
+				suite.Require().NoError(err, "test case %d should have passed", i)
+			} else {
+				// nolint: scopelint
+				suite.Require().True(tc.expErr.Is(err), "test case %d returned an unexpected error: %v", i, err)
+			}
+		})
+	}
+}
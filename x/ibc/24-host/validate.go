@@ -16,22 +16,46 @@ import (
 // ValidateFn function type to validate path and identifier bytestrings
 type ValidateFn func(string) error
 
-func defaultIdentifierValidator(id string, min, max int) error {
-	// valid id MUST NOT contain "/" separator
-	if strings.Contains(id, "/") {
-		return sdkerrors.Wrapf(ErrInvalidID, "identifier %s cannot contain separator '/'", id)
-	}
-	// valid id must be between 10 and 20 characters
-	if len(id) < min || len(id) > max {
-		return sdkerrors.Wrapf(ErrInvalidID, "identifier %s has invalid length: %d, must be between %d-%d characters", id, len(id), min, max)
+// IsValidCharsFn function type to check that an identifier only contains
+// allowed characters
+type IsValidCharsFn func(string) bool
+
+// NewIdentifierValidator returns an identifier validator function which requires
+// identifiers to be between the min and max lengths (inclusive), not to contain
+// the "/" separator and to only contain characters allowed by the given
+// predicate.
+func NewIdentifierValidator(min, max int, isValidChars IsValidCharsFn) ValidateFn {
+	return func(id string) error {
+		// valid id MUST NOT contain "/" separator
+		if strings.Contains(id, "/") {
+			return sdkerrors.Wrapf(ErrInvalidID, "identifier %s cannot contain separator '/'", id)
+		}
+		// valid id must be between min and max characters
+		if len(id) < min || len(id) > max {
+			return sdkerrors.Wrapf(ErrInvalidID, "identifier %s has invalid length: %d, must be between %d-%d characters", id, len(id), min, max)
+		}
+		if !isValidChars(id) {
+			return sdkerrors.Wrapf(ErrInvalidID, "identifier %s contains invalid characters", id)
+		}
+		return nil
 	}
+}
+
+func defaultIdentifierValidator(id string, min, max int) error {
 	// valid id must contain only lower alphabetic characters
-	if !sdk.IsAlphaLower(id) {
-		return sdkerrors.Wrapf(ErrInvalidID, "identifier %s must contain only lowercase alphabetic characters", id)
-	}
-	return nil
+	return NewIdentifierValidator(min, max, sdk.IsAlphaLower)(id)
 }
 
+// Identifier validators used by the IBC modules. They default to the validators
+// defined below and can be replaced by applications that need stricter or looser
+// identifier rules (e.g to accept identifiers of a counterparty chain).
+var (
+	ClientIdentifierValidator     ValidateFn = DefaultClientIdentifierValidator
+	ConnectionIdentifierValidator ValidateFn = DefaultConnectionIdentifierValidator
+	ChannelIdentifierValidator    ValidateFn = DefaultChannelIdentifierValidator
+	PortIdentifierValidator       ValidateFn = DefaultPortIdentifierValidator
+)
+
 // DefaultClientIdentifierValidator is the default validator function for Client identifiers
 // A valid Identifier must be between 10-20 characters and only contain lowercase
 // alphabetic characters,
@@ -0,0 +1,71 @@
+package host
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDefaultIdentifierValidator(t *testing.T) {
+	testCases := []struct {
+		msg       string
+		id        string
+		validator ValidateFn
+		expPass   bool
+	}{
+		{"client id with min length", strings.Repeat("a", 10), DefaultClientIdentifierValidator, true},
+		{"client id with max length", strings.Repeat("a", 20), DefaultClientIdentifierValidator, true},
+		{"client id too short", strings.Repeat("a", 9), DefaultClientIdentifierValidator, false},
+		{"client id too long", strings.Repeat("a", 21), DefaultClientIdentifierValidator, false},
+		{"connection id with min length", strings.Repeat("a", 10), DefaultConnectionIdentifierValidator, true},
+		{"connection id too long", strings.Repeat("a", 21), DefaultConnectionIdentifierValidator, false},
+		{"channel id with max length", strings.Repeat("a", 20), DefaultChannelIdentifierValidator, true},
+		{"channel id too short", strings.Repeat("a", 9), DefaultChannelIdentifierValidator, false},
+		{"port id with min length", "ab", DefaultPortIdentifierValidator, true},
+		{"port id too short", "a", DefaultPortIdentifierValidator, false},
+		{"uppercase characters", "clientIDabc", DefaultClientIdentifierValidator, false},
+		{"numeric characters", "clientid01", DefaultClientIdentifierValidator, false},
+		{"separator", "client/idabc", DefaultClientIdentifierValidator, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.validator(tc.id)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestNewIdentifierValidator(t *testing.T) {
+	validator := NewIdentifierValidator(3, 64, sdk.IsAlphaNumeric)
+
+	testCases := []struct {
+		msg     string
+		id      string
+		expPass bool
+	}{
+		{"min length", "abc", true},
+		{"max length", strings.Repeat("a", 64), true},
+		{"below min length", "ab", false},
+		{"above max length", strings.Repeat("a", 65), false},
+		{"empty", "", false},
+		{"alphanumeric characters", "channel0", true},
+		{"invalid characters", "channel-0", false},
+		{"separator", "chan/nel0", false},
+	}
+
+	for _, tc := range testCases {
+		err := validator(tc.id)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+			require.True(t, ErrInvalidID.Is(err), tc.msg)
+		}
+	}
+}
@@ -2,13 +2,14 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
 	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
 )
 
 // ProofVerificationDecorator handles messages that contains application specific packet types,
-// including MsgPacket, MsgAcknowledgement, MsgTimeout.
+// including MsgPacket, MsgRecvPackets, MsgAcknowledgement, MsgTimeout, MsgTimeoutOnClose.
 // MsgUpdateClients are also handled here to perform atomic multimsg transaction
 type ProofVerificationDecorator struct {
 	clientKeeper  client.Keeper
@@ -23,7 +24,7 @@ func NewProofVerificationDecorator(clientKeeper client.Keeper, channelKeeper cha
 	}
 }
 
-// AnteHandle executes MsgUpdateClient, MsgPacket, MsgAcknowledgement, MsgTimeout.
+// AnteHandle executes MsgUpdateClient, MsgPacket, MsgRecvPackets, MsgAcknowledgement, MsgTimeout, MsgTimeoutOnClose.
 // The packet execution messages are then passed to the respective application handlers.
 func (pvr ProofVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	for _, msg := range tx.GetMsgs() {
@@ -33,10 +34,19 @@ func (pvr ProofVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 			_, err = pvr.clientKeeper.UpdateClient(ctx, msg.GetClientID(), msg.GetHeader())
 		case channel.MsgPacket:
 			_, err = pvr.channelKeeper.RecvPacket(ctx, msg.Packet, msg.Proof, msg.ProofHeight)
+		case channel.MsgRecvPackets:
+			for i, packet := range msg.Packets {
+				if _, err = pvr.channelKeeper.RecvPacket(ctx, packet, msg.Proofs[i], msg.ProofHeight); err != nil {
+					err = sdkerrors.Wrapf(err, "packet at index %d", i)
+					break
+				}
+			}
 		case channel.MsgAcknowledgement:
 			_, err = pvr.channelKeeper.AcknowledgePacket(ctx, msg.Packet, msg.Acknowledgement, msg.Proof, msg.ProofHeight)
 		case channel.MsgTimeout:
 			_, err = pvr.channelKeeper.TimeoutPacket(ctx, msg.Packet, msg.Proof, msg.ProofHeight, msg.NextSequenceRecv)
+		case channel.MsgTimeoutOnClose:
+			_, err = pvr.channelKeeper.TimeoutOnClose(ctx, msg.Packet, msg.Proof, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
 		}
 
 		if err != nil {
@@ -21,6 +21,7 @@ import (
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/ante"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
@@ -86,7 +87,7 @@ func (suite *HandlerTestSuite) TestHandleMsgPacketOrdered() {
 		suite.chainA.App.IBCKeeper.ChannelKeeper,
 	))
 
-	packet := channel.NewPacket(newPacket(12345).GetData(), 1, portid, chanid, cpportid, cpchanid, 100)
+	packet := channel.NewPacket(newPacket(12345).GetData(), 1, portid, chanid, cpportid, cpchanid, 100, 0)
 
 	ctx := suite.chainA.GetContext()
 	cctx, _ := ctx.CacheContext()
@@ -145,7 +146,7 @@ func (suite *HandlerTestSuite) TestHandleMsgPacketUnordered() {
 
 	var packet channeltypes.Packet
 	for i := 0; i < 5; i++ {
-		packet = channel.NewPacket(newPacket(uint64(i)).GetData(), uint64(i), portid, chanid, cpportid, cpchanid, 100)
+		packet = channel.NewPacket(newPacket(uint64(i)).GetData(), uint64(i), portid, chanid, cpportid, cpchanid, 100, 0)
 		suite.chainB.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), packet.SourcePort, packet.SourceChannel, uint64(i), channeltypes.CommitPacket(packet))
 	}
 
@@ -157,7 +158,7 @@ func (suite *HandlerTestSuite) TestHandleMsgPacketUnordered() {
 
 	for i := 10; i >= 0; i-- {
 		cctx, write := suite.chainA.GetContext().CacheContext()
-		packet = channel.NewPacket(newPacket(uint64(i)).GetData(), uint64(i), portid, chanid, cpportid, cpchanid, 100)
+		packet = channel.NewPacket(newPacket(uint64(i)).GetData(), uint64(i), portid, chanid, cpportid, cpchanid, 100, 0)
 		packetCommitmentPath := ibctypes.PacketCommitmentPath(packet.SourcePort, packet.SourceChannel, uint64(i))
 		proof, proofHeight := queryProof(suite.chainB, packetCommitmentPath)
 		msg := channel.NewMsgPacket(packet, proof, uint64(proofHeight), addr1)
@@ -171,6 +172,85 @@ func (suite *HandlerTestSuite) TestHandleMsgPacketUnordered() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgRecvPackets() {
+	handler := sdk.ChainAnteDecorators(ante.NewProofVerificationDecorator(
+		suite.chainA.App.IBCKeeper.ClientKeeper,
+		suite.chainA.App.IBCKeeper.ChannelKeeper,
+	))
+
+	// the packet of sequence 3 is not committed
+	for i := 1; i <= 2; i++ {
+		packet := channel.NewPacket(newPacket(uint64(i)).GetData(), uint64(i), portid, chanid, cpportid, cpchanid, 100, 0)
+		suite.chainB.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), packet.SourcePort, packet.SourceChannel, uint64(i), channeltypes.CommitPacket(packet))
+	}
+
+	suite.chainA.createChannel(cpportid, cpchanid, portid, chanid, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+
+	suite.chainA.updateClient(suite.chainB)
+
+	var (
+		packets     []channeltypes.Packet
+		proofs      []commitmentexported.Proof
+		proofHeight int64
+	)
+	for i := 1; i <= 3; i++ {
+		packet := channel.NewPacket(newPacket(uint64(i)).GetData(), uint64(i), portid, chanid, cpportid, cpchanid, 100, 0)
+		var proof commitmenttypes.MerkleProof
+		proof, proofHeight = queryProof(suite.chainB, ibctypes.PacketCommitmentPath(packet.SourcePort, packet.SourceChannel, uint64(i)))
+		packets = append(packets, packet)
+		proofs = append(proofs, proof)
+	}
+
+	cctx, _ := suite.chainA.GetContext().CacheContext()
+	msg := channel.NewMsgRecvPackets(packets[:2], proofs[:2], uint64(proofHeight), addr1)
+	_, err := handler(cctx, suite.newTx(msg), false)
+	suite.NoError(err, "%+v", err) // all the packets are proven
+
+	// the whole batch is rejected if any of the proofs is invalid
+	msg = channel.NewMsgRecvPackets(packets, proofs, uint64(proofHeight), addr1)
+	_, err = handler(cctx, suite.newTx(msg), false)
+	suite.Error(err, "%+v", err)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgTimeoutOnClose() {
+	handler := sdk.ChainAnteDecorators(ante.NewProofVerificationDecorator(
+		suite.chainA.App.IBCKeeper.ClientKeeper,
+		suite.chainA.App.IBCKeeper.ChannelKeeper,
+	))
+
+	// packet sent from chainA to chainB
+	packet := channel.NewPacket(newPacket(12345).GetData(), 1, cpportid, cpchanid, portid, chanid, 100, 0)
+	suite.chainA.createChannel(cpportid, cpchanid, portid, chanid, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence, channeltypes.CommitPacket(packet))
+
+	channelPath := ibctypes.ChannelPath(portid, chanid)
+	unreceivedPath := ibctypes.PacketAcknowledgementPath(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	// the channel on chainB is still open
+	suite.chainB.createChannel(portid, chanid, cpportid, cpchanid, channelexported.OPEN, channelexported.UNORDERED, testConnection)
+	suite.chainA.updateClient(suite.chainB)
+
+	proofClose, proofHeight := queryProof(suite.chainB, channelPath)
+	proofUnreceived, _ := queryProof(suite.chainB, unreceivedPath)
+	msg := channel.NewMsgTimeoutOnClose(packet, 1, proofUnreceived, proofClose, uint64(proofHeight), addr1)
+
+	cctx, _ := suite.chainA.GetContext().CacheContext()
+	_, err := handler(cctx, suite.newTx(msg), false)
+	suite.Error(err, "%+v", err) // counterparty channel is not closed
+
+	// the channel on chainB is closed
+	suite.chainB.createChannel(portid, chanid, cpportid, cpchanid, channelexported.CLOSED, channelexported.UNORDERED, testConnection)
+	suite.chainA.updateClient(suite.chainB)
+
+	proofClose, proofHeight = queryProof(suite.chainB, channelPath)
+	proofUnreceived, _ = queryProof(suite.chainB, unreceivedPath)
+	msg = channel.NewMsgTimeoutOnClose(packet, 1, proofUnreceived, proofClose, uint64(proofHeight), addr1)
+
+	cctx, _ = suite.chainA.GetContext().CacheContext()
+	_, err = handler(cctx, suite.newTx(msg), false)
+	suite.NoError(err, "%+v", err) // successfully executed
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
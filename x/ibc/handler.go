@@ -7,6 +7,7 @@ import (
 	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	connection "github.com/cosmos/cosmos-sdk/x/ibc/03-connection"
 	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	port "github.com/cosmos/cosmos-sdk/x/ibc/05-port"
 )
 
@@ -23,6 +24,9 @@ func NewHandler(k Keeper) sdk.Handler {
 		case clientexported.MsgUpdateClient:
 			return &sdk.Result{}, nil
 
+		case clientexported.MsgSubmitMisbehaviour:
+			return client.HandleMsgSubmitMisbehaviour(ctx, k.ClientKeeper, msg)
+
 		// IBC connection  msgs
 		case connection.MsgConnectionOpenInit:
 			return connection.HandleMsgConnectionOpenInit(ctx, k.ConnectionKeeper, msg)
@@ -154,8 +158,24 @@ func NewHandler(k Keeper) sdk.Handler {
 
 		// IBC packet msgs get routed to the appropriate module callback
 		case channel.MsgPacket:
-			// Lookup module by channel capability
-			module, _, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+			// a packet relayed again after being received is a no-op, so that it's
+			// not executed twice
+			if k.ChannelKeeper.IsPacketReceived(ctx, msg.Packet) {
+				return channel.HandleMsgPacketAlreadyReceived(ctx, msg), nil
+			}
+			return recvPacket(ctx, k, msg.Packet, msg.Signer)
+
+		case channel.MsgRecvPackets:
+			return handleMsgRecvPackets(ctx, k, msg)
+
+		case channel.MsgAcknowledgement:
+			if err := k.ChannelKeeper.ValidateRelayer(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Signer); err != nil {
+				return nil, err
+			}
+
+			// Lookup module by channel capability. The acknowledged packet was sent
+			// from this chain so the capability is owned by the source port.
+			module, cap, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 			if !ok {
 				return nil, sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "could not retrieve module from channel capability")
 			}
@@ -165,11 +185,20 @@ func NewHandler(k Keeper) sdk.Handler {
 			if !ok {
 				return nil, sdkerrors.Wrapf(port.ErrInvalidRoute, "route not found to module: %s", module)
 			}
-			return cbs.OnRecvPacket(ctx, msg.Packet)
+			res, err := cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, msg.Signer)
+			if err != nil {
+				return nil, err
+			}
+			err = k.ChannelKeeper.AcknowledgementExecuted(ctx, cap, msg.Packet)
+			if err != nil {
+				return nil, err
+			}
+			return res, err
 
-		case channel.MsgAcknowledgement:
-			// Lookup module by channel capability
-			module, _, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+		case channel.MsgTimeout:
+			// Lookup module by channel capability. The timed-out packet was sent
+			// from this chain so the capability is owned by the source port.
+			module, cap, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 			if !ok {
 				return nil, sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "could not retrieve module from channel capability")
 			}
@@ -179,11 +208,20 @@ func NewHandler(k Keeper) sdk.Handler {
 			if !ok {
 				return nil, sdkerrors.Wrapf(port.ErrInvalidRoute, "route not found to module: %s", module)
 			}
-			return cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement)
+			res, err := cbs.OnTimeoutPacket(ctx, msg.Packet, msg.Signer)
+			if err != nil {
+				return nil, err
+			}
+			err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet)
+			if err != nil {
+				return nil, err
+			}
+			return res, err
 
-		case channel.MsgTimeout:
-			// Lookup module by channel capability
-			module, cap, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+		case channel.MsgTimeoutOnClose:
+			// Lookup module by channel capability. The packet was sent from this
+			// chain so the capability is owned by the source port.
+			module, cap, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 			if !ok {
 				return nil, sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "could not retrieve module from channel capability")
 			}
@@ -193,9 +231,11 @@ func NewHandler(k Keeper) sdk.Handler {
 			if !ok {
 				return nil, sdkerrors.Wrapf(port.ErrInvalidRoute, "route not found to module: %s", module)
 			}
-			res, err := cbs.OnTimeoutPacket(ctx, msg.Packet)
-			if err != nil {
 
+			// the packet will never be received on the closed channel, so the
+			// application handles it as a regular timeout (eg: refund tokens)
+			res, err := cbs.OnTimeoutPacket(ctx, msg.Packet, msg.Signer)
+			if err != nil {
 				return nil, err
 			}
 			err = k.ChannelKeeper.TimeoutExecuted(ctx, cap, msg.Packet)
@@ -209,3 +249,81 @@ func NewHandler(k Keeper) sdk.Handler {
 		}
 	}
 }
+
+// recvPacket routes a received packet to the callback of the module that owns
+// its destination channel, if the relayer is allowed on that channel.
+func recvPacket(ctx sdk.Context, k Keeper, packet channel.Packet, relayer sdk.AccAddress) (*sdk.Result, error) {
+	if err := k.ChannelKeeper.ValidateRelayer(ctx, packet.DestinationPort, packet.DestinationChannel, relayer); err != nil {
+		return nil, err
+	}
+
+	// packets of ordered channels must be received in sequence order
+	if err := k.ChannelKeeper.ValidatePacketSequence(ctx, packet); err != nil {
+		return nil, err
+	}
+
+	// Lookup module by channel capability
+	module, _, ok := k.ChannelKeeper.LookupModuleByChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !ok {
+		return nil, sdkerrors.Wrap(channel.ErrChannelCapabilityNotFound, "could not retrieve module from channel capability")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(port.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+	return cbs.OnRecvPacket(ctx, packet, relayer)
+}
+
+// handleMsgRecvPackets executes the packets of a MsgRecvPackets in order and
+// returns their results, along with the acknowledgements written by the
+// modules, on the result data. Each packet is executed on a cached context so
+// that a packet that fails to be executed doesn't abort the others: its state
+// changes and events are discarded and an error result is returned for it.
+//
+// NOTE: the packet proofs are verified by the ante handler, so the whole batch
+// is rejected if any of them is invalid.
+func handleMsgRecvPackets(ctx sdk.Context, k Keeper, msg channel.MsgRecvPackets) (*sdk.Result, error) {
+	results := make([]channel.RecvPacketResult, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		// a packet relayed again after being received is not executed twice
+		if k.ChannelKeeper.IsPacketReceived(ctx, packet) {
+			results[i] = channel.NewRecvPacketErrorResult(packet, channel.ErrPacketAlreadyReceived)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := recvPacket(cacheCtx, k, packet, msg.Signer); err != nil {
+			results[i] = channel.NewRecvPacketErrorResult(packet, err)
+			continue
+		}
+
+		writeCache()
+		events := cacheCtx.EventManager().Events()
+		ctx.EventManager().EmitEvents(events)
+		results[i] = channel.NewRecvPacketResult(packet, getAcknowledgement(events))
+	}
+
+	return &sdk.Result{
+		Data:   channel.SubModuleCdc.MustMarshalJSON(results),
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// getAcknowledgement returns the acknowledgement carried by the receive packet
+// event emitted when a packet is executed.
+func getAcknowledgement(events sdk.Events) []byte {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeRecvPacket {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == channeltypes.AttributeKeyData {
+				return attr.Value
+			}
+		}
+	}
+	return nil
+}
@@ -23,6 +23,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			switch path[1] {
 			case client.QueryAllClients:
 				res, err = client.QuerierClients(ctx, req, k.ClientKeeper)
+			case client.QueryClientFrozen:
+				res, err = client.QuerierClientFrozen(ctx, req, k.ClientKeeper)
 			default:
 				err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown IBC %s query endpoint", client.SubModuleName)
 			}
@@ -41,6 +43,20 @@ func NewQuerier(k Keeper) sdk.Querier {
 				res, err = channel.QuerierChannels(ctx, req, k.ChannelKeeper)
 			case channel.QueryConnectionChannels:
 				res, err = channel.QuerierConnectionChannels(ctx, req, k.ChannelKeeper)
+			case channel.QueryNextSequenceSend:
+				res, err = channel.QuerierNextSequenceSend(ctx, req, k.ChannelKeeper)
+			case channel.QueryPacketCommitment:
+				res, err = channel.QuerierPacketCommitment(ctx, req, k.ChannelKeeper)
+			case channel.QueryPacketCommitments:
+				res, err = channel.QuerierPacketCommitments(ctx, req, k.ChannelKeeper)
+			case channel.QueryPacketAck:
+				res, err = channel.QuerierPacketAck(ctx, req, k.ChannelKeeper)
+			case channel.QueryUnreceivedPackets:
+				res, err = channel.QuerierUnreceivedPackets(ctx, req, k.ChannelKeeper)
+			case channel.QueryUnreceivedPacketsRange:
+				res, err = channel.QuerierUnreceivedPacketsRange(ctx, req, k.ChannelKeeper)
+			case channel.QueryUnrelayedAcks:
+				res, err = channel.QuerierUnrelayedAcks(ctx, req, k.ChannelKeeper)
 			default:
 				err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown IBC %s query endpoint", channel.SubModuleName)
 			}
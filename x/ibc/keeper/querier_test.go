@@ -43,6 +43,12 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 			false,
 			"",
 		},
+		{
+			"client - QuerierClientFrozen",
+			[]string{client.SubModuleName, client.QueryClientFrozen},
+			false,
+			"",
+		},
 		{
 			"client - invalid query",
 			[]string{client.SubModuleName, "foo"},
@@ -85,6 +91,36 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 			false,
 			"",
 		},
+		{
+			"channel - QuerierNextSequenceSend",
+			[]string{channel.SubModuleName, channel.QueryNextSequenceSend},
+			false,
+			"",
+		},
+		{
+			"channel - QuerierPacketCommitments",
+			[]string{channel.SubModuleName, channel.QueryPacketCommitments},
+			false,
+			"",
+		},
+		{
+			"channel - QuerierUnreceivedPackets",
+			[]string{channel.SubModuleName, channel.QueryUnreceivedPackets},
+			false,
+			"",
+		},
+		{
+			"channel - QuerierUnreceivedPacketsRange",
+			[]string{channel.SubModuleName, channel.QueryUnreceivedPacketsRange},
+			false,
+			"",
+		},
+		{
+			"channel - QuerierUnrelayedAcks",
+			[]string{channel.SubModuleName, channel.QueryUnrelayedAcks},
+			false,
+			"",
+		},
 		{
 			"channel - invalid query",
 			[]string{channel.SubModuleName, "foo"},
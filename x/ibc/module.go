@@ -138,5 +138,6 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 // EndBlock returns the end blocker for the ibc module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	channel.EndBlocker(ctx, am.keeper.ChannelKeeper)
 	return []abci.ValidatorUpdate{}
 }
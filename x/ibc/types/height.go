@@ -0,0 +1,194 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// chainIDRevisionRegex matches the chain IDs that end with a revision number,
+// i.e {chain name}-{revision number} (eg: cosmoshub-4)
+var chainIDRevisionRegex = regexp.MustCompile(`^.*[^-]-[1-9][0-9]*$`)
+
+// Height is a block height that is aware of the revision of the chain. The
+// block heights of a chain restart from 1 after each revision bump (i.e chain
+// upgrade), so that the heights are ordered by revision number first and by
+// revision height within a revision.
+//
+// A zero revision number defines a flat height, as used before heights were
+// revision aware (see IsTimeoutHeightReached).
+type Height struct {
+	RevisionNumber uint64 `json:"revision_number" yaml:"revision_number"` // revision of the chain the height belongs to
+	RevisionHeight uint64 `json:"revision_height" yaml:"revision_height"` // block height within the revision
+}
+
+// NewHeight creates a new Height instance
+func NewHeight(revisionNumber, revisionHeight uint64) Height {
+	return Height{
+		RevisionNumber: revisionNumber,
+		RevisionHeight: revisionHeight,
+	}
+}
+
+// IsZero returns true if the height is unset.
+func (h Height) IsZero() bool {
+	return h.RevisionNumber == 0 && h.RevisionHeight == 0
+}
+
+// Compare returns -1 if the height is lower than the other one, 0 if they are
+// equal and 1 if it is greater. Heights of a lower revision are always lower,
+// whatever their revision height.
+func (h Height) Compare(other Height) int {
+	switch {
+	case h.RevisionNumber < other.RevisionNumber:
+		return -1
+	case h.RevisionNumber > other.RevisionNumber:
+		return 1
+	case h.RevisionHeight < other.RevisionHeight:
+		return -1
+	case h.RevisionHeight > other.RevisionHeight:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// LT returns true if the height is lower than the other one.
+func (h Height) LT(other Height) bool { return h.Compare(other) < 0 }
+
+// GTE returns true if the height is greater than or equal to the other one.
+func (h Height) GTE(other Height) bool { return h.Compare(other) >= 0 }
+
+// EQ returns true if the heights are equal.
+func (h Height) EQ(other Height) bool { return h.Compare(other) == 0 }
+
+// String returns the height formatted as {revision number}-{revision height},
+// or as the revision height alone for a flat height.
+func (h Height) String() string {
+	if h.RevisionNumber == 0 {
+		return strconv.FormatUint(h.RevisionHeight, 10)
+	}
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
+}
+
+// MarshalJSON encodes the height as the string returned by String, so that the
+// JSON encoding of flat heights is unchanged.
+func (h Height) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+// UnmarshalJSON decodes a height formatted as a number or a string (see
+// ParseHeight), as well as the object with the revision number and height.
+func (h *Height) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err == nil {
+		height, err := ParseHeight(s)
+		if err != nil {
+			return err
+		}
+		*h = height
+		return nil
+	}
+
+	var flat uint64
+	if err := json.Unmarshal(bz, &flat); err == nil {
+		*h = NewHeight(0, flat)
+		return nil
+	}
+
+	var height struct {
+		RevisionNumber json.Number `json:"revision_number"`
+		RevisionHeight json.Number `json:"revision_height"`
+	}
+	if err := json.Unmarshal(bz, &height); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHeight, "invalid height %s: %s", bz, err.Error())
+	}
+
+	revisionNumber, err := parseUint(height.RevisionNumber.String())
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHeight, "invalid revision number %s: %s", height.RevisionNumber, err.Error())
+	}
+	revisionHeight, err := parseUint(height.RevisionHeight.String())
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHeight, "invalid revision height %s: %s", height.RevisionHeight, err.Error())
+	}
+
+	*h = NewHeight(revisionNumber, revisionHeight)
+	return nil
+}
+
+// ParseHeight parses a height formatted as {revision number}-{revision height}.
+// A single number is parsed as a flat height, for backward compatibility.
+func ParseHeight(s string) (Height, error) {
+	parts := strings.Split(s, "-")
+	switch len(parts) {
+	case 1:
+		revisionHeight, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "invalid height %s: %s", s, err.Error())
+		}
+		return NewHeight(0, revisionHeight), nil
+
+	case 2:
+		revisionNumber, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "invalid revision number %s: %s", s, err.Error())
+		}
+		revisionHeight, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "invalid revision height %s: %s", s, err.Error())
+		}
+		return NewHeight(revisionNumber, revisionHeight), nil
+
+	default:
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "height %s must be formatted as {revision number}-{revision height}", s)
+	}
+}
+
+// ParseChainID returns the revision number of a chain ID formatted as
+// {chain name}-{revision number}, or 0 for the other chain IDs.
+func ParseChainID(chainID string) uint64 {
+	if !chainIDRevisionRegex.MatchString(chainID) {
+		return 0
+	}
+
+	revisionNumber, err := strconv.ParseUint(chainID[strings.LastIndex(chainID, "-")+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revisionNumber
+}
+
+// GetSelfHeight returns the height of the chain at the given context, with the
+// revision number of its chain ID.
+func GetSelfHeight(ctx sdk.Context) Height {
+	return NewHeight(ParseChainID(ctx.ChainID()), uint64(ctx.BlockHeight()))
+}
+
+// IsTimeoutHeightReached returns true if the given height of a chain reached the
+// timeout height of a packet sent to it. A zero timeout height never times out.
+//
+// A flat timeout height is only compared to the revision height, so that the
+// timeouts set before heights were revision aware keep their meaning on chains
+// with a revision number.
+func IsTimeoutHeightReached(height, timeoutHeight Height) bool {
+	if timeoutHeight.IsZero() {
+		return false
+	}
+	if timeoutHeight.RevisionNumber == 0 {
+		return height.RevisionHeight >= timeoutHeight.RevisionHeight
+	}
+	return height.GTE(timeoutHeight)
+}
+
+func parseUint(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightCompare(t *testing.T) {
+	testCases := []struct {
+		msg    string
+		height Height
+		other  Height
+		expCmp int
+	}{
+		{"same revision, lower height", NewHeight(1, 10), NewHeight(1, 11), -1},
+		{"same revision, equal height", NewHeight(1, 10), NewHeight(1, 10), 0},
+		{"same revision, greater height", NewHeight(1, 11), NewHeight(1, 10), 1},
+		{"lower revision, greater height", NewHeight(1, 1000), NewHeight(2, 1), -1},
+		{"greater revision, lower height", NewHeight(2, 1), NewHeight(1, 1000), 1},
+		{"flat height, lower than any revision", NewHeight(0, 1000), NewHeight(1, 1), -1},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expCmp, tc.height.Compare(tc.other), tc.msg)
+		require.Equal(t, tc.expCmp < 0, tc.height.LT(tc.other), tc.msg)
+		require.Equal(t, tc.expCmp >= 0, tc.height.GTE(tc.other), tc.msg)
+		require.Equal(t, tc.expCmp == 0, tc.height.EQ(tc.other), tc.msg)
+	}
+}
+
+func TestParseHeight(t *testing.T) {
+	testCases := []struct {
+		msg       string
+		height    string
+		expHeight Height
+		expPass   bool
+	}{
+		{"flat height", "100", NewHeight(0, 100), true},
+		{"revision height", "2-100", NewHeight(2, 100), true},
+		{"zero height", "0", Height{}, true},
+		{"empty height", "", Height{}, false},
+		{"invalid revision number", "a-100", Height{}, false},
+		{"invalid revision height", "2-a", Height{}, false},
+		{"negative height", "-100", Height{}, false},
+		{"too many parts", "1-2-100", Height{}, false},
+	}
+
+	for _, tc := range testCases {
+		height, err := ParseHeight(tc.height)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+			require.Equal(t, tc.expHeight, height, tc.msg)
+			require.Equal(t, tc.height, height.String(), tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestHeightJSON(t *testing.T) {
+	bz, err := json.Marshal(NewHeight(0, 100))
+	require.NoError(t, err)
+	require.Equal(t, `"100"`, string(bz), "flat heights must encode as before")
+
+	bz, err = json.Marshal(NewHeight(2, 100))
+	require.NoError(t, err)
+	require.Equal(t, `"2-100"`, string(bz))
+
+	testCases := []struct {
+		msg       string
+		json      string
+		expHeight Height
+		expPass   bool
+	}{
+		{"flat number", `100`, NewHeight(0, 100), true},
+		{"flat string", `"100"`, NewHeight(0, 100), true},
+		{"revision string", `"2-100"`, NewHeight(2, 100), true},
+		{"object", `{"revision_number":"2","revision_height":"100"}`, NewHeight(2, 100), true},
+		{"object with numbers", `{"revision_number":2,"revision_height":100}`, NewHeight(2, 100), true},
+		{"invalid string", `"2-a"`, Height{}, false},
+		{"negative number", `-100`, Height{}, false},
+		{"invalid object", `{"revision_number":"a","revision_height":"100"}`, Height{}, false},
+	}
+
+	for _, tc := range testCases {
+		var height Height
+		err := json.Unmarshal([]byte(tc.json), &height)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+			require.Equal(t, tc.expHeight, height, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestParseChainID(t *testing.T) {
+	testCases := []struct {
+		chainID     string
+		expRevision uint64
+	}{
+		{"cosmoshub-4", 4},
+		{"gaia-testnet-12", 12},
+		{"testchain", 0},
+		{"testchain-0", 0},
+		{"testchain-01", 0},
+		{"testchain--1", 0},
+		{"-1", 0},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expRevision, ParseChainID(tc.chainID), tc.chainID)
+	}
+}
+
+func TestIsTimeoutHeightReached(t *testing.T) {
+	testCases := []struct {
+		msg           string
+		height        Height
+		timeoutHeight Height
+		expReached    bool
+	}{
+		{"no timeout height", NewHeight(2, 1000), Height{}, false},
+		{"flat timeout not reached", NewHeight(0, 99), NewHeight(0, 100), false},
+		{"flat timeout reached", NewHeight(0, 100), NewHeight(0, 100), true},
+		{"flat timeout reached on a revision", NewHeight(2, 100), NewHeight(0, 100), true},
+		{"revision timeout not reached", NewHeight(1, 99), NewHeight(1, 100), false},
+		{"revision timeout reached", NewHeight(1, 100), NewHeight(1, 100), true},
+		{"revision bump reaches the timeout", NewHeight(2, 1), NewHeight(1, 1000), true},
+		{"timeout on a later revision", NewHeight(1, 1000), NewHeight(2, 1), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expReached, IsTimeoutHeightReached(tc.height, tc.timeoutHeight), tc.msg)
+	}
+}
@@ -17,6 +17,9 @@ const (
 
 	// RouterKey is the msg router key for the IBC module
 	RouterKey string = ModuleName
+
+	// DefaultParamspace is the default name for the IBC parameter subspace
+	DefaultParamspace = ModuleName
 )
 
 // KVStore key prefixes for IBC
@@ -33,6 +36,9 @@ const (
 	KeyNextSeqRecvPrefix       = "seqRecvs"
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
+	KeyRelayerAllowlistPrefix  = "relayerAllowlists"
+	KeyPacketReceiptPrefix     = "receipts"
+	KeyReceiptQueuePrefix      = "receiptQueue"
 )
 
 // KeyPrefixBytes return the key prefix bytes from a URL string format
@@ -113,6 +119,12 @@ func ChannelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/", KeyChannelPrefix) + channelPath(portID, channelID)
 }
 
+// PortChannelsPrefixPath defines the store path prefix of all the channels of a
+// port
+func PortChannelsPrefixPath(portID string) string {
+	return fmt.Sprintf("%s/ports/%s/", KeyChannelPrefix, portID)
+}
+
 // ChannelCapabilityPath defines the path under which capability keys associated
 // with a channel are stored
 func ChannelCapabilityPath(portID, channelID string) string {
@@ -134,11 +146,42 @@ func PacketCommitmentPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/", KeyPacketCommitmentPrefix) + channelPath(portID, channelID) + fmt.Sprintf("/packets/%d", sequence)
 }
 
+// PacketCommitmentPrefixPath defines the store path prefix of the commitments
+// of all the packets sent on a channel
+func PacketCommitmentPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/", KeyPacketCommitmentPrefix) + channelPath(portID, channelID) + "/packets/"
+}
+
 // PacketAcknowledgementPath defines the packet acknowledgement store path
 func PacketAcknowledgementPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/", KeyPacketAckPrefix) + channelPath(portID, channelID) + fmt.Sprintf("/acknowledgements/%d", sequence)
 }
 
+// PacketReceiptPath defines the path under which the receipt of a packet
+// received on an unordered channel is stored
+func PacketReceiptPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/", KeyPacketReceiptPrefix) + channelPath(portID, channelID) + fmt.Sprintf("/receipts/%d", sequence)
+}
+
+// ReceiptQueuePath defines the path under which a packet receipt is queued for
+// pruning at the given height. The height is zero padded so that the queue is
+// iterated by increasing height.
+func ReceiptQueuePath(height uint64, portID, channelID string, sequence uint64) string {
+	return ReceiptQueueHeightPrefix(height) + channelPath(portID, channelID) + fmt.Sprintf("/%d", sequence)
+}
+
+// ReceiptQueueHeightPrefix defines the path prefix of the packet receipts
+// queued at the given height
+func ReceiptQueueHeightPrefix(height uint64) string {
+	return fmt.Sprintf("%s/%020d/", KeyReceiptQueuePrefix, height)
+}
+
+// RelayerAllowlistPath defines the path under which the relayers allowed to
+// relay the packets and acknowledgements of a channel are stored
+func RelayerAllowlistPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/", KeyRelayerAllowlistPrefix) + channelPath(portID, channelID)
+}
+
 // KeyChannel returns the store key for a particular channel
 func KeyChannel(portID, channelID string) []byte {
 	return []byte(ChannelPath(portID, channelID))
@@ -174,6 +217,24 @@ func KeyPacketAcknowledgement(portID, channelID string, sequence uint64) []byte
 	return []byte(PacketAcknowledgementPath(portID, channelID, sequence))
 }
 
+// KeyPacketReceipt returns the store key under which the receipt of a packet
+// received on an unordered channel is stored
+func KeyPacketReceipt(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketReceiptPath(portID, channelID, sequence))
+}
+
+// KeyReceiptQueue returns the store key under which a packet receipt is queued
+// for pruning at the given height
+func KeyReceiptQueue(height uint64, portID, channelID string, sequence uint64) []byte {
+	return []byte(ReceiptQueuePath(height, portID, channelID, sequence))
+}
+
+// KeyRelayerAllowlist returns the store key under which the relayers allowed on
+// a channel are stored
+func KeyRelayerAllowlist(portID, channelID string) []byte {
+	return []byte(RelayerAllowlistPath(portID, channelID))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("ports/%s/channels/%s", portID, channelID)
 }